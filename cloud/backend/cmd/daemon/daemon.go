@@ -2,6 +2,8 @@
 package daemon
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxevent"
@@ -23,12 +25,17 @@ func DaemonCmd() *cobra.Command {
 }
 
 func doRunDaemon() {
+	cfg := config.NewProvider()
+
 	fx.New(
 		fx.WithLogger(func(log *zap.Logger) fxevent.Logger {
 			return &fxevent.ZapLogger{Logger: log}
 		}),
+		// Leave room for the HTTP server to drain before the stop hooks of
+		// the dependencies it uses are run.
+		fx.StopTimeout(time.Duration(cfg.App.HTTPShutdownSeconds)*time.Second+15*time.Second),
 		fx.Provide(zap.NewDevelopment),
-		fx.Provide(config.NewProvider),
+		fx.Supply(cfg),
 		manifold.Module(),
 	).Run()
 }
@@ -0,0 +1,54 @@
+// github.com/Maple-Open-Tech/monorepo/cloud/backend/cmd/openapi/openapi.go
+package openapi
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/manifold"
+	commonhttp "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/manifold/interface/http"
+)
+
+func OpenAPICmd() *cobra.Command {
+	var output string
+	var cmd = &cobra.Command{
+		Use:   "openapi",
+		Short: "Write the OpenAPI document of the API",
+		Long: `Write the OpenAPI 3.1 document of the API, the same one served at
+/openapi.json, to generate the SDKs of the clients. The routes are built
+without their dependencies, so no database, object storage or GeoLite
+database is needed.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			doRunOpenAPI(output)
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "openapi.json", "File to write the document to, `-` for the standard output")
+	return cmd
+}
+
+func doRunOpenAPI(output string) {
+	routes, err := commonhttp.NewDescribedRoutes(manifold.Routes(), zap.NewNop())
+	if err != nil {
+		log.Fatalf("failed building the routes: %v", err)
+	}
+	doc := commonhttp.NewOpenAPIDocument(routes)
+
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		log.Fatalf("failed marshalling the document: %v", err)
+	}
+	b = append(b, '\n')
+
+	if output == "-" {
+		os.Stdout.Write(b)
+		return
+	}
+	if err := os.WriteFile(output, b, 0o644); err != nil {
+		log.Fatalf("failed writing the document: %v", err)
+	}
+	log.Printf("wrote the OpenAPI document to %s\n", output)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/cmd/daemon"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/cmd/openapi"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/cmd/version"
 )
 
@@ -28,6 +29,7 @@ var rootCmd = &cobra.Command{
 func Execute() {
 	// Attach sub-commands to our main root.
 	rootCmd.AddCommand(daemon.DaemonCmd())
+	rootCmd.AddCommand(openapi.OpenAPICmd())
 	rootCmd.AddCommand(version.VersionCmd())
 
 	if err := rootCmd.Execute(); err != nil {
@@ -16,6 +16,7 @@ type Configuration struct {
 	DB                DBConfig
 	AWS               AWSConfig
 	PAPERCLOUDMailgun MailgunConfig
+	OIDC              OIDCConfig
 }
 
 type CacheConf struct {
@@ -29,8 +30,53 @@ type AppConfig struct {
 	AdministrationHMACSecret *sbytes.SecureBytes
 	AdministrationSecretKey  *sstring.SecureString
 	GeoLiteDBPath            string
+	GeoLiteASNDBPath         string // Optional GeoLite2 ASN database, required to block ASNs.
+	GeoLiteReloadSeconds     int    // How often the GeoLite2 databases are checked for changes, zero disables reloading.
 	BannedCountries          []string
-	BetaAccessCode           string
+	BannedASNs               []string // Autonomous systems blocked on every route, ex: `AS64496,64497`.
+	CountryPolicies          string   // Per route country policies, ex: `POST /iam/api/v1/register=allow:CA,US;GET /foo=deny:RU;/healthcheck=off`.
+	TrustedProxies           []string // CIDRs of the load balancers and proxies allowed to forward the client IP address, ex: `10.0.0.0/8,fd00::/8`.
+	PermissionRoles          string   // Overrides for the permission-to-role mapping, ex: `users:manage=1;vault:files=1,2,3`.
+	AuditEventRetentionDays  int
+	TermsOfServiceVersion    string // Current version of the terms of service, users who accepted an older version must re-accept.
+
+	LoginOTTEmailCooldownSeconds int  // Minimum wait before another login code is sent to the same email.
+	LoginOTTIPCooldownSeconds    int  // Minimum wait before the same IP address can request another login code.
+	LoginOTTMaxAttempts          int  // Number of wrong codes after which a login code is invalidated.
+	LoginMagicLinkEnabled        bool // Include a single-use sign-in link in login code emails.
+
+	RateLimitEnabled        bool   // Reject clients and users exceeding the rate limit policies with `429 Too Many Requests`.
+	RateLimitUseRedis       bool   // Share the rate limit counters between instances through the cache, otherwise keep them in memory.
+	RateLimitClientPolicies string // Overrides for the per client IP address policies, ex: `default=300/60;POST /iam/api/v1/request-login-ott=5/60`.
+	RateLimitUserPolicies   string // Overrides for the per authenticated user policies, ex: `default=600/60`.
+
+	BlacklistRefreshSeconds   int // How often the banned IP addresses are reloaded in case a change notification was missed.
+	BlacklistAutoBanThreshold int // Number of requests to banned URLs after which the client IP address is banned, zero disables automatic bans.
+	BlacklistAutoBanSeconds   int // How long an automatic ban lasts, also the window in which the requests to banned URLs are counted.
+
+	MetricsEnabled bool   // Expose the Prometheus metrics at `/metrics`.
+	MetricsAddress string // Serve the metrics on a separate admin listener, ex: `127.0.0.1:9100`, otherwise on the main server.
+
+	OTLPEndpoint         string // OTLP/HTTP collector receiving the traces, ex: `otel-collector:4318`, empty disables exporting.
+	OTLPInsecure         bool   // Send the traces to the collector without TLS.
+	TracingSamplePercent int    // Percentage of new traces sampled, traces started by clients follow their decision.
+
+	HealthCheckCacheSeconds   int // How long a readiness report is reused before the dependencies are checked again.
+	HealthCheckTimeoutSeconds int // Maximum time given to the readiness checks, slower checks fail.
+
+	HTTPReadHeaderTimeoutSeconds int    // Maximum time to read the request headers, protects against slowloris clients.
+	HTTPReadTimeoutSeconds       int    // Maximum time to read the whole request, including uploads.
+	HTTPWriteTimeoutSeconds      int    // Maximum time to write the response, including downloads.
+	HTTPIdleTimeoutSeconds       int    // How long an idle keep-alive connection is kept open.
+	HTTPMaxHeaderBytes           int    // Maximum size of the request headers.
+	HTTPH2CEnabled               bool   // Accept HTTP/2 without TLS, for example behind a load balancer terminating TLS.
+	HTTPShutdownSeconds          int    // Maximum time given to the in-flight requests to complete on shutdown.
+	TLSCertFile                  string // PEM certificate served over TLS, empty serves plain HTTP.
+	TLSKeyFile                   string // PEM private key of the certificate.
+	TLSReloadSeconds             int    // How often the certificate files are checked for changes, zero disables reloading.
+
+	IdempotencyKeyTTLSeconds int // How long the responses of the requests sent with an `Idempotency-Key` are replayed, zero disables idempotency keys.
+	IdempotencyMaxBodyBytes  int // Maximum size of the body of the requests sent with an `Idempotency-Key`, larger bodies are rejected.
 }
 
 type DBConfig struct {
@@ -50,6 +96,12 @@ type MailgunConfig struct {
 	BackendDomain    string
 }
 
+type OIDCConfig struct {
+	Issuer         string // Public base URL of the backend, used as the `iss` claim.
+	SigningKeyPath string // Path to the PEM encoded RSA key used to sign ID tokens.
+	LoginURL       string // Frontend page which runs the login and consent for an authorization request.
+}
+
 type AWSConfig struct {
 	AccessKey  string
 	SecretKey  string
@@ -69,8 +121,45 @@ func NewProvider() *Configuration {
 	c.App.AdministrationHMACSecret = getSecureBytesEnv("BACKEND_APP_ADMINISTRATION_HMAC_SECRET", false)
 	c.App.AdministrationSecretKey = getSecureStringEnv("BACKEND_APP_ADMINISTRATION_SECRET_KEY", false)
 	c.App.GeoLiteDBPath = getEnv("BACKEND_APP_GEOLITE_DB_PATH", false)
+	c.App.GeoLiteASNDBPath = getEnv("BACKEND_APP_GEOLITE_ASN_DB_PATH", false)
+	c.App.GeoLiteReloadSeconds = getIntEnv("BACKEND_APP_GEOLITE_RELOAD_SECONDS", false, 60)
 	c.App.BannedCountries = getStringsArrEnv("BACKEND_APP_BANNED_COUNTRIES", false)
-	c.App.BetaAccessCode = getEnv("BACKEND_APP_BETA_ACCESS_CODE", false)
+	c.App.BannedASNs = getStringsArrEnv("BACKEND_APP_BANNED_ASNS", false)
+	c.App.CountryPolicies = getEnv("BACKEND_APP_COUNTRY_POLICIES", false)
+	c.App.TrustedProxies = getStringsArrEnv("BACKEND_APP_TRUSTED_PROXIES", false)
+	c.App.PermissionRoles = getEnv("BACKEND_APP_PERMISSION_ROLES", false)
+	c.App.AuditEventRetentionDays = getIntEnv("BACKEND_APP_AUDIT_EVENT_RETENTION_DAYS", false, 365)
+	c.App.TermsOfServiceVersion = getEnv("BACKEND_APP_TERMS_OF_SERVICE_VERSION", false)
+	c.App.LoginOTTEmailCooldownSeconds = getIntEnv("BACKEND_APP_LOGIN_OTT_EMAIL_COOLDOWN_SECONDS", false, 60)
+	c.App.LoginOTTIPCooldownSeconds = getIntEnv("BACKEND_APP_LOGIN_OTT_IP_COOLDOWN_SECONDS", false, 10)
+	c.App.LoginOTTMaxAttempts = getIntEnv("BACKEND_APP_LOGIN_OTT_MAX_ATTEMPTS", false, 5)
+	c.App.LoginMagicLinkEnabled = getEnvBool("BACKEND_APP_LOGIN_MAGIC_LINK_ENABLED", false, false)
+	c.App.RateLimitEnabled = getEnvBool("BACKEND_APP_RATE_LIMIT_ENABLED", false, true)
+	c.App.RateLimitUseRedis = getEnvBool("BACKEND_APP_RATE_LIMIT_USE_REDIS", false, true)
+	c.App.RateLimitClientPolicies = getEnv("BACKEND_APP_RATE_LIMIT_CLIENT_POLICIES", false)
+	c.App.RateLimitUserPolicies = getEnv("BACKEND_APP_RATE_LIMIT_USER_POLICIES", false)
+	c.App.BlacklistRefreshSeconds = getIntEnv("BACKEND_APP_BLACKLIST_REFRESH_SECONDS", false, 300)
+	c.App.BlacklistAutoBanThreshold = getIntEnv("BACKEND_APP_BLACKLIST_AUTO_BAN_THRESHOLD", false, 5)
+	c.App.BlacklistAutoBanSeconds = getIntEnv("BACKEND_APP_BLACKLIST_AUTO_BAN_SECONDS", false, 3600)
+	c.App.MetricsEnabled = getEnvBool("BACKEND_APP_METRICS_ENABLED", false, false)
+	c.App.MetricsAddress = getEnv("BACKEND_APP_METRICS_ADDRESS", false)
+	c.App.OTLPEndpoint = getEnv("BACKEND_APP_OTLP_ENDPOINT", false)
+	c.App.OTLPInsecure = getEnvBool("BACKEND_APP_OTLP_INSECURE", false, false)
+	c.App.TracingSamplePercent = getIntEnv("BACKEND_APP_TRACING_SAMPLE_PERCENT", false, 100)
+	c.App.HealthCheckCacheSeconds = getIntEnv("BACKEND_APP_HEALTH_CHECK_CACHE_SECONDS", false, 5)
+	c.App.HealthCheckTimeoutSeconds = getIntEnv("BACKEND_APP_HEALTH_CHECK_TIMEOUT_SECONDS", false, 3)
+	c.App.HTTPReadHeaderTimeoutSeconds = getIntEnv("BACKEND_APP_HTTP_READ_HEADER_TIMEOUT_SECONDS", false, 10)
+	c.App.HTTPReadTimeoutSeconds = getIntEnv("BACKEND_APP_HTTP_READ_TIMEOUT_SECONDS", false, 120)
+	c.App.HTTPWriteTimeoutSeconds = getIntEnv("BACKEND_APP_HTTP_WRITE_TIMEOUT_SECONDS", false, 120)
+	c.App.HTTPIdleTimeoutSeconds = getIntEnv("BACKEND_APP_HTTP_IDLE_TIMEOUT_SECONDS", false, 120)
+	c.App.HTTPMaxHeaderBytes = getIntEnv("BACKEND_APP_HTTP_MAX_HEADER_BYTES", false, 1<<20)
+	c.App.HTTPH2CEnabled = getEnvBool("BACKEND_APP_HTTP_H2C_ENABLED", false, false)
+	c.App.HTTPShutdownSeconds = getIntEnv("BACKEND_APP_HTTP_SHUTDOWN_SECONDS", false, 30)
+	c.App.TLSCertFile = getEnv("BACKEND_APP_TLS_CERT_FILE", false)
+	c.App.TLSKeyFile = getEnv("BACKEND_APP_TLS_KEY_FILE", false)
+	c.App.TLSReloadSeconds = getIntEnv("BACKEND_APP_TLS_RELOAD_SECONDS", false, 60)
+	c.App.IdempotencyKeyTTLSeconds = getIntEnv("BACKEND_APP_IDEMPOTENCY_KEY_TTL_SECONDS", false, 86400)
+	c.App.IdempotencyMaxBodyBytes = getIntEnv("BACKEND_APP_IDEMPOTENCY_MAX_BODY_BYTES", false, 100<<20)
 
 	// --- Database section ---
 	c.DB.URI = getEnv("BACKEND_DB_URI", true)
@@ -98,6 +187,17 @@ func NewProvider() *Configuration {
 	c.PAPERCLOUDMailgun.FrontendDomain = getEnv("BACKEND_PAPERCLOUD_MAILGUN_FRONTEND_DOMAIN", true)
 	c.PAPERCLOUDMailgun.BackendDomain = getEnv("BACKEND_PAPERCLOUD_MAILGUN_BACKEND_DOMAIN", true)
 
+	// --------- OpenID Connect ------------
+	c.OIDC.Issuer = getEnv("BACKEND_OIDC_ISSUER", false)
+	if c.OIDC.Issuer == "" {
+		c.OIDC.Issuer = "https://" + c.PAPERCLOUDMailgun.BackendDomain
+	}
+	c.OIDC.SigningKeyPath = getEnv("BACKEND_OIDC_SIGNING_KEY_PATH", false)
+	c.OIDC.LoginURL = getEnv("BACKEND_OIDC_LOGIN_URL", false)
+	if c.OIDC.LoginURL == "" {
+		c.OIDC.LoginURL = "https://" + c.PAPERCLOUDMailgun.FrontendDomain + "/oauth2/login"
+	}
+
 	return &c
 }
 
@@ -156,6 +256,18 @@ func getEnvBool(key string, required bool, defaultValue bool) bool {
 	return value
 }
 
+func getIntEnv(key string, required bool, defaultValue int) int {
+	valueStr := getEnv(key, required)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		log.Fatalf("Invalid integer value for environment variable %s", key)
+	}
+	return value
+}
+
 func getStringsArrEnv(key string, required bool) []string {
 	value := os.Getenv(key)
 	if required && value == "" {
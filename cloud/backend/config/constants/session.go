@@ -10,6 +10,9 @@ const (
 	SessionProxies
 	SessionFederatedUser
 	SessionFederatedUserCompanyName
+	SessionOrganizationID
+	SessionOrganizationName
+	SessionOrganizationRole
 	SessionFederatedUserRole
 	SessionFederatedUserID
 	SessionFederatedUserUUID
@@ -21,4 +24,11 @@ const (
 	SessionFederatedUserStoreName
 	SessionFederatedUserStoreLevel
 	SessionFederatedUserStoreTimezone
+	SessionAPIKeyID
+	SessionAPIKeyScopes
+	SessionAuthorizationRequirement
+	SessionUserAgent
+	SessionRequestID
+	SessionLogEntry
+	SessionIdempotentRoute
 )
@@ -0,0 +1,18 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Repository Interface for an APIKey model in the database.
+type Repository interface {
+	Create(ctx context.Context, m *APIKey) error
+	GetByID(ctx context.Context, id primitive.ObjectID) (*APIKey, error)
+	GetByKeyHash(ctx context.Context, keyHash string) (*APIKey, error)
+	ListByFederatedUserID(ctx context.Context, federatedUserID primitive.ObjectID) ([]*APIKey, error)
+	UpdateLastUsed(ctx context.Context, id primitive.ObjectID, lastUsedAt time.Time, ipAddress string) error
+	DeleteByID(ctx context.Context, id primitive.ObjectID) error
+}
@@ -0,0 +1,56 @@
+package apikey
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	APIKeyScopeVaultRead  = "vault:read"  // Read-only access to the vault.
+	APIKeyScopeVaultWrite = "vault:write" // Read and write access to the vault.
+
+	// APIKeyPrefix is prepended to every generated key so leaked keys are easy
+	// to recognize by secret scanners.
+	APIKeyPrefix = "pcak_"
+)
+
+// APIKey structure represents a personal API key created by a federated user
+// for scripted access. Only the hash of the key is stored.
+type APIKey struct {
+	ID                    primitive.ObjectID `bson:"_id" json:"id"`
+	FederatedUserID       primitive.ObjectID `bson:"federated_user_id" json:"federated_user_id"`
+	Name                  string             `bson:"name" json:"name"`
+	Hint                  string             `bson:"hint" json:"hint"` // The first few characters of the key so the user can tell keys apart.
+	KeyHash               string             `bson:"key_hash" json:"-"`
+	Scopes                []string           `bson:"scopes" json:"scopes"`
+	ExpiresAt             time.Time          `bson:"expires_at" json:"expires_at"`
+	LastUsedAt            time.Time          `bson:"last_used_at,omitempty" json:"last_used_at,omitempty"`
+	LastUsedFromIPAddress string             `bson:"last_used_from_ip_address,omitempty" json:"last_used_from_ip_address,omitempty"`
+	CreatedAt             time.Time          `bson:"created_at" json:"created_at"`
+	CreatedFromIPAddress  string             `bson:"created_from_ip_address" json:"created_from_ip_address"`
+}
+
+// HasScope returns true if the key was granted `scope`. The write scope
+// implies the read scope.
+func (k *APIKey) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+		if scope == APIKeyScopeVaultRead && s == APIKeyScopeVaultWrite {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidScope returns true if `scope` is a scope we support.
+func IsValidScope(scope string) bool {
+	switch scope {
+	case APIKeyScopeVaultRead, APIKeyScopeVaultWrite:
+		return true
+	default:
+		return false
+	}
+}
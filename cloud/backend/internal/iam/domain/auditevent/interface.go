@@ -0,0 +1,12 @@
+package auditevent
+
+import (
+	"context"
+)
+
+// Repository Interface for audit events. There are purposefully no update
+// or delete operations as the audit log is append-only.
+type Repository interface {
+	Create(ctx context.Context, m *AuditEvent) error
+	ListByFilter(ctx context.Context, filter *AuditEventFilter) (*AuditEventFilterResult, error)
+}
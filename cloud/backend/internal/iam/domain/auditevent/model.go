@@ -0,0 +1,109 @@
+package auditevent
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	AuditEventTypeLoginOTTRequested           = "login.ott_requested"
+	AuditEventTypeLoginOTTFailed              = "login.ott_failed"
+	AuditEventTypeLoginChallengeFailed        = "login.challenge_failed"
+	AuditEventTypeLoginSucceeded              = "login.succeeded"
+	AuditEventTypeLoginNewDeviceAlerted       = "login.new_device_alerted"
+	AuditEventTypeLoginDisowned               = "login.disowned"
+	AuditEventTypeTokenRefreshed              = "token.refreshed"
+	AuditEventTypeSessionRevoked              = "session.revoked"
+	AuditEventTypePasswordChanged             = "account.password_changed"
+	AuditEventTypeAccountRecovered            = "account.recovered"
+	AuditEventTypeEmailChangeRequested        = "account.email_change_requested"
+	AuditEventTypeEmailChanged                = "account.email_changed"
+	AuditEventTypeEmailChangeCancelled        = "account.email_change_cancelled"
+	AuditEventTypeOAuth2Authorized            = "oauth2.authorized"
+	AuditEventTypeOrganizationCreated         = "organization.created"
+	AuditEventTypeOrganizationUpdated         = "organization.updated"
+	AuditEventTypeOrganizationMemberInvited   = "organization.member_invited"
+	AuditEventTypeOrganizationInviteRevoked   = "organization.invitation_revoked"
+	AuditEventTypeOrganizationMemberJoined    = "organization.member_joined"
+	AuditEventTypeOrganizationMemberRole      = "organization.member_role_changed"
+	AuditEventTypeOrganizationMemberRemoved   = "organization.member_removed"
+	AuditEventTypeFileCreated                 = "file.created"
+	AuditEventTypeFileDownloaded              = "file.downloaded"
+	AuditEventTypeFileDeleted                 = "file.deleted"
+	AuditEventTypeFileShared                  = "file.shared"
+	AuditEventTypeAdminUserStatusChanged      = "admin.user.status_changed"
+	AuditEventTypeAdminUserRoleChanged        = "admin.user.role_changed"
+	AuditEventTypeAdminUserForcedLogout       = "admin.user.forced_logout"
+	AuditEventTypeAdminUserVerificationResent = "admin.user.verification_resent"
+	AuditEventTypeAdminInviteCreated          = "admin.invite.created"
+	AuditEventTypeAdminOAuthClientCreated     = "admin.oauth_client.created"
+	AuditEventTypeAdminOAuthClientDeleted     = "admin.oauth_client.deleted"
+	AuditEventTypeAdminIPAddressBanned        = "admin.ip_address.banned"
+	AuditEventTypeAdminIPAddressUnbanned      = "admin.ip_address.unbanned"
+
+	AuditEventOutcomeSuccess = "success"
+	AuditEventOutcomeFailure = "failure"
+
+	AuditEventResourceTypeEncryptedFile = "encrypted_file"
+	AuditEventResourceTypeOrganization  = "organization"
+)
+
+// AuditEvent structure represents a security relevant action which happened
+// in our system. Audit events are append-only and never modified; they are
+// removed automatically once the configured retention period has passed.
+type AuditEvent struct {
+	ID primitive.ObjectID `bson:"_id" json:"id"`
+
+	// Type is one of the `AuditEventType*` constants.
+	Type string `bson:"type" json:"type"`
+
+	// Outcome is one of the `AuditEventOutcome*` constants.
+	Outcome string `bson:"outcome" json:"outcome"`
+
+	// ActorUserID is the user who performed the action. For admin actions
+	// this is the administrator.
+	ActorUserID primitive.ObjectID `bson:"actor_user_id,omitempty" json:"actor_user_id,omitempty"`
+	ActorName   string             `bson:"actor_name,omitempty" json:"actor_name,omitempty"`
+
+	// SubjectUserID is the user whose account the action was performed on
+	// and is who gets to see the event in their security events.
+	SubjectUserID primitive.ObjectID `bson:"subject_user_id,omitempty" json:"subject_user_id,omitempty"`
+
+	// ResourceType and ResourceID identify the object the action was
+	// performed on, if any, for example an encrypted file.
+	ResourceType string `bson:"resource_type,omitempty" json:"resource_type,omitempty"`
+	ResourceID   string `bson:"resource_id,omitempty" json:"resource_id,omitempty"`
+
+	IPAddress string            `bson:"ip_address,omitempty" json:"ip_address,omitempty"`
+	UserAgent string            `bson:"user_agent,omitempty" json:"user_agent,omitempty"`
+	Details   map[string]string `bson:"details,omitempty" json:"details,omitempty"`
+	CreatedAt time.Time         `bson:"created_at" json:"created_at"`
+}
+
+// AuditEventFilter represents the filter criteria for listing audit events
+type AuditEventFilter struct {
+	ActorUserID   *primitive.ObjectID `json:"actor_user_id,omitempty"`
+	SubjectUserID *primitive.ObjectID `json:"subject_user_id,omitempty"`
+	Types         []string            `json:"types,omitempty"`
+	Outcome       string              `json:"outcome,omitempty"`
+	ResourceID    string              `json:"resource_id,omitempty"`
+	IPAddress     string              `json:"ip_address,omitempty"`
+
+	// Date range filters
+	CreatedAtStart *time.Time `json:"created_at_start,omitempty"`
+	CreatedAtEnd   *time.Time `json:"created_at_end,omitempty"`
+
+	// Pagination - cursor based
+	LastID        *primitive.ObjectID `json:"last_id,omitempty"`
+	LastCreatedAt *time.Time          `json:"last_created_at,omitempty"`
+	Limit         int64               `json:"limit,omitempty"`
+}
+
+// AuditEventFilterResult represents the result of a filtered list operation
+type AuditEventFilterResult struct {
+	Events        []*AuditEvent      `json:"events"`
+	HasMore       bool               `json:"has_more"`
+	LastID        primitive.ObjectID `json:"last_id,omitempty"`
+	LastCreatedAt time.Time          `json:"last_created_at"`
+}
@@ -12,9 +12,11 @@ type Repository interface {
 	Create(ctx context.Context, m *BannedIPAddress) error
 	GetByID(ctx context.Context, id primitive.ObjectID) (*BannedIPAddress, error)
 	GetByNonce(ctx context.Context, nonce *big.Int) (*BannedIPAddress, error)
+	GetByValue(ctx context.Context, value string) (*BannedIPAddress, error)
 	UpdateByID(ctx context.Context, m *BannedIPAddress) error
 	CountByFilter(ctx context.Context, filter *BannedIPAddressFilter) (uint64, error)
 	ListByFilter(ctx context.Context, filter *BannedIPAddressFilter) (*BannedIPAddressFilterResult, error)
 	DeleteByID(ctx context.Context, id primitive.ObjectID) error
 	ListAllValues(ctx context.Context) ([]string, error)
+	ListAllActive(ctx context.Context) ([]*BannedIPAddress, error)
 }
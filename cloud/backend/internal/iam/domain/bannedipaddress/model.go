@@ -9,10 +9,18 @@ import (
 // BannedIPAddress structure represents the blockchain transaction that
 // belongs to our user in our application.
 type BannedIPAddress struct {
-	ID        primitive.ObjectID `bson:"_id" json:"id"`
-	UserID    primitive.ObjectID `bson:"user_id" json:"user_id"` // The user ID that this IP address belongs to.
-	Value     string             `bson:"value" json:"value"`
-	CreatedAt time.Time          `bson:"created_at,omitempty" json:"created_at,omitempty"`
+	ID              primitive.ObjectID `bson:"_id" json:"id"`
+	UserID          primitive.ObjectID `bson:"user_id" json:"user_id"` // The user ID that this IP address belongs to.
+	Value           string             `bson:"value" json:"value"`     // IP address, CIDR range or IPv6 prefix.
+	Reason          string             `bson:"reason,omitempty" json:"reason,omitempty"`
+	ExpiresAt       time.Time          `bson:"expires_at,omitempty" json:"expires_at,omitempty"` // Zero bans forever, otherwise the record is removed once expired.
+	CreatedByUserID primitive.ObjectID `bson:"created_by_user_id,omitempty" json:"created_by_user_id,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at,omitempty" json:"created_at,omitempty"`
+}
+
+// IsExpired returns true if the ban is temporary and was lifted at `now`.
+func (m *BannedIPAddress) IsExpired(now time.Time) bool {
+	return !m.ExpiresAt.IsZero() && !now.Before(m.ExpiresAt)
 }
 
 type BannedIPAddressFilter struct {
@@ -0,0 +1,14 @@
+package consentrecord
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Repository Interface for consent records. There are purposefully no update
+// or delete operations as the consent ledger is append-only.
+type Repository interface {
+	Create(ctx context.Context, m *ConsentRecord) error
+	ListByFederatedUserID(ctx context.Context, federatedUserID primitive.ObjectID) ([]*ConsentRecord, error)
+}
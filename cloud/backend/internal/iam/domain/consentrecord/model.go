@@ -0,0 +1,29 @@
+package consentrecord
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// The kinds of consent a federated user can give or withdraw.
+const (
+	ConsentTypeTermsOfService = "terms_of_service"
+	ConsentTypePromotions     = "promotions"
+	ConsentTypeTracking       = "tracking_across_third_party_apps_and_services"
+)
+
+// ConsentRecord structure represents a single entry in the consent ledger.
+// Every time a federated user gives or withdraws consent a new record is
+// appended, so the full history of which document version was agreed to,
+// when and from where is kept.
+type ConsentRecord struct {
+	ID              primitive.ObjectID `bson:"_id" json:"id"`
+	FederatedUserID primitive.ObjectID `bson:"federated_user_id" json:"federated_user_id"`
+	Type            string             `bson:"type" json:"type"`
+	DocumentVersion string             `bson:"document_version" json:"document_version"`
+	Granted         bool               `bson:"granted" json:"granted"` // False when the consent was withdrawn.
+	IPAddress       string             `bson:"ip_address" json:"ip_address"`
+	UserAgent       string             `bson:"user_agent,omitempty" json:"user_agent,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+}
@@ -3,6 +3,7 @@ package federateduser
 
 import (
 	"context"
+	"errors"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
@@ -17,7 +18,18 @@ type Repository interface {
 	DeleteByEmail(ctx context.Context, email string) error
 	CheckIfExistsByEmail(ctx context.Context, email string) (bool, error)
 	UpdateByID(ctx context.Context, m *FederatedUser) error
+	UpdateEmailByID(ctx context.Context, id primitive.ObjectID, oldEmail, newEmail string) error
 	ListAll(ctx context.Context) ([]*FederatedUser, error)
 	CountByFilter(ctx context.Context, filter *FederatedUserFilter) (uint64, error)
 	ListByFilter(ctx context.Context, filter *FederatedUserFilter) (*FederatedUserFilterResult, error)
 }
+
+var (
+	// ErrEmailAlreadyExists is returned when an email update collides with
+	// another account's address.
+	ErrEmailAlreadyExists = errors.New("email address already exists")
+
+	// ErrEmailModified is returned when the account's email no longer matches
+	// the address the update was requested against.
+	ErrEmailModified = errors.New("email address was modified")
+)
@@ -15,6 +15,10 @@ const (
 	FederatedUserRoleRoot       = 1 // Root user, has all permissions
 	FederatedUserRoleCompany    = 2 // Company user, has permissions for company-related operations
 	FederatedUserRoleIndividual = 3 // Individual user, has permissions for individual-related operations
+
+	// MaxKnownLoginDevices is the number of most recently used devices
+	// remembered per user for new device login alerts.
+	MaxKnownLoginDevices = 20
 )
 
 type FederatedUser struct {
@@ -51,6 +55,10 @@ type FederatedUser struct {
 	AgreeTermsOfService                            bool               `bson:"agree_terms_of_service" json:"agree_terms_of_service,omitempty"`
 	AgreePromotions                                bool               `bson:"agree_promotions" json:"agree_promotions,omitempty"`
 	AgreeToTrackingAcrossThirdPartyAppsAndServices bool               `bson:"agree_to_tracking_across_third_party_apps_and_services" json:"agree_to_tracking_across_third_party_apps_and_services,omitempty"`
+	AgreeTermsOfServiceVersion                     string             `bson:"agree_terms_of_service_version,omitempty" json:"agree_terms_of_service_version,omitempty"`
+	AgreeTermsOfServiceAt                          time.Time          `bson:"agree_terms_of_service_at,omitempty" json:"agree_terms_of_service_at,omitempty"`
+	KnownLoginDevices                              []*LoginDevice     `bson:"known_login_devices,omitempty" json:"known_login_devices,omitempty"`
+	KnownLoginCountries                            []string           `bson:"known_login_countries,omitempty" json:"known_login_countries,omitempty"`
 
 	// --- E2EE Related ---
 	Salt                              string `json:"salt"`
@@ -70,6 +78,7 @@ type FederatedUser struct {
 	ModifiedByUserID      primitive.ObjectID `bson:"modified_by_user_id" json:"modified_by_user_id"`
 	ModifiedAt            time.Time          `bson:"modified_at" json:"modified_at"`
 	ModifiedByName        string             `bson:"modified_by_name" json:"modified_by_name"`
+	InviteID              primitive.ObjectID `bson:"invite_id,omitempty" json:"invite_id,omitempty"` // The invite the user registered with.
 
 	// OTPEnabled controls whether we force 2FA or not during login.
 	OTPEnabled bool `bson:"otp_enabled" json:"otp_enabled"`
@@ -94,6 +103,79 @@ type FederatedUser struct {
 	OTPBackupCodeHashAlgorithm string `bson:"otp_backup_code_hash_algorithm" json:"-"`
 }
 
+// IsTermsOfServiceOutdated returns true if the user has not accepted the
+// `currentVersion` of the terms of service. An empty `currentVersion` means
+// the terms of service are not versioned so they are never outdated.
+func (u *FederatedUser) IsTermsOfServiceOutdated(currentVersion string) bool {
+	return currentVersion != "" && u.AgreeTermsOfServiceVersion != currentVersion
+}
+
+// LoginDevice is a device the user has successfully logged in from.
+type LoginDevice struct {
+	Fingerprint string    `bson:"fingerprint" json:"fingerprint"`
+	UserAgent   string    `bson:"user_agent" json:"user_agent"`
+	Country     string    `bson:"country,omitempty" json:"country,omitempty"`
+	FirstSeenAt time.Time `bson:"first_seen_at" json:"first_seen_at"`
+	LastSeenAt  time.Time `bson:"last_seen_at" json:"last_seen_at"`
+}
+
+// HasKnownLoginDevice returns true if the user has logged in from the device
+// with the `fingerprint` before.
+func (u *FederatedUser) HasKnownLoginDevice(fingerprint string) bool {
+	for _, d := range u.KnownLoginDevices {
+		if d.Fingerprint == fingerprint {
+			return true
+		}
+	}
+	return false
+}
+
+// HasKnownLoginCountry returns true if the user has logged in from the
+// `country` before.
+func (u *FederatedUser) HasKnownLoginCountry(country string) bool {
+	for _, c := range u.KnownLoginCountries {
+		if c == country {
+			return true
+		}
+	}
+	return false
+}
+
+// RememberLogin records a login from the device with the `fingerprint` and
+// from the `country`, which may be empty if it could not be determined. Only
+// the `MaxKnownLoginDevices` most recently used devices are kept.
+func (u *FederatedUser) RememberLogin(fingerprint, userAgent, country string, at time.Time) {
+	if country != "" && !u.HasKnownLoginCountry(country) {
+		u.KnownLoginCountries = append(u.KnownLoginCountries, country)
+	}
+
+	for _, d := range u.KnownLoginDevices {
+		if d.Fingerprint == fingerprint {
+			d.UserAgent = userAgent
+			d.Country = country
+			d.LastSeenAt = at
+			return
+		}
+	}
+
+	u.KnownLoginDevices = append(u.KnownLoginDevices, &LoginDevice{
+		Fingerprint: fingerprint,
+		UserAgent:   userAgent,
+		Country:     country,
+		FirstSeenAt: at,
+		LastSeenAt:  at,
+	})
+	if len(u.KnownLoginDevices) > MaxKnownLoginDevices {
+		oldest := 0
+		for i, d := range u.KnownLoginDevices {
+			if d.LastSeenAt.Before(u.KnownLoginDevices[oldest].LastSeenAt) {
+				oldest = i
+			}
+		}
+		u.KnownLoginDevices = append(u.KnownLoginDevices[:oldest], u.KnownLoginDevices[oldest+1:]...)
+	}
+}
+
 // FederatedUserFilter represents the filter criteria for listing users
 type FederatedUserFilter struct {
 	// Basic filters
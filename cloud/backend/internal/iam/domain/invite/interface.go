@@ -0,0 +1,17 @@
+package invite
+
+import (
+	"context"
+	"time"
+)
+
+// Repository Interface for an Invite model in the database.
+type Repository interface {
+	Create(ctx context.Context, m *Invite) error
+	GetByCode(ctx context.Context, code string) (*Invite, error)
+	ListByFilter(ctx context.Context, filter *InviteFilter) (*InviteFilterResult, error)
+
+	// ConsumeByCode atomically takes one use of the invite for `module`,
+	// returning nil if no usable invite matches.
+	ConsumeByCode(ctx context.Context, code string, module int, now time.Time) (*Invite, error)
+}
@@ -0,0 +1,52 @@
+package invite
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Invite structure represents an invitation code which lets people register
+// for a monolith module while it is in closed beta. Every successful
+// registration consumes one use of the invite.
+type Invite struct {
+	ID              primitive.ObjectID `bson:"_id" json:"id"`
+	Code            string             `bson:"code" json:"code"`
+	Module          int                `bson:"module" json:"module"`
+	MaxUses         int                `bson:"max_uses" json:"max_uses"`
+	UseCount        int                `bson:"use_count" json:"use_count"`
+	ExpiresAt       *time.Time         `bson:"expires_at,omitempty" json:"expires_at,omitempty"` // Nil means the invite never expires.
+	Note            string             `bson:"note,omitempty" json:"note,omitempty"`
+	CreatedByUserID primitive.ObjectID `bson:"created_by_user_id" json:"created_by_user_id"`
+	CreatedByName   string             `bson:"created_by_name" json:"created_by_name"`
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+	LastUsedAt      time.Time          `bson:"last_used_at,omitempty" json:"last_used_at,omitempty"`
+}
+
+// IsUsable returns true if the invite still has uses left and has not
+// expired at `now`.
+func (i *Invite) IsUsable(now time.Time) bool {
+	if i.UseCount >= i.MaxUses {
+		return false
+	}
+	return i.ExpiresAt == nil || now.Before(*i.ExpiresAt)
+}
+
+// InviteFilter represents the filter options for listing invites.
+type InviteFilter struct {
+	Module          int                 `json:"module,omitempty"`
+	CreatedByUserID *primitive.ObjectID `json:"created_by_user_id,omitempty"`
+
+	// Cursor-based pagination
+	LastID        *primitive.ObjectID `json:"last_id,omitempty"`
+	LastCreatedAt *time.Time          `json:"last_created_at,omitempty"`
+	Limit         int64               `json:"limit"`
+}
+
+// InviteFilterResult represents the result of a filtered list operation.
+type InviteFilterResult struct {
+	Invites       []*Invite          `json:"invites"`
+	HasMore       bool               `json:"has_more"`
+	LastID        primitive.ObjectID `json:"last_id,omitempty"`
+	LastCreatedAt time.Time          `json:"last_created_at"`
+}
@@ -0,0 +1,13 @@
+package oauthclient
+
+import (
+	"context"
+)
+
+// Repository Interface for an OAuthClient model in the database.
+type Repository interface {
+	Create(ctx context.Context, m *OAuthClient) error
+	GetByClientID(ctx context.Context, clientID string) (*OAuthClient, error)
+	ListAll(ctx context.Context) ([]*OAuthClient, error)
+	DeleteByClientID(ctx context.Context, clientID string) error
+}
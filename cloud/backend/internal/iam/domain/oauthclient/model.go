@@ -0,0 +1,61 @@
+package oauthclient
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Scopes which relying parties may request, see OpenID Connect Core 1.0
+// section 5.4.
+const (
+	ScopeOpenID  = "openid"
+	ScopeProfile = "profile"
+	ScopeEmail   = "email"
+)
+
+// OAuthClient structure represents an application which delegates login to
+// us through OpenID Connect. Public clients (for example single page apps)
+// have no secret and must rely on PKCE alone.
+type OAuthClient struct {
+	ID               primitive.ObjectID `bson:"_id" json:"id"`
+	ClientID         string             `bson:"client_id" json:"client_id"`
+	ClientSecretHash string             `bson:"client_secret_hash,omitempty" json:"-"`
+	Name             string             `bson:"name" json:"name"`
+	RedirectURIs     []string           `bson:"redirect_uris" json:"redirect_uris"`
+	Scopes           []string           `bson:"scopes" json:"scopes"`
+	IsPublic         bool               `bson:"is_public" json:"is_public"`
+	CreatedByUserID  primitive.ObjectID `bson:"created_by_user_id" json:"created_by_user_id"`
+	CreatedAt        time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// HasRedirectURI returns true if `uri` exactly matches one of the registered
+// redirect URIs.
+func (c *OAuthClient) HasRedirectURI(uri string) bool {
+	for _, u := range c.RedirectURIs {
+		if u == uri {
+			return true
+		}
+	}
+	return false
+}
+
+// HasScope returns true if the client was allowed to request `scope`.
+func (c *OAuthClient) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidScope returns true if `scope` is a scope we support.
+func IsValidScope(scope string) bool {
+	switch scope {
+	case ScopeOpenID, ScopeProfile, ScopeEmail:
+		return true
+	default:
+		return false
+	}
+}
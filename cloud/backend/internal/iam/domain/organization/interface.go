@@ -0,0 +1,15 @@
+package organization
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Repository Interface for an Organization model in the database.
+type Repository interface {
+	Create(ctx context.Context, m *Organization) error
+	GetByID(ctx context.Context, id primitive.ObjectID) (*Organization, error)
+	ListByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*Organization, error)
+	UpdateByID(ctx context.Context, m *Organization) error
+}
@@ -0,0 +1,18 @@
+package organization
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Organization structure represents a company account which federated users
+// join as members so they can collaborate on shared resources without
+// sharing logins.
+type Organization struct {
+	ID              primitive.ObjectID `bson:"_id" json:"id"`
+	Name            string             `bson:"name" json:"name"`
+	CreatedByUserID primitive.ObjectID `bson:"created_by_user_id" json:"created_by_user_id"`
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+	ModifiedAt      time.Time          `bson:"modified_at" json:"modified_at"`
+}
@@ -0,0 +1,16 @@
+package organizationinvitation
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Repository Interface for an OrganizationInvitation model in the database.
+type Repository interface {
+	Create(ctx context.Context, m *OrganizationInvitation) error
+	GetByID(ctx context.Context, id primitive.ObjectID) (*OrganizationInvitation, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*OrganizationInvitation, error)
+	ListByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) ([]*OrganizationInvitation, error)
+	DeleteByID(ctx context.Context, id primitive.ObjectID) error
+}
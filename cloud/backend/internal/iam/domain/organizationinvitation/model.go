@@ -0,0 +1,23 @@
+package organizationinvitation
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OrganizationInvitation structure represents a pending email invitation to
+// join an organization. Only the hash of the token sent by email is stored;
+// the document is removed once accepted or expired.
+type OrganizationInvitation struct {
+	ID               primitive.ObjectID `bson:"_id" json:"id"`
+	OrganizationID   primitive.ObjectID `bson:"organization_id" json:"organization_id"`
+	OrganizationName string             `bson:"organization_name" json:"organization_name"`
+	Email            string             `bson:"email" json:"email"`
+	Role             int8               `bson:"role" json:"role"`
+	TokenHash        string             `bson:"token_hash" json:"-"`
+	InvitedByUserID  primitive.ObjectID `bson:"invited_by_user_id" json:"invited_by_user_id"`
+	InvitedByName    string             `bson:"invited_by_name" json:"invited_by_name"`
+	ExpiresAt        time.Time          `bson:"expires_at" json:"expires_at"`
+	CreatedAt        time.Time          `bson:"created_at" json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Repository Interface for an OrganizationMember model in the database.
+type Repository interface {
+	Create(ctx context.Context, m *OrganizationMember) error
+	GetByOrganizationIDAndUserID(ctx context.Context, organizationID, userID primitive.ObjectID) (*OrganizationMember, error)
+	ListByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) ([]*OrganizationMember, error)
+	ListByUserID(ctx context.Context, userID primitive.ObjectID) ([]*OrganizationMember, error)
+	CountByOrganizationIDAndRole(ctx context.Context, organizationID primitive.ObjectID, role int8) (int64, error)
+	UpdateRoleByID(ctx context.Context, id primitive.ObjectID, role int8) error
+	DeleteByID(ctx context.Context, id primitive.ObjectID) error
+}
@@ -0,0 +1,48 @@
+package organizationmember
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Roles a federated user can hold within an organization. Lower values are
+// more privileged.
+const (
+	OrganizationMemberRoleOwner  = 1
+	OrganizationMemberRoleAdmin  = 2
+	OrganizationMemberRoleMember = 3
+)
+
+// OrganizationMember structure represents the membership of a federated
+// user in an organization.
+type OrganizationMember struct {
+	ID              primitive.ObjectID `bson:"_id" json:"id"`
+	OrganizationID  primitive.ObjectID `bson:"organization_id" json:"organization_id"`
+	FederatedUserID primitive.ObjectID `bson:"federated_user_id" json:"federated_user_id"`
+	Email           string             `bson:"email" json:"email"`
+	Name            string             `bson:"name" json:"name"`
+	Role            int8               `bson:"role" json:"role"`
+	// The other members wrap the keys of the vault files they share with
+	// the organization with this public key of the member.
+	PublicKey       string             `bson:"public_key,omitempty" json:"public_key,omitempty"`
+	InvitedByUserID primitive.ObjectID `bson:"invited_by_user_id,omitempty" json:"invited_by_user_id,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at" json:"created_at"`
+	ModifiedAt      time.Time          `bson:"modified_at" json:"modified_at"`
+}
+
+// IsValidRole returns true if `role` is one of the organization roles.
+func IsValidRole(role int8) bool {
+	switch role {
+	case OrganizationMemberRoleOwner, OrganizationMemberRoleAdmin, OrganizationMemberRoleMember:
+		return true
+	default:
+		return false
+	}
+}
+
+// CanManageMembers returns true if members with `role` may invite, remove
+// and change the role of other members.
+func CanManageMembers(role int8) bool {
+	return role == OrganizationMemberRoleOwner || role == OrganizationMemberRoleAdmin
+}
@@ -0,0 +1,144 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_admin "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/blacklist"
+)
+
+type CreateBannedIPAddressHTTPHandler struct {
+	adminRoute
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_admin.CreateBannedIPAddressService
+	blacklist  blacklist.Provider
+	middleware middleware.Middleware
+}
+
+func NewCreateBannedIPAddressHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_admin.CreateBannedIPAddressService,
+	blp blacklist.Provider,
+	middleware middleware.Middleware,
+) *CreateBannedIPAddressHTTPHandler {
+	return &CreateBannedIPAddressHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		blacklist:  blp,
+		middleware: middleware,
+	}
+}
+
+func (*CreateBannedIPAddressHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/admin/banned-ip-addresses"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*CreateBannedIPAddressHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Ban an IP address or network",
+		Request:  sv_admin.CreateBannedIPAddressRequestDTO{},
+		Response: dom_banip.BannedIPAddress{},
+		Status:   http.StatusCreated,
+	}
+}
+
+// Idempotent lets the retries sent with the same idempotency key replay
+// the first response
+func (*CreateBannedIPAddressHTTPHandler) Idempotent() bool {
+	return true
+}
+
+func (r *CreateBannedIPAddressHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *CreateBannedIPAddressHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*sv_admin.CreateBannedIPAddressRequestDTO, error) {
+	var requestData sv_admin.CreateBannedIPAddressRequestDTO
+
+	defer r.Body.Close()
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang struct
+	err := json.NewDecoder(teeReader).Decode(&requestData)
+	if err != nil {
+		h.logger.Error("decoding error",
+			zap.Any("err", err),
+			zap.String("json", rawJSON.String()),
+		)
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
+	}
+
+	return &requestData, nil
+}
+
+func (h *CreateBannedIPAddressHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		resp, err := h.service.Execute(sessCtx, data)
+		if err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	// Start the transaction
+	result, err := session.WithTransaction(ctx, transactionFunc)
+	if err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Only notify once committed or other instances could reload before the
+	// ban is visible to them; the periodic refresh catches up on failure.
+	if err := h.blacklist.NotifyChanged(ctx); err != nil {
+		h.logger.Warn("failed notifying blacklist change", zap.Any("error", err))
+	}
+
+	resp := result.(*dom_banip.BannedIPAddress)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
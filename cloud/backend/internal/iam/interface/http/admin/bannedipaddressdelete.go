@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_admin "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/blacklist"
+)
+
+type DeleteBannedIPAddressHTTPHandler struct {
+	adminRoute
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_admin.DeleteBannedIPAddressService
+	blacklist  blacklist.Provider
+	middleware middleware.Middleware
+}
+
+func NewDeleteBannedIPAddressHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_admin.DeleteBannedIPAddressService,
+	blp blacklist.Provider,
+	middleware middleware.Middleware,
+) *DeleteBannedIPAddressHTTPHandler {
+	return &DeleteBannedIPAddressHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		blacklist:  blp,
+		middleware: middleware,
+	}
+}
+
+func (*DeleteBannedIPAddressHTTPHandler) Pattern() string {
+	return "DELETE /iam/api/v1/admin/banned-ip-addresses/{id}"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*DeleteBannedIPAddressHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary: "Lift the ban of an IP address or network",
+		Status:  http.StatusNoContent,
+	}
+}
+
+func (r *DeleteBannedIPAddressHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *DeleteBannedIPAddressHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "Invalid ID format"))
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		if err := h.service.Execute(sessCtx, id); err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Start the transaction
+	if _, err := session.WithTransaction(ctx, transactionFunc); err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	if err := h.blacklist.NotifyChanged(ctx); err != nil {
+		h.logger.Warn("failed notifying blacklist change", zap.Any("error", err))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,119 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_admin "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type ListBannedIPAddressesHTTPHandler struct {
+	adminRoute
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_admin.ListBannedIPAddressesService
+	middleware middleware.Middleware
+}
+
+func NewListBannedIPAddressesHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_admin.ListBannedIPAddressesService,
+	middleware middleware.Middleware,
+) *ListBannedIPAddressesHTTPHandler {
+	return &ListBannedIPAddressesHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ListBannedIPAddressesHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/admin/banned-ip-addresses"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListBannedIPAddressesHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "List the banned IP addresses and networks",
+		Response: dom_banip.BannedIPAddressFilterResult{},
+	}
+}
+
+func (r *ListBannedIPAddressesHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+// unmarshalFilter converts the query parameters into our filter. Supported
+// parameters are `value`, `limit`, `last_id` and `last_created_at`; dates
+// are in RFC 3339 format.
+func (h *ListBannedIPAddressesHTTPHandler) unmarshalFilter(r *http.Request) (*dom_banip.BannedIPAddressFilter, error) {
+	q := r.URL.Query()
+	filter := &dom_banip.BannedIPAddressFilter{}
+	e := make(map[string]string)
+
+	if v := q.Get("value"); v != "" {
+		filter.Value = &v
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			e["limit"] = "Limit must be a number"
+		}
+		filter.Limit = limit
+	}
+	if v := q.Get("last_id"); v != "" {
+		lastID, err := primitive.ObjectIDFromHex(v)
+		if err != nil {
+			e["last_id"] = "Invalid ID format"
+		}
+		filter.LastID = &lastID
+	}
+	if v := q.Get("last_created_at"); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			e["last_created_at"] = "Invalid date format"
+		}
+		filter.LastCreatedAt = &t
+	}
+
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+	return filter, nil
+}
+
+func (h *ListBannedIPAddressesHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := h.unmarshalFilter(r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp, err := h.service.Execute(ctx, filter)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
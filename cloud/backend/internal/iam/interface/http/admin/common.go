@@ -0,0 +1,17 @@
+package admin
+
+import (
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
+)
+
+// adminRoute is embedded by every admin handler to restrict access to root
+// users holding the user management permission.
+type adminRoute struct{}
+
+func (adminRoute) RequiredRoles() []int8 {
+	return []int8{authorization.RoleRoot}
+}
+
+func (adminRoute) RequiredPermissions() []string {
+	return []string{authorization.PermissionUsersManage}
+}
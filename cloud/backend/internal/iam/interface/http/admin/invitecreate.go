@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_admin "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type CreateInviteHTTPHandler struct {
+	adminRoute
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_admin.CreateInviteService
+	middleware middleware.Middleware
+}
+
+func NewCreateInviteHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_admin.CreateInviteService,
+	middleware middleware.Middleware,
+) *CreateInviteHTTPHandler {
+	return &CreateInviteHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*CreateInviteHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/admin/invites"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*CreateInviteHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Invite someone to register",
+		Request:  sv_admin.CreateInviteRequestDTO{},
+		Response: dom_invite.Invite{},
+		Status:   http.StatusCreated,
+	}
+}
+
+func (r *CreateInviteHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *CreateInviteHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*sv_admin.CreateInviteRequestDTO, error) {
+	var requestData sv_admin.CreateInviteRequestDTO
+
+	defer r.Body.Close()
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang struct
+	err := json.NewDecoder(teeReader).Decode(&requestData)
+	if err != nil {
+		h.logger.Error("decoding error",
+			zap.Any("err", err),
+			zap.String("json", rawJSON.String()),
+		)
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
+	}
+
+	return &requestData, nil
+}
+
+func (h *CreateInviteHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		resp, err := h.service.Execute(sessCtx, data)
+		if err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	// Start the transaction
+	result, err := session.WithTransaction(ctx, transactionFunc)
+	if err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp := result.(*dom_invite.Invite)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
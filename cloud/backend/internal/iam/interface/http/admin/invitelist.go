@@ -0,0 +1,130 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_admin "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type ListInvitesHTTPHandler struct {
+	adminRoute
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_admin.ListInvitesService
+	middleware middleware.Middleware
+}
+
+func NewListInvitesHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_admin.ListInvitesService,
+	middleware middleware.Middleware,
+) *ListInvitesHTTPHandler {
+	return &ListInvitesHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ListInvitesHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/admin/invites"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListInvitesHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "List the invites",
+		Response: dom_invite.InviteFilterResult{},
+	}
+}
+
+func (r *ListInvitesHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+// unmarshalFilter converts the query parameters into our filter. Supported
+// parameters are `module`, `created_by_user_id`, `limit`, `last_id` and
+// `last_created_at`; dates are in RFC 3339 format.
+func (h *ListInvitesHTTPHandler) unmarshalFilter(r *http.Request) (*dom_invite.InviteFilter, error) {
+	q := r.URL.Query()
+	filter := &dom_invite.InviteFilter{}
+	e := make(map[string]string)
+
+	if v := q.Get("module"); v != "" {
+		module, err := strconv.Atoi(v)
+		if err != nil {
+			e["module"] = "Module must be a number"
+		}
+		filter.Module = module
+	}
+	if v := q.Get("created_by_user_id"); v != "" {
+		userID, err := primitive.ObjectIDFromHex(v)
+		if err != nil {
+			e["created_by_user_id"] = "Invalid ID format"
+		}
+		filter.CreatedByUserID = &userID
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			e["limit"] = "Limit must be a number"
+		}
+		filter.Limit = limit
+	}
+	if v := q.Get("last_id"); v != "" {
+		lastID, err := primitive.ObjectIDFromHex(v)
+		if err != nil {
+			e["last_id"] = "Invalid ID format"
+		}
+		filter.LastID = &lastID
+	}
+	if v := q.Get("last_created_at"); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			e["last_created_at"] = "Invalid date format"
+		}
+		filter.LastCreatedAt = &t
+	}
+
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+	return filter, nil
+}
+
+func (h *ListInvitesHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := h.unmarshalFilter(r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp, err := h.service.Execute(ctx, filter)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
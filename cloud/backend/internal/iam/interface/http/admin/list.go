@@ -0,0 +1,154 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_admin "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type ListFederatedUsersHTTPHandler struct {
+	adminRoute
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_admin.ListFederatedUsersService
+	middleware middleware.Middleware
+}
+
+func NewListFederatedUsersHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_admin.ListFederatedUsersService,
+	middleware middleware.Middleware,
+) *ListFederatedUsersHTTPHandler {
+	return &ListFederatedUsersHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ListFederatedUsersHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/admin/users"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListFederatedUsersHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "List the users",
+		Response: sv_admin.ListFederatedUsersResponseDTO{},
+	}
+}
+
+func (r *ListFederatedUsersHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+// unmarshalFilter converts the query parameters into our filter. Supported
+// parameters are `search`, `name`, `email`, `role`, `status`,
+// `created_at_start`, `created_at_end`, `limit`, `last_id` and
+// `last_created_at`; dates are in RFC 3339 format.
+func (h *ListFederatedUsersHTTPHandler) unmarshalFilter(r *http.Request) (*dom_user.FederatedUserFilter, error) {
+	q := r.URL.Query()
+	filter := &dom_user.FederatedUserFilter{}
+	e := make(map[string]string)
+
+	if v := q.Get("search"); v != "" {
+		filter.SearchTerm = &v
+	}
+	if v := q.Get("name"); v != "" {
+		filter.Name = &v
+	}
+	if v := q.Get("email"); v != "" {
+		filter.Email = &v
+	}
+	if v := q.Get("role"); v != "" {
+		role, err := strconv.ParseInt(v, 10, 8)
+		if err != nil {
+			e["role"] = "Role must be a number"
+		}
+		filter.Role = int8(role)
+	}
+	if v := q.Get("status"); v != "" {
+		status, err := strconv.ParseInt(v, 10, 8)
+		if err != nil {
+			e["status"] = "Status must be a number"
+		}
+		filter.Status = int8(status)
+	}
+	if v := q.Get("created_at_start"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			e["created_at_start"] = "Invalid date format"
+		}
+		filter.CreatedAtStart = &t
+	}
+	if v := q.Get("created_at_end"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			e["created_at_end"] = "Invalid date format"
+		}
+		filter.CreatedAtEnd = &t
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			e["limit"] = "Limit must be a number"
+		}
+		filter.Limit = limit
+	}
+	if v := q.Get("last_id"); v != "" {
+		lastID, err := primitive.ObjectIDFromHex(v)
+		if err != nil {
+			e["last_id"] = "Invalid ID format"
+		}
+		filter.LastID = &lastID
+	}
+	if v := q.Get("last_created_at"); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			e["last_created_at"] = "Invalid date format"
+		}
+		filter.LastCreatedAt = &t
+	}
+
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+	return filter, nil
+}
+
+func (h *ListFederatedUsersHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := h.unmarshalFilter(r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp, err := h.service.Execute(ctx, filter)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
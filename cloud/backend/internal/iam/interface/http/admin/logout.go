@@ -0,0 +1,91 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_admin "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type ForceLogoutFederatedUserHTTPHandler struct {
+	adminRoute
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_admin.ForceLogoutFederatedUserService
+	middleware middleware.Middleware
+}
+
+func NewForceLogoutFederatedUserHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_admin.ForceLogoutFederatedUserService,
+	middleware middleware.Middleware,
+) *ForceLogoutFederatedUserHTTPHandler {
+	return &ForceLogoutFederatedUserHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ForceLogoutFederatedUserHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/admin/users/{id}/logout"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ForceLogoutFederatedUserHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary: "Sign a user out of every session",
+		Status:  http.StatusNoContent,
+	}
+}
+
+func (r *ForceLogoutFederatedUserHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *ForceLogoutFederatedUserHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "Invalid user ID format"))
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		if err := h.service.Execute(sessCtx, id); err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Start the transaction
+	if _, err := session.WithTransaction(ctx, transactionFunc); err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,90 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_admin "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type DeleteOAuthClientHTTPHandler struct {
+	adminRoute
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_admin.DeleteOAuthClientService
+	middleware middleware.Middleware
+}
+
+func NewDeleteOAuthClientHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_admin.DeleteOAuthClientService,
+	middleware middleware.Middleware,
+) *DeleteOAuthClientHTTPHandler {
+	return &DeleteOAuthClientHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*DeleteOAuthClientHTTPHandler) Pattern() string {
+	return "DELETE /iam/api/v1/admin/oauth-clients/{client_id}"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*DeleteOAuthClientHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary: "Delete an OAuth client",
+		Status:  http.StatusNoContent,
+	}
+}
+
+func (r *DeleteOAuthClientHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *DeleteOAuthClientHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	clientID := r.PathValue("client_id")
+	if clientID == "" {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("client_id", "Client ID is required"))
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		if err := h.service.Execute(sessCtx, clientID); err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Start the transaction
+	if _, err := session.WithTransaction(ctx, transactionFunc); err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_admin "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type ListOAuthClientsHTTPHandler struct {
+	adminRoute
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_admin.ListOAuthClientsService
+	middleware middleware.Middleware
+}
+
+func NewListOAuthClientsHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_admin.ListOAuthClientsService,
+	middleware middleware.Middleware,
+) *ListOAuthClientsHTTPHandler {
+	return &ListOAuthClientsHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ListOAuthClientsHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/admin/oauth-clients"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListOAuthClientsHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "List the OAuth clients",
+		Response: []*dom_oauthclient.OAuthClient{},
+	}
+}
+
+func (r *ListOAuthClientsHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *ListOAuthClientsHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.service.Execute(ctx)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
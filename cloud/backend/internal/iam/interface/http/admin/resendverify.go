@@ -0,0 +1,136 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_admin "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type ResendFederatedUserVerificationHTTPHandler struct {
+	adminRoute
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_admin.ResendFederatedUserVerificationService
+	middleware middleware.Middleware
+}
+
+func NewResendFederatedUserVerificationHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_admin.ResendFederatedUserVerificationService,
+	middleware middleware.Middleware,
+) *ResendFederatedUserVerificationHTTPHandler {
+	return &ResendFederatedUserVerificationHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ResendFederatedUserVerificationHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/admin/users/{id}/resend-verification"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ResendFederatedUserVerificationHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary: "Send the verification email of a user again",
+		Request: sv_admin.ResendFederatedUserVerificationRequestDTO{},
+		Status:  http.StatusNoContent,
+	}
+}
+
+// Idempotent lets the retries sent with the same idempotency key replay
+// the first response
+func (*ResendFederatedUserVerificationHTTPHandler) Idempotent() bool {
+	return true
+}
+
+func (r *ResendFederatedUserVerificationHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *ResendFederatedUserVerificationHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*sv_admin.ResendFederatedUserVerificationRequestDTO, error) {
+	var requestData sv_admin.ResendFederatedUserVerificationRequestDTO
+
+	defer r.Body.Close()
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang struct
+	err := json.NewDecoder(teeReader).Decode(&requestData)
+	if err == io.EOF {
+		// The payload is optional for this endpoint.
+		return &requestData, nil
+	}
+	if err != nil {
+		h.logger.Error("decoding error",
+			zap.Any("err", err),
+			zap.String("json", rawJSON.String()),
+		)
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
+	}
+
+	return &requestData, nil
+}
+
+func (h *ResendFederatedUserVerificationHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "Invalid user ID format"))
+		return
+	}
+
+	data, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		if err := h.service.Execute(sessCtx, id, data); err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Start the transaction
+	_, err = session.WithTransaction(ctx, transactionFunc)
+	if err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
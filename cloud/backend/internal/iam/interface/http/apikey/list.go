@@ -0,0 +1,75 @@
+// cloud/backend/internal/iam/interface/http/apikey/list.go
+package apikey
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
+)
+
+type ListAPIKeysHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_apikey.ListAPIKeysService
+	middleware middleware.Middleware
+}
+
+func NewListAPIKeysHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_apikey.ListAPIKeysService,
+	middleware middleware.Middleware,
+) *ListAPIKeysHTTPHandler {
+	return &ListAPIKeysHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ListAPIKeysHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/api-keys"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListAPIKeysHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "List the API keys of the user",
+		Response: sv_apikey.ListAPIKeysResponseDTO{},
+	}
+}
+
+func (*ListAPIKeysHTTPHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionAPIKeysManage}
+}
+
+func (r *ListAPIKeysHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *ListAPIKeysHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.service.Execute(ctx)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
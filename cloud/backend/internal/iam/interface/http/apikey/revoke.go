@@ -0,0 +1,76 @@
+// cloud/backend/internal/iam/interface/http/apikey/revoke.go
+package apikey
+
+import (
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
+)
+
+type RevokeAPIKeyHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_apikey.RevokeAPIKeyService
+	middleware middleware.Middleware
+}
+
+func NewRevokeAPIKeyHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_apikey.RevokeAPIKeyService,
+	middleware middleware.Middleware,
+) *RevokeAPIKeyHTTPHandler {
+	return &RevokeAPIKeyHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*RevokeAPIKeyHTTPHandler) Pattern() string {
+	return "DELETE /iam/api/v1/api-keys/{id}"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*RevokeAPIKeyHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary: "Revoke an API key of the user",
+		Status:  http.StatusNoContent,
+	}
+}
+
+func (*RevokeAPIKeyHTTPHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionAPIKeysManage}
+}
+
+func (r *RevokeAPIKeyHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *RevokeAPIKeyHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "Invalid API key ID format"))
+		return
+	}
+
+	if err := h.service.Execute(ctx, id); err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,124 @@
+package consent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_consent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/consent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type AcceptTermsOfServiceHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_consent.AcceptTermsOfServiceService
+	middleware middleware.Middleware
+}
+
+func NewAcceptTermsOfServiceHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_consent.AcceptTermsOfServiceService,
+	middleware middleware.Middleware,
+) *AcceptTermsOfServiceHTTPHandler {
+	return &AcceptTermsOfServiceHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*AcceptTermsOfServiceHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/me/consents/terms-of-service"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*AcceptTermsOfServiceHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Accept the current terms of service",
+		Request:       sv_consent.AcceptTermsOfServiceRequestDTO{},
+		Status:        http.StatusNoContent,
+		Authenticated: true,
+	}
+}
+
+// Idempotent lets the retries sent with the same idempotency key replay
+// the first response
+func (*AcceptTermsOfServiceHTTPHandler) Idempotent() bool {
+	return true
+}
+
+func (r *AcceptTermsOfServiceHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *AcceptTermsOfServiceHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*sv_consent.AcceptTermsOfServiceRequestDTO, error) {
+	var requestData sv_consent.AcceptTermsOfServiceRequestDTO
+
+	defer r.Body.Close()
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang struct
+	err := json.NewDecoder(teeReader).Decode(&requestData)
+	if err != nil {
+		h.logger.Error("decoding error",
+			zap.Any("err", err),
+			zap.String("json", rawJSON.String()),
+		)
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
+	}
+
+	return &requestData, nil
+}
+
+func (h *AcceptTermsOfServiceHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		if err := h.service.Execute(sessCtx, data); err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Start the transaction
+	if _, err := session.WithTransaction(ctx, transactionFunc); err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
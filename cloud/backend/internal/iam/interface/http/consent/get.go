@@ -0,0 +1,70 @@
+package consent
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_consent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/consent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type GetMyConsentsHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_consent.GetMyConsentsService
+	middleware middleware.Middleware
+}
+
+func NewGetMyConsentsHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_consent.GetMyConsentsService,
+	middleware middleware.Middleware,
+) *GetMyConsentsHTTPHandler {
+	return &GetMyConsentsHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*GetMyConsentsHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/me/consents"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GetMyConsentsHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Get the consents of the user",
+		Response:      sv_consent.ConsentsResponseDTO{},
+		Authenticated: true,
+	}
+}
+
+func (r *GetMyConsentsHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *GetMyConsentsHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.service.Execute(ctx)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
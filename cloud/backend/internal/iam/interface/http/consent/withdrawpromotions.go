@@ -0,0 +1,84 @@
+package consent
+
+import (
+	"context"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_consent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/consent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type WithdrawPromotionsConsentHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_consent.WithdrawPromotionsConsentService
+	middleware middleware.Middleware
+}
+
+func NewWithdrawPromotionsConsentHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_consent.WithdrawPromotionsConsentService,
+	middleware middleware.Middleware,
+) *WithdrawPromotionsConsentHTTPHandler {
+	return &WithdrawPromotionsConsentHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*WithdrawPromotionsConsentHTTPHandler) Pattern() string {
+	return "DELETE /iam/api/v1/me/consents/promotions"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*WithdrawPromotionsConsentHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Withdraw the consent to receive promotions",
+		Status:        http.StatusNoContent,
+		Authenticated: true,
+	}
+}
+
+func (r *WithdrawPromotionsConsentHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *WithdrawPromotionsConsentHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		if err := h.service.Execute(sessCtx); err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Start the transaction
+	if _, err := session.WithTransaction(ctx, transactionFunc); err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
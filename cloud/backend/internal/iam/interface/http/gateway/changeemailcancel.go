@@ -0,0 +1,131 @@
+// cloud/backend/internal/iam/interface/http/gateway/changeemailcancel.go
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	_ "time/tzdata"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type GatewayCancelEmailChangeHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_gateway.GatewayCancelEmailChangeService
+	middleware middleware.Middleware
+}
+
+func NewGatewayCancelEmailChangeHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_gateway.GatewayCancelEmailChangeService,
+	middleware middleware.Middleware,
+) *GatewayCancelEmailChangeHTTPHandler {
+	return &GatewayCancelEmailChangeHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*GatewayCancelEmailChangeHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/change-email/cancel"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayCancelEmailChangeHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Cancel the change of the email address",
+		Request:  sv_gateway.GatewayCancelEmailChangeRequestIDO{},
+		Response: sv_gateway.GatewayCancelEmailChangeResponseIDO{},
+	}
+}
+
+func (r *GatewayCancelEmailChangeHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *GatewayCancelEmailChangeHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*sv_gateway.GatewayCancelEmailChangeRequestIDO, error) {
+	var requestData sv_gateway.GatewayCancelEmailChangeRequestIDO
+
+	defer r.Body.Close()
+
+	h.logger.Debug("beginning to decode json payload for api request ...",
+		zap.String("api", "/iam/api/v1/change-email/cancel"))
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang struct
+	err := json.NewDecoder(teeReader).Decode(&requestData)
+	if err != nil {
+		// Developers Note: do not log the raw payload as it contains the cancel token.
+		h.logger.Error("decoding error", zap.Any("err", err))
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
+	}
+
+	h.logger.Debug("successfully decoded json payload api request",
+		zap.String("api", "/iam/api/v1/change-email/cancel"))
+
+	return &requestData, nil
+}
+
+func (h *GatewayCancelEmailChangeHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		resp, err := h.service.Execute(sessCtx, data)
+		if err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	// Start the transaction
+	result, err := session.WithTransaction(ctx, transactionFunc)
+	if err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp := result.(*sv_gateway.GatewayCancelEmailChangeResponseIDO)
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
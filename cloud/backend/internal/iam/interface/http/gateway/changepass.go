@@ -0,0 +1,132 @@
+// cloud/backend/internal/iam/interface/http/gateway/changepass.go
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	_ "time/tzdata"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type GatewayChangePasswordHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_gateway.GatewayChangePasswordService
+	middleware middleware.Middleware
+}
+
+func NewGatewayChangePasswordHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_gateway.GatewayChangePasswordService,
+	middleware middleware.Middleware,
+) *GatewayChangePasswordHTTPHandler {
+	return &GatewayChangePasswordHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*GatewayChangePasswordHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/change-password"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayChangePasswordHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Change the password by answering the challenge",
+		Request:       sv_gateway.GatewayChangePasswordRequestIDO{},
+		Response:      sv_gateway.GatewayChangePasswordResponseIDO{},
+		Authenticated: true,
+	}
+}
+
+func (r *GatewayChangePasswordHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *GatewayChangePasswordHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*sv_gateway.GatewayChangePasswordRequestIDO, error) {
+	var requestData sv_gateway.GatewayChangePasswordRequestIDO
+
+	defer r.Body.Close()
+
+	h.logger.Debug("beginning to decode json payload for api request ...",
+		zap.String("api", "/iam/api/v1/change-password"))
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang struct
+	err := json.NewDecoder(teeReader).Decode(&requestData)
+	if err != nil {
+		// Developers Note: do not log the raw payload as it contains key material.
+		h.logger.Error("decoding error", zap.Any("err", err))
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
+	}
+
+	h.logger.Debug("successfully decoded json payload api request",
+		zap.String("api", "/iam/api/v1/change-password"))
+
+	return &requestData, nil
+}
+
+func (h *GatewayChangePasswordHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		resp, err := h.service.Execute(sessCtx, data)
+		if err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	// Start the transaction
+	result, err := session.WithTransaction(ctx, transactionFunc)
+	if err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp := result.(*sv_gateway.GatewayChangePasswordResponseIDO)
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,72 @@
+// cloud/backend/internal/iam/interface/http/gateway/changepasschallenge.go
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	_ "time/tzdata"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type GatewayChangePasswordChallengeHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_gateway.GatewayChangePasswordChallengeService
+	middleware middleware.Middleware
+}
+
+func NewGatewayChangePasswordChallengeHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_gateway.GatewayChangePasswordChallengeService,
+	middleware middleware.Middleware,
+) *GatewayChangePasswordChallengeHTTPHandler {
+	return &GatewayChangePasswordChallengeHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*GatewayChangePasswordChallengeHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/change-password/challenge"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayChangePasswordChallengeHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Request the challenge needed to change the password",
+		Response:      sv_gateway.GatewayChangePasswordChallengeResponseIDO{},
+		Authenticated: true,
+	}
+}
+
+func (r *GatewayChangePasswordChallengeHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *GatewayChangePasswordChallengeHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.service.Execute(ctx)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type GatewayCompleteLoginHTTPHandler struct {
@@ -43,6 +45,15 @@ func (*GatewayCompleteLoginHTTPHandler) Pattern() string {
 	return "POST /iam/api/v1/complete-login"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayCompleteLoginHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Complete the login by answering the challenge",
+		Request:  sv_gateway.GatewayCompleteLoginRequestIDO{},
+		Response: sv_gateway.GatewayCompleteLoginResponseIDO{},
+	}
+}
+
 func (r *GatewayCompleteLoginHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
@@ -69,7 +80,7 @@ func (h *GatewayCompleteLoginHTTPHandler) unmarshalRequest(
 			zap.Any("err", err),
 			zap.String("json", rawJSON.String()),
 		)
-		return nil, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong")
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
 	}
 
 	// Defensive Code: Sanitize inputs
@@ -117,6 +128,7 @@ func (h *GatewayCompleteLoginHTTPHandler) Execute(w http.ResponseWriter, r *http
 		httperror.ResponseError(w, err)
 		return
 	}
+	metrics.IncLogins()
 
 	resp := result.(*sv_gateway.GatewayCompleteLoginResponseIDO)
 
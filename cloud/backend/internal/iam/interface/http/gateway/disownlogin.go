@@ -0,0 +1,131 @@
+// cloud/backend/internal/iam/interface/http/gateway/disownlogin.go
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	_ "time/tzdata"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type GatewayDisownLoginHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_gateway.GatewayDisownLoginService
+	middleware middleware.Middleware
+}
+
+func NewGatewayDisownLoginHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_gateway.GatewayDisownLoginService,
+	middleware middleware.Middleware,
+) *GatewayDisownLoginHTTPHandler {
+	return &GatewayDisownLoginHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*GatewayDisownLoginHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/disown-login"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayDisownLoginHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Disown a login which was not made by the account owner",
+		Request:  sv_gateway.GatewayDisownLoginRequestIDO{},
+		Response: sv_gateway.GatewayDisownLoginResponseIDO{},
+	}
+}
+
+func (r *GatewayDisownLoginHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *GatewayDisownLoginHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*sv_gateway.GatewayDisownLoginRequestIDO, error) {
+	var requestData sv_gateway.GatewayDisownLoginRequestIDO
+
+	defer r.Body.Close()
+
+	h.logger.Debug("beginning to decode json payload for api request ...",
+		zap.String("api", "/iam/api/v1/disown-login"))
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang struct
+	err := json.NewDecoder(teeReader).Decode(&requestData)
+	if err != nil {
+		// Developers Note: do not log the raw payload as it contains the disown token.
+		h.logger.Error("decoding error", zap.Any("err", err))
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
+	}
+
+	h.logger.Debug("successfully decoded json payload api request",
+		zap.String("api", "/iam/api/v1/disown-login"))
+
+	return &requestData, nil
+}
+
+func (h *GatewayDisownLoginHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		resp, err := h.service.Execute(sessCtx, data)
+		if err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	// Start the transaction
+	result, err := session.WithTransaction(ctx, transactionFunc)
+	if err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp := result.(*sv_gateway.GatewayDisownLoginResponseIDO)
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type GatewayForgotPasswordHTTPHandler struct {
@@ -41,6 +42,16 @@ func (*GatewayForgotPasswordHTTPHandler) Pattern() string {
 	return "POST /iam/api/v1/forgot-password"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayForgotPasswordHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Start the recovery of a forgotten password",
+		Request:  sv_gateway.GatewayForgotPasswordRequestIDO{},
+		Response: sv_gateway.GatewayForgotPasswordResponseIDO{},
+		Status:   http.StatusCreated,
+	}
+}
+
 func (r *GatewayForgotPasswordHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply MaplesSend middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
@@ -68,7 +79,7 @@ func (h *GatewayForgotPasswordHTTPHandler) unmarshalLoginRequest(
 			zap.Any("err", err),
 			zap.String("json", rawJSON.String()),
 		)
-		return nil, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong")
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
 	}
 
 	h.logger.Debug("successfully decoded json payload api request", zap.String("api", "/iam/api/v1/forgot-password"))
@@ -11,6 +11,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type GatewayLogoutHTTPHandler struct {
@@ -43,6 +44,15 @@ func (*GatewayLogoutHTTPHandler) Pattern() string {
 	return "POST /iam/api/v1/logout"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayLogoutHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Log out of the current session",
+		Status:        http.StatusNoContent,
+		Authenticated: true,
+	}
+}
+
 func (h *GatewayLogoutHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	if err := h.service.Execute(ctx); err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type GatewayRefreshTokenHTTPHandler struct {
@@ -42,6 +43,16 @@ func (*GatewayRefreshTokenHTTPHandler) Pattern() string {
 	return "POST /iam/api/v1/token/refresh"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayRefreshTokenHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Exchange a refresh token for new tokens",
+		Request:  sv_gateway.GatewayRefreshTokenRequestIDO{},
+		Response: sv_gateway.GatewayRefreshTokenResponseIDO{},
+		Status:   http.StatusCreated,
+	}
+}
+
 func (r *GatewayRefreshTokenHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply MaplesSend middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
@@ -67,7 +78,7 @@ func (h *GatewayRefreshTokenHTTPHandler) unmarshalRefreshTokenRequest(
 			zap.Any("err", err),
 			zap.String("json", rawJSON.String()),
 		)
-		return nil, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong")
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
 	}
 
 	return &requestData, nil
@@ -17,6 +17,8 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type GatewayFederatedUserRegisterHTTPHandler struct {
@@ -44,6 +46,22 @@ func (*GatewayFederatedUserRegisterHTTPHandler) Pattern() string {
 	return "POST /iam/api/v1/register"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayFederatedUserRegisterHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Register a new account",
+		Request:  sv_gateway.RegisterCustomerRequestIDO{},
+		Response: map[string]string{},
+		Status:   http.StatusCreated,
+	}
+}
+
+// Idempotent lets the retries sent with the same idempotency key replay
+// the first response, so they never send the email again
+func (*GatewayFederatedUserRegisterHTTPHandler) Idempotent() bool {
+	return true
+}
+
 func (r *GatewayFederatedUserRegisterHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply MaplesSend middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
@@ -69,7 +87,7 @@ func (h *GatewayFederatedUserRegisterHTTPHandler) unmarshalRegisterCustomerReque
 			zap.Any("err", err),
 			zap.String("json", rawJSON.String()),
 		)
-		return nil, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong")
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
 	}
 
 	// Defensive Code: For security purposes we need to remove all whitespaces from the email and lower the characters.
@@ -114,6 +132,7 @@ func (h *GatewayFederatedUserRegisterHTTPHandler) Execute(w http.ResponseWriter,
 		httperror.ResponseError(w, txErr)
 		return
 	}
+	metrics.IncRegistrations()
 
 	// If transaction succeeds, return success response
 	response := map[string]interface{}{
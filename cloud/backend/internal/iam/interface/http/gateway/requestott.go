@@ -16,6 +16,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type GatewayRequestLoginOTTHTTPHandler struct {
@@ -43,6 +44,21 @@ func (*GatewayRequestLoginOTTHTTPHandler) Pattern() string {
 	return "POST /iam/api/v1/request-login-ott"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayRequestLoginOTTHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Request a one-time login code by email",
+		Request:  sv_gateway.GatewayRequestLoginOTTRequestIDO{},
+		Response: sv_gateway.GatewayRequestLoginOTTResponseIDO{},
+	}
+}
+
+// Idempotent lets the retries sent with the same idempotency key replay
+// the first response, so they never send the email again
+func (*GatewayRequestLoginOTTHTTPHandler) Idempotent() bool {
+	return true
+}
+
 func (r *GatewayRequestLoginOTTHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
@@ -69,7 +85,7 @@ func (h *GatewayRequestLoginOTTHTTPHandler) unmarshalRequest(
 			zap.Any("err", err),
 			zap.String("json", rawJSON.String()),
 		)
-		return nil, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong")
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
 	}
 
 	// Defensive Code: For security purposes we need to remove all whitespaces from the email and lower the characters.
@@ -15,6 +15,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type GatewayResetPasswordHTTPHandler struct {
@@ -42,6 +43,16 @@ func (*GatewayResetPasswordHTTPHandler) Pattern() string {
 	return "POST /iam/api/v1/reset-password"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayResetPasswordHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Reset the password with the recovery key",
+		Request:  sv_gateway.GatewayResetPasswordRequestIDO{},
+		Response: sv_gateway.GatewayResetPasswordResponseIDO{},
+		Status:   http.StatusCreated,
+	}
+}
+
 func (r *GatewayResetPasswordHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply MaplesSend middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
@@ -70,7 +81,7 @@ func (h *GatewayResetPasswordHTTPHandler) unmarshalLoginRequest(
 			zap.Any("err", err),
 			zap.String("json", rawJSON.String()),
 		)
-		return nil, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong")
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
 	}
 
 	h.logger.Debug("successfully decoded json payload api request",
@@ -15,6 +15,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type GatewayVerifyEmailHTTPHandler struct {
@@ -42,6 +43,16 @@ func (*GatewayVerifyEmailHTTPHandler) Pattern() string {
 	return "POST /iam/api/v1/verify-email-code"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayVerifyEmailHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Verify the email address of an account",
+		Request:  sv_gateway.GatewayVerifyEmailRequestIDO{},
+		Response: sv_gateway.GatwayVerifyEmailResponseIDO{},
+		Status:   http.StatusCreated,
+	}
+}
+
 func (r *GatewayVerifyEmailHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply MaplesSend middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
@@ -67,7 +78,7 @@ func (h *GatewayVerifyEmailHTTPHandler) unmarshalVerifyRequest(
 			zap.Any("err", err),
 			zap.String("json", rawJSON.String()),
 		)
-		return nil, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong")
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
 	}
 
 	// Defensive Code: For security purposes we need to remove all whitespaces from the email and lower the characters.
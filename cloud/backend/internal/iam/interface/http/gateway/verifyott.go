@@ -16,6 +16,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type GatewayVerifyLoginOTTHTTPHandler struct {
@@ -43,6 +44,15 @@ func (*GatewayVerifyLoginOTTHTTPHandler) Pattern() string {
 	return "POST /iam/api/v1/verify-login-ott"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GatewayVerifyLoginOTTHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Verify a one-time login code and receive the login challenge",
+		Request:  sv_gateway.GatewayVerifyLoginOTTRequestIDO{},
+		Response: sv_gateway.GatewayVerifyLoginOTTResponseIDO{},
+	}
+}
+
 func (r *GatewayVerifyLoginOTTHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
@@ -69,7 +79,7 @@ func (h *GatewayVerifyLoginOTTHTTPHandler) unmarshalRequest(
 			zap.Any("err", err),
 			zap.String("json", rawJSON.String()),
 		)
-		return nil, httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong")
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
 	}
 
 	// Defensive Code: Sanitize inputs
@@ -0,0 +1,127 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
+)
+
+// memoryCache is a `mongodbcache.Cacher` which, like the real one, is not
+// part of the database transactions.
+type memoryCache struct {
+	mu   sync.Mutex
+	vals map[string][]byte
+}
+
+func (c *memoryCache) Shutdown(context.Context) {}
+
+func (c *memoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	val, ok := c.vals[key]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return val, nil
+}
+
+func (c *memoryCache) Set(ctx context.Context, key string, val []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.vals[key] = val
+	return nil
+}
+
+func (c *memoryCache) SetWithExpiry(ctx context.Context, key string, val []byte, expiry time.Duration) error {
+	return c.Set(ctx, key, val)
+}
+
+func (c *memoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.vals, key)
+	return nil
+}
+
+// abortedUserStore returns the same user on every lookup and discards the
+// updates, like the transactions which are aborted by a service error.
+type abortedUserStore struct {
+	user dom_user.FederatedUser
+}
+
+func (s *abortedUserStore) GetByEmail(ctx context.Context, email string) (*dom_user.FederatedUser, error) {
+	user := s.user
+	return &user, nil
+}
+
+func (s *abortedUserStore) Update(ctx context.Context, user *dom_user.FederatedUser) error {
+	return nil
+}
+
+type getByEmailFunc func(ctx context.Context, email string) (*dom_user.FederatedUser, error)
+
+func (f getByEmailFunc) Execute(ctx context.Context, email string) (*dom_user.FederatedUser, error) {
+	return f(ctx, email)
+}
+
+type updateFunc func(ctx context.Context, user *dom_user.FederatedUser) error
+
+func (f updateFunc) Execute(ctx context.Context, user *dom_user.FederatedUser) error {
+	return f(ctx, user)
+}
+
+func TestVerifyRecoveryLimitsAttemptsAcrossAbortedTransactions(t *testing.T) {
+	// The client never reaches a server: the transactions only run client
+	// side as the service touches the database through the fakes.
+	dbClient, err := mongo.Connect(options.Client().ApplyURI("mongodb://127.0.0.1:1"))
+	require.NoError(t, err)
+	defer dbClient.Disconnect(context.Background())
+
+	cfg := &config.Configuration{}
+	cfg.App.LoginOTTMaxAttempts = 3
+
+	store := &abortedUserStore{user: dom_user.FederatedUser{
+		ID:                                primitive.NewObjectID(),
+		Email:                             "alice@example.com",
+		PasswordResetVerificationCode:     "123456",
+		PasswordResetVerificationExpiry:   time.Now().Add(5 * time.Minute),
+		MasterKeyEncryptedWithRecoveryKey: "wrapped",
+	}}
+	service := sv_gateway.NewGatewayVerifyRecoveryService(
+		cfg,
+		zap.NewNop(),
+		&memoryCache{vals: map[string][]byte{}},
+		getByEmailFunc(store.GetByEmail),
+		updateFunc(store.Update),
+	)
+	h := NewGatewayVerifyRecoveryHTTPHandler(zap.NewNop(), dbClient, service, nil)
+
+	verify := func(code string) int {
+		body := `{"email":"alice@example.com","code":"` + code + `"}`
+		r := httptest.NewRequest(http.MethodPost, "/iam/api/v1/verify-recovery", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.Execute(w, r)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusBadRequest, verify("000000"))
+	assert.Equal(t, http.StatusBadRequest, verify("000001"))
+	assert.Equal(t, http.StatusTooManyRequests, verify("000002"))
+	assert.Equal(t, http.StatusTooManyRequests, verify("123456"), "the right code was accepted after the limit")
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
+)
+
+// AuthorizationMiddleware enforces the roles and permissions declared by the
+// route. It must run after `PostJWTProcessorMiddleware` so the role of the
+// authenticated user is available in the context.
+func (mid *middleware) AuthorizationMiddleware(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		req, ok := ctx.Value(constants.SessionAuthorizationRequirement).(*authorization.Requirement)
+		if ok && !req.IsEmpty() {
+			// Unauthenticated requests have no role and are always denied.
+			role, _ := ctx.Value(constants.SessionFederatedUserRole).(int8)
+			if !mid.authorization.IsAuthorized(role, req) {
+				httperror.ResponseError(w, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "you do not have permission to access this resource"), httperror.CodePermissionDenied))
+				return
+			}
+		}
+
+		fn(w, r)
+	}
+}
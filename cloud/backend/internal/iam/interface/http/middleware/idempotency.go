@@ -0,0 +1,154 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/idempotency"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
+)
+
+// IdempotencyMiddleware replays the response of the first POST or PATCH
+// request sent with an `Idempotency-Key` to the retries with the same key,
+// so retrying on a flaky network never creates duplicates. A retry with
+// another payload is rejected with `422 Unprocessable Entity`.
+//
+// Only the routes implementing `IdempotentRoute` honour the key, as the
+// responses are stored in the cache. It must run after the JWT and the
+// authorization middlewares so the rejected requests are never read and
+// the keys are scoped by the authenticated user, see `idempotencyScope`.
+func (mid *middleware) IdempotencyMiddleware(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		key := r.Header.Get(idempotency.HeaderKey)
+		enabled, _ := ctx.Value(constants.SessionIdempotentRoute).(bool)
+		if key == "" || !enabled || !idempotency.Applies(r.Method) || mid.config.App.IdempotencyKeyTTLSeconds <= 0 {
+			fn(w, r)
+			return
+		}
+
+		if !idempotency.ValidKey(key) {
+			httperror.ResponseError(w, httperror.WithCode(
+				httperror.NewForBadRequestWithSingleField("idempotency_key", "Idempotency key must be 1 to 255 visible ASCII characters"),
+				httperror.CodeInvalidIdempotencyKey))
+			return
+		}
+
+		// The body is copied to be fingerprinted, larger ones to disk.
+		if mid.config.App.IdempotencyMaxBodyBytes > 0 && r.Body != nil {
+			r.Body = http.MaxBytesReader(w, r.Body, int64(mid.config.App.IdempotencyMaxBodyBytes))
+		}
+		fingerprint, release, err := idempotency.Fingerprint(r)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				httperror.ResponseError(w, httperror.WithCode(
+					httperror.NewForSingleField(http.StatusRequestEntityTooLarge, "message", "Request body is too large"),
+					httperror.CodeRequestTooLarge))
+				return
+			}
+			logging.Logger(ctx, mid.logger).Warn("failed reading request body for idempotency key", zap.Error(err))
+			httperror.ResponseError(w, httperror.WithCode(
+				httperror.NewForBadRequestWithSingleField("message", "Failed to read the request body"),
+				httperror.CodeMalformedRequest))
+			return
+		}
+		defer release()
+
+		cacheKey := idempotency.CacheKey(idempotencyScope(ctx, fingerprint), key)
+
+		// Serialise the concurrent duplicates so they wait for the first
+		// request and replay its response. The lock is released even if the
+		// client went away, otherwise the retries would wait for it to expire.
+		mid.distributedMutex.Acquire(ctx, cacheKey+":lock")
+		defer mid.distributedMutex.Release(context.WithoutCancel(ctx), cacheKey+":lock")
+
+		if val, err := mid.cache.Get(ctx, cacheKey); err == nil && len(val) > 0 {
+			stored := &idempotency.Response{}
+			if err := json.Unmarshal(val, stored); err != nil {
+				logging.Logger(ctx, mid.logger).Error("failed unmarshalling idempotent response", zap.Error(err))
+			} else if stored.Fingerprint != fingerprint {
+				logging.Logger(ctx, mid.logger).Warn("idempotency key reused with another payload",
+					zap.String("pattern", r.Pattern))
+				httperror.ResponseError(w, httperror.WithCode(
+					httperror.NewForSingleField(http.StatusUnprocessableEntity, "idempotency_key", "Idempotency key was already used for another request"),
+					httperror.CodeIdempotencyKeyReused))
+				return
+			} else if stored.IsPending() {
+				// The lock could not be obtained in time, the first request
+				// is still being served.
+				httperror.ResponseError(w, httperror.WithCode(
+					httperror.NewForSingleField(http.StatusConflict, "idempotency_key", "A request with this idempotency key is in progress, please try again later"),
+					httperror.CodeIdempotencyKeyInUse))
+				return
+			} else {
+				logging.Logger(ctx, mid.logger).Debug("replaying idempotent response",
+					zap.String("pattern", r.Pattern),
+					zap.Int("status", stored.Status))
+				stored.Replay(w)
+				return
+			}
+		}
+
+		// Mark the key as in progress until the request is served, for the
+		// duplicates which gave up waiting for the lock. The mark expires
+		// once the request would have timed out in case this server died.
+		pendingTTL := time.Duration(mid.config.App.HTTPReadTimeoutSeconds+mid.config.App.HTTPWriteTimeoutSeconds) * time.Second
+		if pendingTTL <= 0 {
+			pendingTTL = time.Minute
+		}
+		mid.storeIdempotentResponse(ctx, cacheKey, &idempotency.Response{Fingerprint: fingerprint}, pendingTTL)
+
+		rec := idempotency.NewRecorder(w)
+		fn(rec, r)
+
+		// The response is saved even if the client went away, it is the one
+		// waiting for it the most.
+		ctx = context.WithoutCancel(ctx)
+		if resp, ok := rec.Response(fingerprint); ok {
+			ttl := time.Duration(mid.config.App.IdempotencyKeyTTLSeconds) * time.Second
+			mid.storeIdempotentResponse(ctx, cacheKey, resp, ttl)
+			return
+		}
+
+		// Failures which may not repeat are executed again on retry.
+		if err := mid.cache.Delete(ctx, cacheKey); err != nil {
+			logging.Logger(ctx, mid.logger).Error("failed deleting idempotent response", zap.Error(err))
+		}
+	}
+}
+
+// idempotencyScope returns the scope of the idempotency keys of the request
+// so clients never see the responses of one another: the authenticated user
+// and the organization it acts for, which unlike the access tokens survive
+// a refresh. The anonymous requests, like the registrations, are scoped by
+// their `fingerprint` as the client IP address is shared by too many
+// clients behind a NAT, only a client sending the same payload again can
+// replay their response.
+func idempotencyScope(ctx context.Context, fingerprint string) string {
+	userID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok || userID.IsZero() {
+		return "anonymous:" + fingerprint
+	}
+	orgID, _ := ctx.Value(constants.SessionOrganizationID).(primitive.ObjectID)
+	return "user:" + userID.Hex() + ":" + orgID.Hex()
+}
+
+func (mid *middleware) storeIdempotentResponse(ctx context.Context, cacheKey string, resp *idempotency.Response, ttl time.Duration) {
+	val, err := json.Marshal(resp)
+	if err != nil {
+		logging.Logger(ctx, mid.logger).Error("failed marshalling idempotent response", zap.Error(err))
+		return
+	}
+	if err := mid.cache.SetWithExpiry(ctx, cacheKey, val, ttl); err != nil {
+		logging.Logger(ctx, mid.logger).Error("failed storing idempotent response", zap.Error(err))
+	}
+}
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/idempotency"
+)
+
+type memoryCache struct {
+	mu   sync.Mutex
+	vals map[string][]byte
+}
+
+func (c *memoryCache) Shutdown(context.Context) {}
+
+func (c *memoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	val, ok := c.vals[key]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return val, nil
+}
+
+func (c *memoryCache) Set(ctx context.Context, key string, val []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.vals[key] = val
+	return nil
+}
+
+func (c *memoryCache) SetWithExpiry(ctx context.Context, key string, val []byte, expiry time.Duration) error {
+	return c.Set(ctx, key, val)
+}
+
+func (c *memoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.vals, key)
+	return nil
+}
+
+type noopMutex struct{}
+
+func (noopMutex) Acquire(ctx context.Context, key string)               {}
+func (noopMutex) Acquiref(ctx context.Context, format string, a ...any) {}
+func (noopMutex) Release(ctx context.Context, key string)               {}
+func (noopMutex) Releasef(ctx context.Context, format string, a ...any) {}
+
+func newIdempotencyTestMiddleware() *middleware {
+	cfg := &config.Configuration{}
+	cfg.App.IdempotencyKeyTTLSeconds = 60
+	cfg.App.IdempotencyMaxBodyBytes = 1 << 10
+	return &middleware{
+		config:           cfg,
+		logger:           zap.NewNop(),
+		cache:            &memoryCache{vals: map[string][]byte{}},
+		distributedMutex: noopMutex{},
+	}
+}
+
+// serveIdempotent sends a request with the idempotency `key` to a route
+// opted into idempotency, counting the runs of the handler in `calls`.
+func serveIdempotent(mid *middleware, calls *int, userID primitive.ObjectID, key, body string) *httptest.ResponseRecorder {
+	h := mid.IdempotencyMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		w.WriteHeader(http.StatusCreated)
+	})
+	r := httptest.NewRequest(http.MethodPost, "/iam/api/v1/register", strings.NewReader(body))
+	r.Header.Set(idempotency.HeaderKey, key)
+	ctx := context.WithValue(r.Context(), constants.SessionIdempotentRoute, true)
+	if !userID.IsZero() {
+		ctx = context.WithValue(ctx, constants.SessionFederatedUserID, userID)
+	}
+	w := httptest.NewRecorder()
+	h(w, r.WithContext(ctx))
+	return w
+}
+
+func TestIdempotencyMiddlewareReplaysAnonymousRetries(t *testing.T) {
+	mid := newIdempotencyTestMiddleware()
+	calls := 0
+
+	serveIdempotent(mid, &calls, primitive.NilObjectID, "key-1", `{"email":"alice@example.com"}`)
+	w := serveIdempotent(mid, &calls, primitive.NilObjectID, "key-1", `{"email":"alice@example.com"}`)
+	assert.Equal(t, 1, calls, "the retry was executed again")
+	assert.Equal(t, "true", w.Header().Get(idempotency.HeaderReplayed))
+
+	// Another client guessing the key cannot replay the response.
+	serveIdempotent(mid, &calls, primitive.NilObjectID, "key-1", `{"email":"bob@example.com"}`)
+	assert.Equal(t, 2, calls, "the response of another payload was replayed")
+}
+
+func TestIdempotencyMiddlewareScopesByUser(t *testing.T) {
+	mid := newIdempotencyTestMiddleware()
+	calls := 0
+	alice, bob := primitive.NewObjectID(), primitive.NewObjectID()
+
+	serveIdempotent(mid, &calls, alice, "key-1", `{}`)
+	serveIdempotent(mid, &calls, alice, "key-1", `{}`)
+	assert.Equal(t, 1, calls, "the retry was executed again")
+
+	serveIdempotent(mid, &calls, bob, "key-1", `{}`)
+	assert.Equal(t, 2, calls, "the response of another user was replayed")
+}
+
+func TestIdempotencyMiddlewareLimitsBodySize(t *testing.T) {
+	mid := newIdempotencyTestMiddleware()
+	calls := 0
+
+	w := serveIdempotent(mid, &calls, primitive.NilObjectID, "key-1", strings.Repeat("x", 2<<10))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(t, 0, calls)
+}
@@ -3,12 +3,12 @@ package middleware
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"net/http"
 	"strings"
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
 )
 
 func (mid *middleware) JWTProcessorMiddleware(fn http.HandlerFunc) http.HandlerFunc {
@@ -18,18 +18,23 @@ func (mid *middleware) JWTProcessorMiddleware(fn http.HandlerFunc) http.HandlerF
 		// Extract our auth header array.
 		reqToken := r.Header.Get("Authorization")
 
-		log.Printf("monorepo/cloud/backend/internal/iam/interface/http/middleware/jwt.go --> reqToken: %v\n", reqToken)
-
 		// Before running our JWT middleware we need to confirm there is an
 		// an `Authorization` header to run our middleware. This is an important
 		// step!
 		if reqToken != "" && strings.Contains(reqToken, "undefined") == false {
 
+			// Personal API keys are sent as `ApiKey <key>` and are processed
+			// separately from the session based JWT tokens.
+			if strings.HasPrefix(reqToken, "ApiKey ") {
+				mid.processAPIKey(fn, w, r, strings.TrimPrefix(reqToken, "ApiKey "))
+				return
+			}
+
 			// Special thanks to "poise" via https://stackoverflow.com/a/44700761
 			splitToken := strings.Split(reqToken, "JWT ")
 			if len(splitToken) < 2 {
 				log.Println("########################################################################")
-				http.Error(w, "not properly formatted authorization header", http.StatusBadRequest)
+				httperror.ResponseError(w, httperror.WithCode(httperror.NewForBadRequestWithSingleField("message", "Authorization header is not properly formatted"), httperror.CodeMalformedRequest))
 				return
 			}
 
@@ -49,10 +54,10 @@ func (mid *middleware) JWTProcessorMiddleware(fn http.HandlerFunc) http.HandlerF
 				return
 			}
 
-			http.Error(w, fmt.Sprintf("attempting to access a protected endpoint and has session error: %v", err), http.StatusUnauthorized)
+			httperror.ResponseError(w, httperror.WithCode(httperror.NewForUnauthorizedWithSingleField("message", "Access token is invalid or has expired"), httperror.CodeInvalidToken))
 			return
 		} else {
-			http.Error(w, "attempting to access a protected endpoint and authorization not set", http.StatusUnauthorized)
+			httperror.ResponseError(w, httperror.WithCode(httperror.NewForUnauthorizedWithSingleField("message", "Authorization is required to access this endpoint"), httperror.CodeUnauthenticated))
 			return
 		}
 	}
@@ -4,9 +4,19 @@ import (
 	"context"
 	"net/http"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
 )
 
+// TermsOfServiceOutdatedErrorField is the error field returned when the user
+// must re-accept the current terms of service before using the API.
+const TermsOfServiceOutdatedErrorField = "terms_of_service_outdated"
+
 func (mid *middleware) PostJWTProcessorMiddleware(fn http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -14,36 +24,68 @@ func (mid *middleware) PostJWTProcessorMiddleware(fn http.HandlerFunc) http.Hand
 		// Get our authorization information.
 		isAuthorized, ok := ctx.Value(constants.SessionIsAuthorized).(bool)
 		if ok && isAuthorized {
-			sessionID := ctx.Value(constants.SessionID).(string)
+			sessionID, _ := ctx.Value(constants.SessionID).(string)
 
-			// Lookup our user profile in the session or return 500 error.
-			user, err := mid.userGetBySessionIDUseCase.Execute(ctx, sessionID)
+			var user *dom_user.FederatedUser
+			var err error
+			if _, isAPIKey := ctx.Value(constants.SessionAPIKeyID).(primitive.ObjectID); isAPIKey {
+				// API key requests have no session so lookup the key owner directly.
+				userID, _ := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+				user, err = mid.userGetByIDUseCase.Execute(ctx, userID)
+			} else {
+				// Lookup our user profile in the session or return 500 error.
+				user, err = mid.userGetBySessionIDUseCase.Execute(ctx, sessionID)
+			}
 			if err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+				httperror.ResponseError(w, err)
 				return
 			}
 
 			// If no user was found then that means our session expired and the
 			// user needs to login or use the refresh token.
 			if user == nil {
-				http.Error(w, "attempting to access a protected endpoint", http.StatusUnauthorized)
+				httperror.ResponseError(w, httperror.WithCode(httperror.NewForUnauthorizedWithSingleField("message", "Session has expired, please login again"), httperror.CodeSessionExpired))
+				return
+			}
+
+			// If system administrator locked or archived the user account then
+			// we need to generate a 403 error letting the user know their account
+			// has been disabled and you cannot access the protected API endpoint.
+			switch user.Status {
+			case dom_user.FederatedUserStatusLocked:
+				httperror.ResponseError(w, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "Account disabled - please contact admin"), httperror.CodeAccountLocked))
+				return
+			case dom_user.FederatedUserStatusArchived:
+				httperror.ResponseError(w, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "Account disabled - please contact admin"), httperror.CodeAccountArchived))
 				return
 			}
 
-			// // If system administrator disabled the user account then we need
-			// // to generate a 403 error letting the user know their account has
-			// // been disabled and you cannot access the protected API endpoint.
-			// if user.State == 0 {
-			// 	http.Error(w, "Account disabled - please contact admin", http.StatusForbidden)
-			// 	return
-			// }
+			// If the terms of service changed since the user accepted them then
+			// we need to generate a 403 error so the client prompts the user to
+			// re-accept. The session holds a snapshot of the user so reload it
+			// in case the user accepted the terms in another session.
+			currentVersion := mid.config.App.TermsOfServiceVersion
+			if user.IsTermsOfServiceOutdated(currentVersion) && !isTermsOfServiceExemptPath(r.URL.Path) {
+				latest, err := mid.userGetByIDUseCase.Execute(ctx, user.ID)
+				if err != nil {
+					httperror.ResponseError(w, err)
+					return
+				}
+				if latest == nil || latest.IsTermsOfServiceOutdated(currentVersion) {
+					httperror.ResponseError(w, httperror.WithCode(httperror.NewForSingleField(http.StatusForbidden, TermsOfServiceOutdatedErrorField, currentVersion), httperror.CodeTermsOfServiceOutdated))
+					return
+				}
+				user = latest
+			}
 
 			// Save our user information to the context.
 			// Save our user.
 			ctx = context.WithValue(ctx, constants.SessionFederatedUser, user)
 
 			// Save individual pieces of the user profile.
-			ctx = context.WithValue(ctx, constants.SessionID, sessionID)
+			if sessionID != "" {
+				ctx = context.WithValue(ctx, constants.SessionID, sessionID)
+			}
 			ctx = context.WithValue(ctx, constants.SessionFederatedUserID, user.ID)
 			ctx = context.WithValue(ctx, constants.SessionFederatedUserRole, user.Role)
 			ctx = context.WithValue(ctx, constants.SessionFederatedUserName, user.Name)
@@ -54,6 +96,9 @@ func (mid *middleware) PostJWTProcessorMiddleware(fn http.HandlerFunc) http.Hand
 			// ctx = context.WithValue(ctx, constants.SessionFederatedUserStoreName, user.StoreName)
 			// ctx = context.WithValue(ctx, constants.SessionFederatedUserStoreLevel, user.StoreLevel)
 			// ctx = context.WithValue(ctx, constants.SessionFederatedUserStoreTimezone, user.StoreTimezone)
+
+			// Tie the log lines of the request to the user.
+			logging.With(ctx, zap.String("user_id", user.ID.Hex()))
 		}
 
 		fn(w, r.WithContext(ctx))
@@ -5,9 +5,19 @@ import (
 	"context"
 	"net/http"
 
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	uc_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/apikey"
 	uc_bannedipaddress "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/bannedipaddress"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	uc_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organization"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/distributedmutex"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/ratelimit"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
 )
 
 type Middleware interface {
@@ -16,20 +26,53 @@ type Middleware interface {
 }
 
 type middleware struct {
+	config                              *config.Configuration
+	logger                              *zap.Logger
 	jwt                                 jwt.Provider
+	authorization                       authorization.Provider
+	rateLimiter                         ratelimit.Provider
 	userGetBySessionIDUseCase           uc_user.FederatedUserGetBySessionIDUseCase
 	bannedIPAddressListAllValuesUseCase uc_bannedipaddress.BannedIPAddressListAllValuesUseCase
+	userGetByIDUseCase                  uc_user.FederatedUserGetByIDUseCase
+	apiKeyGetByKeyHashUseCase           uc_apikey.APIKeyGetByKeyHashUseCase
+	apiKeyUpdateLastUsedUseCase         uc_apikey.APIKeyUpdateLastUsedUseCase
+	organizationMemberGetUseCase        uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase
+	organizationGetByIDUseCase          uc_organization.OrganizationGetByIDUseCase
+	cache                               mongodbcache.Cacher
+	distributedMutex                    distributedmutex.Adapter
 }
 
 func NewMiddleware(
+	cfg *config.Configuration,
+	loggerp *zap.Logger,
 	jwtp jwt.Provider,
+	authp authorization.Provider,
+	rlp ratelimit.Provider,
 	uc1 uc_user.FederatedUserGetBySessionIDUseCase,
 	uc2 uc_bannedipaddress.BannedIPAddressListAllValuesUseCase,
+	uc3 uc_user.FederatedUserGetByIDUseCase,
+	uc4 uc_apikey.APIKeyGetByKeyHashUseCase,
+	uc5 uc_apikey.APIKeyUpdateLastUsedUseCase,
+	uc6 uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase,
+	uc7 uc_organization.OrganizationGetByIDUseCase,
+	cache mongodbcache.Cacher,
+	dmutex distributedmutex.Adapter,
 ) Middleware {
 	return &middleware{
+		config:                              cfg,
+		logger:                              loggerp,
 		jwt:                                 jwtp,
+		authorization:                       authp,
+		rateLimiter:                         rlp,
 		userGetBySessionIDUseCase:           uc1,
 		bannedIPAddressListAllValuesUseCase: uc2,
+		userGetByIDUseCase:                  uc3,
+		apiKeyGetByKeyHashUseCase:           uc4,
+		apiKeyUpdateLastUsedUseCase:         uc5,
+		organizationMemberGetUseCase:        uc6,
+		organizationGetByIDUseCase:          uc7,
+		cache:                               cache,
+		distributedMutex:                    dmutex,
 	}
 }
 
@@ -39,9 +82,22 @@ func (mid *middleware) Attach(fn http.HandlerFunc) http.HandlerFunc {
 		// Apply base middleware to all requests
 		handler := mid.applyBaseMiddleware(fn)
 
+		// Replay the responses of the retries, this runs last so only the
+		// authenticated and authorized requests are read and stored.
+		handler = mid.IdempotencyMiddleware(handler)
+
+		// Enforce the roles and permissions declared by the route, this runs
+		// after the JWT middleware so the user role is known.
+		handler = mid.AuthorizationMiddleware(handler)
+
+		// Limit the requests of the authenticated user, this also runs after
+		// the JWT middleware so the user is known.
+		handler = mid.UserRateLimitMiddleware(handler)
+
 		// Check if the path requires authentication
 		if isProtectedPath(r.URL.Path) {
 			// Apply auth middleware for protected paths
+			handler = mid.OrganizationMiddleware(handler)
 			handler = mid.PostJWTProcessorMiddleware(handler)
 			handler = mid.JWTProcessorMiddleware(handler)
 			// handler = mid.EnforceBlacklistMiddleware(handler)
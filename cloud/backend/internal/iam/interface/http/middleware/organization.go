@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+)
+
+// OrganizationHeader selects the organization the request acts on behalf of.
+// Requests without it act on the user's personal resources.
+const OrganizationHeader = "X-Organization-ID"
+
+// OrganizationMiddleware verifies the authenticated user is a member of the
+// organization selected by `OrganizationHeader` and saves the organization
+// and the user's role in it to the context. It must run after
+// `PostJWTProcessorMiddleware`.
+func (mid *middleware) OrganizationMiddleware(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		orgIDStr := r.Header.Get(OrganizationHeader)
+		if orgIDStr == "" {
+			fn(w, r)
+			return
+		}
+
+		userID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+		if !ok || userID.IsZero() {
+			fn(w, r)
+			return
+		}
+
+		orgID, err := primitive.ObjectIDFromHex(orgIDStr)
+		if err != nil {
+			httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("organization_id", "Invalid organization ID format"))
+			return
+		}
+
+		member, err := mid.organizationMemberGetUseCase.Execute(ctx, orgID, userID)
+		if err != nil {
+			httperror.ResponseError(w, err)
+			return
+		}
+		if member == nil {
+			httperror.ResponseError(w, httperror.WithCode(httperror.NewForForbiddenWithSingleField("organization_id", "You are not a member of this organization"), httperror.CodeNotOrganizationMember))
+			return
+		}
+
+		org, err := mid.organizationGetByIDUseCase.Execute(ctx, orgID)
+		if err != nil {
+			httperror.ResponseError(w, err)
+			return
+		}
+		if org == nil {
+			httperror.ResponseError(w, httperror.WithCode(httperror.NewForForbiddenWithSingleField("organization_id", "You are not a member of this organization"), httperror.CodeNotOrganizationMember))
+			return
+		}
+
+		ctx = context.WithValue(ctx, constants.SessionOrganizationID, org.ID)
+		ctx = context.WithValue(ctx, constants.SessionOrganizationName, org.Name)
+		ctx = context.WithValue(ctx, constants.SessionOrganizationRole, member.Role)
+
+		fn(w, r.WithContext(ctx))
+	}
+}
@@ -14,25 +14,59 @@ type protectedRoute struct {
 var (
 	exactPaths    = make(map[string]bool)
 	patternRoutes []protectedRoute
+
+	// termsOfServiceExemptPaths stay available to users who have not yet
+	// accepted the current terms of service so they can review and accept
+	// them, withdraw consent, delete their account or logout.
+	termsOfServiceExemptPaths = map[string]bool{
+		"/papercloud/api/v1/me":                    true,
+		"/papercloud/api/v1/me/delete":             true,
+		"/iam/api/v1/logout":                       true,
+		"/iam/api/v1/me/consents":                  true,
+		"/iam/api/v1/me/consents/terms-of-service": true,
+		"/iam/api/v1/me/consents/promotions":       true,
+	}
 )
 
 func init() {
 	// Exact matches
 	exactPaths = map[string]bool{
-		"/papercloud/api/v1/me":         true,
-		"/papercloud/api/v1/me/delete":  true,
-		"/papercloud/api/v1/dashboard":  true,
-		"/vault/api/v1/encrypted-files": true,
+		"/papercloud/api/v1/me":                       true,
+		"/papercloud/api/v1/me/delete":                true,
+		"/papercloud/api/v1/dashboard":                true,
+		"/vault/api/v1/encrypted-files":               true,
+		"/iam/api/v1/change-password":                 true,
+		"/iam/api/v1/change-password/challenge":       true,
+		"/iam/api/v1/change-email":                    true,
+		"/iam/api/v1/change-email/verify":             true,
+		"/iam/api/v1/api-keys":                        true,
+		"/iam/api/v1/admin/users":                     true,
+		"/iam/api/v1/admin/invites":                   true,
+		"/iam/api/v1/admin/oauth-clients":             true,
+		"/iam/api/v1/admin/banned-ip-addresses":       true,
+		"/iam/api/v1/logout":                          true,
+		"/iam/api/v1/me/security-events":              true,
+		"/iam/api/v1/admin/security-events":           true,
+		"/iam/api/v1/organizations":                   true,
+		"/iam/api/v1/organization-invitations/accept": true,
+		"/iam/api/v1/me/consents":                     true,
+		"/iam/api/v1/me/consents/terms-of-service":    true,
+		"/iam/api/v1/me/consents/promotions":          true,
 		// "/iam/api/v1/reset-password":      true,
 		// "/iam/api/v1/token/refresh": true, // This is counterintuitive to the token refresh api endpoint
 	}
 
 	// Pattern matches
 	patterns := []string{
-		"/vault/api/v1/encrypted-files/[0-9a-f]+$",          // Regex designed for mongodb ids.
-		"/vault/api/v1/encrypted-files/[0-9a-f]+/download$", // Regex designed for mongodb ids.
-		"/vault/api/v1/files-by-client-id/[^/]+$",           // Regex designed for any non-empty string (client ID).
-		"/vault/api/v1/encrypted-files/[0-9a-f]+/url$",      // Regex designed for mongodb ids.
+		"/vault/api/v1/encrypted-files/[0-9a-f]+$",                                   // Regex designed for mongodb ids.
+		"/vault/api/v1/encrypted-files/[0-9a-f]+/download$",                          // Regex designed for mongodb ids.
+		"/vault/api/v1/files-by-client-id/[^/]+$",                                    // Regex designed for any non-empty string (client ID).
+		"/vault/api/v1/encrypted-files/[0-9a-f]+/url$",                               // Regex designed for mongodb ids.
+		"/iam/api/v1/api-keys/[0-9a-f]+$",                                            // Regex designed for mongodb ids.
+		"/iam/api/v1/admin/users/[0-9a-f]+(/[a-z-]+)?$",                              // Regex designed for mongodb ids with an optional action.
+		"/iam/api/v1/admin/oauth-clients/[0-9a-f]+$",                                 // Regex designed for generated client IDs.
+		"/iam/api/v1/admin/banned-ip-addresses/[0-9a-f]+$",                           // Regex designed for mongodb ids.
+		"/iam/api/v1/organizations/[0-9a-f]+(/(members|invitations)(/[0-9a-f]+)?)?$", // Regex designed for mongodb ids with optional sub-resources.
 
 		// Examples:
 		// "^/papercloud/api/v1/user/[0-9]+$",                      // Regex designed for non-zero integers.
@@ -70,3 +104,7 @@ func isProtectedPath(path string) bool {
 
 	return false
 }
+
+func isTermsOfServiceExemptPath(path string) bool {
+	return termsOfServiceExemptPaths[path]
+}
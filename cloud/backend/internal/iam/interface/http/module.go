@@ -4,9 +4,15 @@ package http
 import (
 	"go.uber.org/fx"
 
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/apikey"
 	commonhttp "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/common"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/consent"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/gateway"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/oauth2"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/securityevent"
 	unifiedhttp "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/manifold/interface/http"
 )
 
@@ -15,19 +21,79 @@ func Module() fx.Option {
 		fx.Provide(
 			middleware.NewMiddleware,
 		),
-		fx.Provide(
-			unifiedhttp.AsRoute(commonhttp.NewGetMapleSendVersionHTTPHandler),
-			unifiedhttp.AsRoute(gateway.NewGatewayFederatedUserRegisterHTTPHandler),
-			unifiedhttp.AsRoute(gateway.NewGatewayVerifyEmailHTTPHandler),
-			// Add the new E2EE login handlers
-			unifiedhttp.AsRoute(gateway.NewGatewayRequestLoginOTTHTTPHandler),
-			unifiedhttp.AsRoute(gateway.NewGatewayVerifyLoginOTTHTTPHandler),
-			unifiedhttp.AsRoute(gateway.NewGatewayCompleteLoginHTTPHandler),
-			// Other handlers
-			unifiedhttp.AsRoute(gateway.NewGatewayLogoutHTTPHandler),
-			unifiedhttp.AsRoute(gateway.NewGatewayRefreshTokenHTTPHandler),
-			// unifiedhttp.AsRoute(gateway.NewGatewayResetPasswordHTTPHandler),
-			// unifiedhttp.AsRoute(gateway.NewGatewayForgotPasswordHTTPHandler),
-		),
+		fx.Provide(unifiedhttp.AsRoutes(Routes())...),
 	)
 }
+
+// Routes returns the constructors of the routes of this module.
+func Routes() []any {
+	return []any{
+		commonhttp.NewGetMapleSendVersionHTTPHandler,
+		gateway.NewGatewayFederatedUserRegisterHTTPHandler,
+		gateway.NewGatewayVerifyEmailHTTPHandler,
+		// Add the new E2EE login handlers
+		gateway.NewGatewayRequestLoginOTTHTTPHandler,
+		gateway.NewGatewayVerifyLoginOTTHTTPHandler,
+		gateway.NewGatewayVerifyLoginMagicLinkHTTPHandler,
+		gateway.NewGatewayCompleteLoginHTTPHandler,
+		// Other handlers
+		gateway.NewGatewayLogoutHTTPHandler,
+		gateway.NewGatewayRefreshTokenHTTPHandler,
+		gateway.NewGatewayChangePasswordChallengeHTTPHandler,
+		gateway.NewGatewayChangePasswordHTTPHandler,
+		gateway.NewGatewayForgotPasswordHTTPHandler,
+		gateway.NewGatewayVerifyRecoveryHTTPHandler,
+		gateway.NewGatewayResetPasswordHTTPHandler,
+		gateway.NewGatewayRequestEmailChangeHTTPHandler,
+		gateway.NewGatewayVerifyEmailChangeHTTPHandler,
+		gateway.NewGatewayCancelEmailChangeHTTPHandler,
+		gateway.NewGatewayDisownLoginHTTPHandler,
+		// API key handlers
+		apikey.NewCreateAPIKeyHTTPHandler,
+		apikey.NewListAPIKeysHTTPHandler,
+		apikey.NewRevokeAPIKeyHTTPHandler,
+		// Admin handlers
+		admin.NewListFederatedUsersHTTPHandler,
+		admin.NewGetFederatedUserHTTPHandler,
+		admin.NewChangeFederatedUserStatusHTTPHandler,
+		admin.NewChangeFederatedUserRoleHTTPHandler,
+		admin.NewForceLogoutFederatedUserHTTPHandler,
+		admin.NewResendFederatedUserVerificationHTTPHandler,
+		admin.NewCreateInviteHTTPHandler,
+		admin.NewListInvitesHTTPHandler,
+		admin.NewCreateOAuthClientHTTPHandler,
+		admin.NewListOAuthClientsHTTPHandler,
+		admin.NewDeleteOAuthClientHTTPHandler,
+		admin.NewCreateBannedIPAddressHTTPHandler,
+		admin.NewListBannedIPAddressesHTTPHandler,
+		admin.NewDeleteBannedIPAddressHTTPHandler,
+		// Security event handlers
+		securityevent.NewListMySecurityEventsHTTPHandler,
+		securityevent.NewListSecurityEventsHTTPHandler,
+		// OpenID Connect provider handlers
+		oauth2.NewOAuth2DiscoveryHTTPHandler,
+		oauth2.NewOAuth2JWKSHTTPHandler,
+		oauth2.NewOAuth2AuthorizeHTTPHandler,
+		oauth2.NewOAuth2GetAuthorizationRequestHTTPHandler,
+		oauth2.NewOAuth2CompleteAuthorizationHTTPHandler,
+		oauth2.NewOAuth2TokenHTTPHandler,
+		oauth2.NewOAuth2UserInfoHTTPHandler,
+		oauth2.NewOAuth2UserInfoPostHTTPHandler,
+		// Consent handlers
+		consent.NewGetMyConsentsHTTPHandler,
+		consent.NewAcceptTermsOfServiceHTTPHandler,
+		consent.NewWithdrawPromotionsConsentHTTPHandler,
+		// Organization handlers
+		organization.NewCreateOrganizationHTTPHandler,
+		organization.NewListMyOrganizationsHTTPHandler,
+		organization.NewGetOrganizationHTTPHandler,
+		organization.NewUpdateOrganizationHTTPHandler,
+		organization.NewListOrganizationMembersHTTPHandler,
+		organization.NewChangeOrganizationMemberRoleHTTPHandler,
+		organization.NewRemoveOrganizationMemberHTTPHandler,
+		organization.NewCreateOrganizationInvitationHTTPHandler,
+		organization.NewListOrganizationInvitationsHTTPHandler,
+		organization.NewRevokeOrganizationInvitationHTTPHandler,
+		organization.NewAcceptOrganizationInvitationHTTPHandler,
+	}
+}
@@ -0,0 +1,71 @@
+package oauth2
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_oauth2 "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/oauth2"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type OAuth2AuthorizeHTTPHandler struct {
+	logger     *zap.Logger
+	service    sv_oauth2.OAuth2AuthorizeService
+	middleware middleware.Middleware
+}
+
+func NewOAuth2AuthorizeHTTPHandler(
+	logger *zap.Logger,
+	service sv_oauth2.OAuth2AuthorizeService,
+	middleware middleware.Middleware,
+) *OAuth2AuthorizeHTTPHandler {
+	return &OAuth2AuthorizeHTTPHandler{
+		logger:     logger,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*OAuth2AuthorizeHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/oauth2/authorize"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*OAuth2AuthorizeHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary: "Start an OpenID Connect authorization, redirects to the login page",
+		Status:  http.StatusFound,
+	}
+}
+
+func (r *OAuth2AuthorizeHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *OAuth2AuthorizeHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	data := &sv_oauth2.OAuth2AuthorizeRequestIDO{
+		ResponseType:        q.Get("response_type"),
+		ClientID:            q.Get("client_id"),
+		RedirectURI:         q.Get("redirect_uri"),
+		Scope:               q.Get("scope"),
+		State:               q.Get("state"),
+		Nonce:               q.Get("nonce"),
+		CodeChallenge:       q.Get("code_challenge"),
+		CodeChallengeMethod: q.Get("code_challenge_method"),
+	}
+
+	resp, err := h.service.Execute(ctx, data)
+	if err != nil {
+		h.logger.Warn("authorization request rejected", zap.Any("err", err))
+		responseError(w, err)
+		return
+	}
+
+	http.Redirect(w, r, resp.RedirectURL, http.StatusFound)
+}
@@ -0,0 +1,119 @@
+package oauth2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_oauth2 "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/oauth2"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type OAuth2CompleteAuthorizationHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_oauth2.OAuth2CompleteAuthorizationService
+	middleware middleware.Middleware
+}
+
+func NewOAuth2CompleteAuthorizationHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_oauth2.OAuth2CompleteAuthorizationService,
+	middleware middleware.Middleware,
+) *OAuth2CompleteAuthorizationHTTPHandler {
+	return &OAuth2CompleteAuthorizationHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*OAuth2CompleteAuthorizationHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/oauth2/authorize/complete"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*OAuth2CompleteAuthorizationHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Complete an authorization with the login challenge",
+		Request:  sv_oauth2.OAuth2CompleteAuthorizationRequestIDO{},
+		Response: sv_oauth2.OAuth2CompleteAuthorizationResponseIDO{},
+	}
+}
+
+func (r *OAuth2CompleteAuthorizationHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *OAuth2CompleteAuthorizationHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*sv_oauth2.OAuth2CompleteAuthorizationRequestIDO, error) {
+	var requestData sv_oauth2.OAuth2CompleteAuthorizationRequestIDO
+
+	defer r.Body.Close()
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang struct
+	err := json.NewDecoder(teeReader).Decode(&requestData)
+	if err != nil {
+		// Developers Note: do not log the raw payload as it contains the decrypted challenge.
+		h.logger.Error("decoding error", zap.Any("err", err))
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
+	}
+
+	return &requestData, nil
+}
+
+func (h *OAuth2CompleteAuthorizationHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		resp, err := h.service.Execute(sessCtx, data)
+		if err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	// Start the transaction
+	result, err := session.WithTransaction(ctx, transactionFunc)
+	if err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		responseError(w, err)
+		return
+	}
+
+	resp := result.(*sv_oauth2.OAuth2CompleteAuthorizationResponseIDO)
+
+	responseJSON(w, resp)
+}
@@ -0,0 +1,59 @@
+package oauth2
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_oauth2 "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/oauth2"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type OAuth2GetAuthorizationRequestHTTPHandler struct {
+	logger     *zap.Logger
+	service    sv_oauth2.OAuth2GetAuthorizationRequestService
+	middleware middleware.Middleware
+}
+
+func NewOAuth2GetAuthorizationRequestHTTPHandler(
+	logger *zap.Logger,
+	service sv_oauth2.OAuth2GetAuthorizationRequestService,
+	middleware middleware.Middleware,
+) *OAuth2GetAuthorizationRequestHTTPHandler {
+	return &OAuth2GetAuthorizationRequestHTTPHandler{
+		logger:     logger,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*OAuth2GetAuthorizationRequestHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/oauth2/authorize/requests/{id}"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*OAuth2GetAuthorizationRequestHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Get a pending authorization request",
+		Response: sv_oauth2.OAuth2AuthorizationRequestResponseIDO{},
+	}
+}
+
+func (r *OAuth2GetAuthorizationRequestHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *OAuth2GetAuthorizationRequestHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.service.Execute(ctx, r.PathValue("id"))
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		responseError(w, err)
+		return
+	}
+
+	responseJSON(w, resp)
+}
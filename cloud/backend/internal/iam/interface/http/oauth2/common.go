@@ -0,0 +1,37 @@
+package oauth2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	sv_oauth2 "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/oauth2"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+)
+
+// responseError writes errors from the OAuth 2.0 endpoints in the format
+// relying parties expect (RFC 6749 section 5.2) and falls back to our usual
+// error map for everything else.
+func responseError(w http.ResponseWriter, err error) {
+	var oauthErr *sv_oauth2.OAuthError
+	if !errors.As(err, &oauthErr) {
+		httperror.ResponseError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if oauthErr.StatusCode == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Bearer error="`+oauthErr.Code+`"`)
+	}
+	w.WriteHeader(oauthErr.StatusCode)
+	json.NewEncoder(w).Encode(oauthErr)
+}
+
+func responseJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,92 @@
+package oauth2
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_oauth2 "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/oauth2"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/oidc"
+)
+
+type OAuth2DiscoveryHTTPHandler struct {
+	logger     *zap.Logger
+	service    sv_oauth2.OAuth2DiscoveryService
+	middleware middleware.Middleware
+}
+
+func NewOAuth2DiscoveryHTTPHandler(
+	logger *zap.Logger,
+	service sv_oauth2.OAuth2DiscoveryService,
+	middleware middleware.Middleware,
+) *OAuth2DiscoveryHTTPHandler {
+	return &OAuth2DiscoveryHTTPHandler{
+		logger:     logger,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*OAuth2DiscoveryHTTPHandler) Pattern() string {
+	return "GET /.well-known/openid-configuration"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*OAuth2DiscoveryHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "OpenID Connect discovery document",
+		Response: sv_oauth2.OAuth2DiscoveryResponseIDO{},
+	}
+}
+
+func (r *OAuth2DiscoveryHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *OAuth2DiscoveryHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	responseJSON(w, h.service.Configuration())
+}
+
+type OAuth2JWKSHTTPHandler struct {
+	logger     *zap.Logger
+	service    sv_oauth2.OAuth2DiscoveryService
+	middleware middleware.Middleware
+}
+
+func NewOAuth2JWKSHTTPHandler(
+	logger *zap.Logger,
+	service sv_oauth2.OAuth2DiscoveryService,
+	middleware middleware.Middleware,
+) *OAuth2JWKSHTTPHandler {
+	return &OAuth2JWKSHTTPHandler{
+		logger:     logger,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*OAuth2JWKSHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/oauth2/jwks"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*OAuth2JWKSHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Public keys signing the ID tokens",
+		Response: oidc.JSONWebKeySet{},
+	}
+}
+
+func (r *OAuth2JWKSHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *OAuth2JWKSHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	responseJSON(w, h.service.JWKS())
+}
@@ -0,0 +1,96 @@
+package oauth2
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_oauth2 "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/oauth2"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type OAuth2TokenHTTPHandler struct {
+	logger     *zap.Logger
+	service    sv_oauth2.OAuth2TokenService
+	middleware middleware.Middleware
+}
+
+func NewOAuth2TokenHTTPHandler(
+	logger *zap.Logger,
+	service sv_oauth2.OAuth2TokenService,
+	middleware middleware.Middleware,
+) *OAuth2TokenHTTPHandler {
+	return &OAuth2TokenHTTPHandler{
+		logger:     logger,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*OAuth2TokenHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/oauth2/token"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*OAuth2TokenHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:            "Exchange an authorization code for tokens",
+		RequestContentType: "application/x-www-form-urlencoded",
+		Response:           sv_oauth2.OAuth2TokenResponseIDO{},
+	}
+}
+
+func (r *OAuth2TokenHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+// unmarshalRequest reads the form encoded body required by RFC 6749 section
+// 4.1.3. Client credentials are taken from HTTP Basic authentication when
+// present and from the body otherwise.
+func (h *OAuth2TokenHTTPHandler) unmarshalRequest(r *http.Request) (*sv_oauth2.OAuth2TokenRequestIDO, error) {
+	if err := r.ParseForm(); err != nil {
+		h.logger.Error("decoding error", zap.Any("err", err))
+		return nil, &sv_oauth2.OAuthError{
+			StatusCode:  http.StatusBadRequest,
+			Code:        sv_oauth2.ErrorInvalidRequest,
+			Description: "payload structure is wrong",
+		}
+	}
+
+	requestData := &sv_oauth2.OAuth2TokenRequestIDO{
+		GrantType:    r.PostForm.Get("grant_type"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		ClientID:     r.PostForm.Get("client_id"),
+		ClientSecret: r.PostForm.Get("client_secret"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+	}
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		requestData.ClientID = clientID
+		requestData.ClientSecret = clientSecret
+	}
+	return requestData, nil
+}
+
+func (h *OAuth2TokenHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := h.unmarshalRequest(r)
+	if err != nil {
+		responseError(w, err)
+		return
+	}
+
+	resp, err := h.service.Execute(ctx, data)
+	if err != nil {
+		h.logger.Warn("token request rejected", zap.Any("err", err))
+		responseError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	responseJSON(w, resp)
+}
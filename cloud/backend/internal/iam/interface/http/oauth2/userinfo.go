@@ -0,0 +1,81 @@
+package oauth2
+
+import (
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_oauth2 "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/oauth2"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+// OAuth2UserInfoHTTPHandler serves the userinfo endpoint, which must accept
+// both GET and POST (OpenID Connect Core 1.0 section 5.3.1), so it is
+// registered once per method.
+type OAuth2UserInfoHTTPHandler struct {
+	logger     *zap.Logger
+	service    sv_oauth2.OAuth2UserInfoService
+	middleware middleware.Middleware
+	method     string
+}
+
+func NewOAuth2UserInfoHTTPHandler(
+	logger *zap.Logger,
+	service sv_oauth2.OAuth2UserInfoService,
+	middleware middleware.Middleware,
+) *OAuth2UserInfoHTTPHandler {
+	return &OAuth2UserInfoHTTPHandler{
+		logger:     logger,
+		service:    service,
+		middleware: middleware,
+		method:     http.MethodGet,
+	}
+}
+
+func NewOAuth2UserInfoPostHTTPHandler(
+	logger *zap.Logger,
+	service sv_oauth2.OAuth2UserInfoService,
+	middleware middleware.Middleware,
+) *OAuth2UserInfoHTTPHandler {
+	h := NewOAuth2UserInfoHTTPHandler(logger, service, middleware)
+	h.method = http.MethodPost
+	return h
+}
+
+func (h *OAuth2UserInfoHTTPHandler) Pattern() string {
+	return h.method + " /iam/api/v1/oauth2/userinfo"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*OAuth2UserInfoHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Claims of the user of the access token",
+		Response:      map[string]any{},
+		Authenticated: true,
+	}
+}
+
+func (r *OAuth2UserInfoHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *OAuth2UserInfoHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var accessToken string
+	if authHeader := r.Header.Get("Authorization"); strings.HasPrefix(authHeader, "Bearer ") {
+		accessToken = strings.TrimPrefix(authHeader, "Bearer ")
+	}
+
+	resp, err := h.service.Execute(ctx, accessToken)
+	if err != nil {
+		responseError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	responseJSON(w, resp)
+}
@@ -0,0 +1,131 @@
+package organization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type AcceptOrganizationInvitationHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_organization.AcceptOrganizationInvitationService
+	middleware middleware.Middleware
+}
+
+func NewAcceptOrganizationInvitationHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_organization.AcceptOrganizationInvitationService,
+	middleware middleware.Middleware,
+) *AcceptOrganizationInvitationHTTPHandler {
+	return &AcceptOrganizationInvitationHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*AcceptOrganizationInvitationHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/organization-invitations/accept"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*AcceptOrganizationInvitationHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Accept an invitation to join an organization",
+		Request:       sv_organization.AcceptOrganizationInvitationRequestDTO{},
+		Response:      sv_organization.OrganizationResponseDTO{},
+		Authenticated: true,
+	}
+}
+
+// Idempotent lets the retries sent with the same idempotency key replay
+// the first response
+func (*AcceptOrganizationInvitationHTTPHandler) Idempotent() bool {
+	return true
+}
+
+func (r *AcceptOrganizationInvitationHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *AcceptOrganizationInvitationHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*sv_organization.AcceptOrganizationInvitationRequestDTO, error) {
+	var requestData sv_organization.AcceptOrganizationInvitationRequestDTO
+
+	defer r.Body.Close()
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang struct
+	err := json.NewDecoder(teeReader).Decode(&requestData)
+	if err != nil {
+		h.logger.Error("decoding error",
+			zap.Any("err", err),
+		)
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
+	}
+
+	return &requestData, nil
+}
+
+func (h *AcceptOrganizationInvitationHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		resp, err := h.service.Execute(sessCtx, data)
+		if err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	// Start the transaction
+	result, err := session.WithTransaction(ctx, transactionFunc)
+	if err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp := result.(*sv_organization.OrganizationResponseDTO)
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,141 @@
+package organization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type CreateOrganizationInvitationHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_organization.CreateOrganizationInvitationService
+	middleware middleware.Middleware
+}
+
+func NewCreateOrganizationInvitationHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_organization.CreateOrganizationInvitationService,
+	middleware middleware.Middleware,
+) *CreateOrganizationInvitationHTTPHandler {
+	return &CreateOrganizationInvitationHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*CreateOrganizationInvitationHTTPHandler) Pattern() string {
+	return "POST /iam/api/v1/organizations/{id}/invitations"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*CreateOrganizationInvitationHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Invite someone to join an organization",
+		Request:       sv_organization.CreateOrganizationInvitationRequestDTO{},
+		Response:      dom_invitation.OrganizationInvitation{},
+		Status:        http.StatusCreated,
+		Authenticated: true,
+	}
+}
+
+// Idempotent lets the retries sent with the same idempotency key replay
+// the first response
+func (*CreateOrganizationInvitationHTTPHandler) Idempotent() bool {
+	return true
+}
+
+func (r *CreateOrganizationInvitationHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *CreateOrganizationInvitationHTTPHandler) unmarshalRequest(
+	ctx context.Context,
+	r *http.Request,
+) (*sv_organization.CreateOrganizationInvitationRequestDTO, error) {
+	var requestData sv_organization.CreateOrganizationInvitationRequestDTO
+
+	defer r.Body.Close()
+
+	var rawJSON bytes.Buffer
+	teeReader := io.TeeReader(r.Body, &rawJSON) // TeeReader allows you to read the JSON and capture it
+
+	// Read the JSON string and convert it into our golang struct
+	err := json.NewDecoder(teeReader).Decode(&requestData)
+	if err != nil {
+		h.logger.Error("decoding error",
+			zap.Any("err", err),
+			zap.String("json", rawJSON.String()),
+		)
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusBadRequest, "non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest)
+	}
+
+	return &requestData, nil
+}
+
+func (h *CreateOrganizationInvitationHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "Invalid organization ID format"))
+		return
+	}
+
+	data, err := h.unmarshalRequest(ctx, r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		resp, err := h.service.Execute(sessCtx, id, data)
+		if err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	// Start the transaction
+	result, err := session.WithTransaction(ctx, transactionFunc)
+	if err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp := result.(*dom_invitation.OrganizationInvitation)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,78 @@
+package organization
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type ListOrganizationInvitationsHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_organization.ListOrganizationInvitationsService
+	middleware middleware.Middleware
+}
+
+func NewListOrganizationInvitationsHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_organization.ListOrganizationInvitationsService,
+	middleware middleware.Middleware,
+) *ListOrganizationInvitationsHTTPHandler {
+	return &ListOrganizationInvitationsHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ListOrganizationInvitationsHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/organizations/{id}/invitations"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListOrganizationInvitationsHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "List the pending invitations of an organization",
+		Response:      []*dom_invitation.OrganizationInvitation{},
+		Authenticated: true,
+	}
+}
+
+func (r *ListOrganizationInvitationsHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *ListOrganizationInvitationsHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "Invalid organization ID format"))
+		return
+	}
+
+	resp, err := h.service.Execute(ctx, id)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,97 @@
+package organization
+
+import (
+	"context"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type RevokeOrganizationInvitationHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_organization.RevokeOrganizationInvitationService
+	middleware middleware.Middleware
+}
+
+func NewRevokeOrganizationInvitationHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_organization.RevokeOrganizationInvitationService,
+	middleware middleware.Middleware,
+) *RevokeOrganizationInvitationHTTPHandler {
+	return &RevokeOrganizationInvitationHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*RevokeOrganizationInvitationHTTPHandler) Pattern() string {
+	return "DELETE /iam/api/v1/organizations/{id}/invitations/{invitation_id}"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*RevokeOrganizationInvitationHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Revoke an invitation to join an organization",
+		Status:        http.StatusNoContent,
+		Authenticated: true,
+	}
+}
+
+func (r *RevokeOrganizationInvitationHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *RevokeOrganizationInvitationHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "Invalid organization ID format"))
+		return
+	}
+
+	invitationID, err := primitive.ObjectIDFromHex(r.PathValue("invitation_id"))
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("invitation_id", "Invalid invitation ID format"))
+		return
+	}
+
+	// Start the transaction
+	session, err := h.dbClient.StartSession()
+	if err != nil {
+		h.logger.Error("start session error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+	defer session.EndSession(ctx)
+
+	// Define a transaction function
+	transactionFunc := func(sessCtx context.Context) (interface{}, error) {
+		if err := h.service.Execute(sessCtx, id, invitationID); err != nil {
+			h.logger.Error("service error", zap.Any("err", err))
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	// Start the transaction
+	if _, err := session.WithTransaction(ctx, transactionFunc); err != nil {
+		h.logger.Error("session failed error", zap.Any("error", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,70 @@
+package organization
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type ListMyOrganizationsHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_organization.ListMyOrganizationsService
+	middleware middleware.Middleware
+}
+
+func NewListMyOrganizationsHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_organization.ListMyOrganizationsService,
+	middleware middleware.Middleware,
+) *ListMyOrganizationsHTTPHandler {
+	return &ListMyOrganizationsHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ListMyOrganizationsHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/organizations"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListMyOrganizationsHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "List the organizations of the user",
+		Response:      []*sv_organization.OrganizationResponseDTO{},
+		Authenticated: true,
+	}
+}
+
+func (r *ListMyOrganizationsHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *ListMyOrganizationsHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.service.Execute(ctx)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
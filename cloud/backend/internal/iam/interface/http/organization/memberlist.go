@@ -0,0 +1,78 @@
+package organization
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type ListOrganizationMembersHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_organization.ListOrganizationMembersService
+	middleware middleware.Middleware
+}
+
+func NewListOrganizationMembersHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_organization.ListOrganizationMembersService,
+	middleware middleware.Middleware,
+) *ListOrganizationMembersHTTPHandler {
+	return &ListOrganizationMembersHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ListOrganizationMembersHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/organizations/{id}/members"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListOrganizationMembersHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "List the members of an organization",
+		Response:      []*dom_member.OrganizationMember{},
+		Authenticated: true,
+	}
+}
+
+func (r *ListOrganizationMembersHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *ListOrganizationMembersHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "Invalid organization ID format"))
+		return
+	}
+
+	resp, err := h.service.Execute(ctx, id)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,80 @@
+package securityevent
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+)
+
+// unmarshalFilter converts the query parameters into our filter. Supported
+// parameters are `actor_user_id`, `subject_user_id`, `type` (comma
+// separated), `outcome`, `resource_id`, `ip_address`, `created_at_start`,
+// `created_at_end`, `limit`, `last_id` and `last_created_at`; dates are in
+// RFC 3339 format.
+func unmarshalFilter(r *http.Request) (*dom_auditevent.AuditEventFilter, error) {
+	q := r.URL.Query()
+	filter := &dom_auditevent.AuditEventFilter{
+		Outcome:    q.Get("outcome"),
+		ResourceID: q.Get("resource_id"),
+		IPAddress:  q.Get("ip_address"),
+	}
+	e := make(map[string]string)
+
+	parseID := func(field string) *primitive.ObjectID {
+		v := q.Get(field)
+		if v == "" {
+			return nil
+		}
+		id, err := primitive.ObjectIDFromHex(v)
+		if err != nil {
+			e[field] = "Invalid ID format"
+			return nil
+		}
+		return &id
+	}
+	parseTime := func(field string) *time.Time {
+		v := q.Get(field)
+		if v == "" {
+			return nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			e[field] = "Invalid date format"
+			return nil
+		}
+		return &t
+	}
+
+	filter.ActorUserID = parseID("actor_user_id")
+	filter.SubjectUserID = parseID("subject_user_id")
+	filter.LastID = parseID("last_id")
+	filter.CreatedAtStart = parseTime("created_at_start")
+	filter.CreatedAtEnd = parseTime("created_at_end")
+	filter.LastCreatedAt = parseTime("last_created_at")
+
+	if v := q.Get("type"); v != "" {
+		for _, t := range strings.Split(v, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				filter.Types = append(filter.Types, t)
+			}
+		}
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			e["limit"] = "Limit must be a number"
+		}
+		filter.Limit = limit
+	}
+
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+	return filter, nil
+}
@@ -0,0 +1,84 @@
+package securityevent
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_securityevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/securityevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
+)
+
+type ListSecurityEventsHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_securityevent.ListSecurityEventsService
+	middleware middleware.Middleware
+}
+
+func NewListSecurityEventsHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_securityevent.ListSecurityEventsService,
+	middleware middleware.Middleware,
+) *ListSecurityEventsHTTPHandler {
+	return &ListSecurityEventsHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ListSecurityEventsHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/admin/security-events"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListSecurityEventsHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "List the security events of every user",
+		Response: sv_securityevent.ListSecurityEventsResponseDTO{},
+	}
+}
+
+func (*ListSecurityEventsHTTPHandler) RequiredRoles() []int8 {
+	return []int8{authorization.RoleRoot}
+}
+
+func (*ListSecurityEventsHTTPHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionUsersManage}
+}
+
+func (r *ListSecurityEventsHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *ListSecurityEventsHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := unmarshalFilter(r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp, err := h.service.Execute(ctx, filter)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
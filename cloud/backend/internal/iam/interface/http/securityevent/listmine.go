@@ -0,0 +1,76 @@
+package securityevent
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	sv_securityevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/securityevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+type ListMySecurityEventsHTTPHandler struct {
+	logger     *zap.Logger
+	dbClient   *mongo.Client
+	service    sv_securityevent.ListMySecurityEventsService
+	middleware middleware.Middleware
+}
+
+func NewListMySecurityEventsHTTPHandler(
+	logger *zap.Logger,
+	dbClient *mongo.Client,
+	service sv_securityevent.ListMySecurityEventsService,
+	middleware middleware.Middleware,
+) *ListMySecurityEventsHTTPHandler {
+	return &ListMySecurityEventsHTTPHandler{
+		logger:     logger,
+		dbClient:   dbClient,
+		service:    service,
+		middleware: middleware,
+	}
+}
+
+func (*ListMySecurityEventsHTTPHandler) Pattern() string {
+	return "GET /iam/api/v1/me/security-events"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListMySecurityEventsHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "List the security events of the user",
+		Response:      sv_securityevent.ListSecurityEventsResponseDTO{},
+		Authenticated: true,
+	}
+}
+
+func (r *ListMySecurityEventsHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	// Apply middleware before handling the request
+	r.middleware.Attach(r.Execute)(w, req)
+}
+
+func (h *ListMySecurityEventsHTTPHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := unmarshalFilter(r)
+	if err != nil {
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	resp, err := h.service.Execute(ctx, filter)
+	if err != nil {
+		h.logger.Error("service error", zap.Any("err", err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(&resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
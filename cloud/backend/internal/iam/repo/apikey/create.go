@@ -0,0 +1,31 @@
+package apikey
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	dom_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl apiKeyImpl) Create(ctx context.Context, m *dom_apikey.APIKey) error {
+	ctx, span := tracing.Start(ctx, "ApiKeyRepository.Create")
+	defer span.End()
+
+	if m.ID == primitive.NilObjectID {
+		m.ID = primitive.NewObjectID()
+		impl.Logger.Warn("database insert api key not included id value, created id now.", zap.Any("id", m.ID))
+	}
+
+	_, err := impl.Collection.InsertOne(ctx, m)
+	if err != nil {
+		impl.Logger.Error("database failed create error",
+			zap.Any("error", err))
+		return err
+	}
+
+	return nil
+}
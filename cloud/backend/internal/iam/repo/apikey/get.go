@@ -0,0 +1,75 @@
+package apikey
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	dom_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl apiKeyImpl) GetByID(ctx context.Context, id primitive.ObjectID) (*dom_apikey.APIKey, error) {
+	ctx, span := tracing.Start(ctx, "ApiKeyRepository.GetByID")
+	defer span.End()
+
+	filter := bson.M{"_id": id}
+
+	var result dom_apikey.APIKey
+	err := impl.Collection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// This error means your query did not match any documents.
+			return nil, nil
+		}
+		impl.Logger.Error("database get by id error", zap.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (impl apiKeyImpl) GetByKeyHash(ctx context.Context, keyHash string) (*dom_apikey.APIKey, error) {
+	ctx, span := tracing.Start(ctx, "ApiKeyRepository.GetByKeyHash")
+	defer span.End()
+
+	filter := bson.M{"key_hash": keyHash}
+
+	var result dom_apikey.APIKey
+	err := impl.Collection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// This error means your query did not match any documents.
+			return nil, nil
+		}
+		impl.Logger.Error("database get by key hash error", zap.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (impl apiKeyImpl) ListByFederatedUserID(ctx context.Context, federatedUserID primitive.ObjectID) ([]*dom_apikey.APIKey, error) {
+	ctx, span := tracing.Start(ctx, "ApiKeyRepository.ListByFederatedUserID")
+	defer span.End()
+
+	filter := bson.M{"federated_user_id": federatedUserID}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := impl.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		impl.Logger.Error("database list by federated user id error", zap.Any("error", err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := make([]*dom_apikey.APIKey, 0)
+	if err := cursor.All(ctx, &results); err != nil {
+		impl.Logger.Error("database decode list error", zap.Any("error", err))
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,33 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl apiKeyImpl) UpdateLastUsed(ctx context.Context, id primitive.ObjectID, lastUsedAt time.Time, ipAddress string) error {
+	ctx, span := tracing.Start(ctx, "ApiKeyRepository.UpdateLastUsed")
+	defer span.End()
+
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$set": bson.M{
+			"last_used_at":              lastUsedAt,
+			"last_used_from_ip_address": ipAddress,
+		},
+	}
+
+	_, err := impl.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		impl.Logger.Error("database update last used error", zap.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package auditevent
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl auditEventImpl) Create(ctx context.Context, m *dom_auditevent.AuditEvent) error {
+	ctx, span := tracing.Start(ctx, "AuditEventRepository.Create")
+	defer span.End()
+
+	if m.ID == primitive.NilObjectID {
+		m.ID = primitive.NewObjectID()
+	}
+
+	// Detach from the caller's transaction (if any) so failed attempts are
+	// recorded even though the transaction which produced them is aborted.
+	ctx = mongo.NewSessionContext(ctx, nil)
+
+	_, err := impl.Collection.InsertOne(ctx, m)
+	if err != nil {
+		impl.Logger.Error("database failed create error",
+			zap.Any("error", err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package auditevent
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+)
+
+type auditEventImpl struct {
+	Logger     *zap.Logger
+	DbClient   *mongo.Client
+	Collection *mongo.Collection
+}
+
+func NewRepository(appCfg *config.Configuration, loggerp *zap.Logger, client *mongo.Client) dom_auditevent.Repository {
+	uc := client.Database(appCfg.DB.MapleAuthName).Collection("audit_events")
+
+	retention := time.Duration(appCfg.App.AuditEventRetentionDays) * 24 * time.Hour
+	if retention <= 0 {
+		log.Fatal("audit event retention must be greater than zero")
+	}
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{
+			{Key: "subject_user_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		}},
+		{Keys: bson.D{
+			{Key: "actor_user_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		}},
+		{Keys: bson.D{
+			{Key: "type", Value: 1},
+			{Key: "created_at", Value: -1},
+		}},
+		{
+			// Retention is enforced by mongodb deleting expired events.
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(retention.Seconds())),
+		},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	s := &auditEventImpl{
+		Logger:     loggerp,
+		DbClient:   client,
+		Collection: uc,
+	}
+	return s
+}
@@ -0,0 +1,121 @@
+package auditevent
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl auditEventImpl) buildMatchStage(filter *dom_auditevent.AuditEventFilter) bson.M {
+	match := bson.M{}
+
+	// Handle cursor-based pagination
+	if filter.LastID != nil && filter.LastCreatedAt != nil {
+		match["$or"] = []bson.M{
+			{
+				"created_at": bson.M{"$lt": filter.LastCreatedAt},
+			},
+			{
+				"created_at": filter.LastCreatedAt,
+				"_id":        bson.M{"$lt": filter.LastID},
+			},
+		}
+	}
+
+	if filter.ActorUserID != nil {
+		match["actor_user_id"] = filter.ActorUserID
+	}
+	if filter.SubjectUserID != nil {
+		match["subject_user_id"] = filter.SubjectUserID
+	}
+	if len(filter.Types) > 0 {
+		match["type"] = bson.M{"$in": filter.Types}
+	}
+	if filter.Outcome != "" {
+		match["outcome"] = filter.Outcome
+	}
+	if filter.ResourceID != "" {
+		match["resource_id"] = filter.ResourceID
+	}
+	if filter.IPAddress != "" {
+		match["ip_address"] = filter.IPAddress
+	}
+
+	if filter.CreatedAtStart != nil || filter.CreatedAtEnd != nil {
+		createdAtFilter := bson.M{}
+		if filter.CreatedAtStart != nil {
+			createdAtFilter["$gte"] = filter.CreatedAtStart
+		}
+		if filter.CreatedAtEnd != nil {
+			createdAtFilter["$lte"] = filter.CreatedAtEnd
+		}
+		// Keep the cursor condition and the date range together.
+		if _, ok := match["$or"]; ok {
+			match["$and"] = []bson.M{{"created_at": createdAtFilter}}
+		} else {
+			match["created_at"] = createdAtFilter
+		}
+	}
+
+	return match
+}
+
+func (impl auditEventImpl) ListByFilter(ctx context.Context, filter *dom_auditevent.AuditEventFilter) (*dom_auditevent.AuditEventFilterResult, error) {
+	ctx, span := tracing.Start(ctx, "AuditEventRepository.ListByFilter")
+	defer span.End()
+
+	if filter == nil {
+		return nil, errors.New("filter cannot be nil")
+	}
+
+	// Default limit if not specified
+	if filter.Limit <= 0 {
+		filter.Limit = 100
+	}
+
+	// Request one more document than needed to determine if there are more results
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(filter.Limit + 1)
+
+	cursor, err := impl.Collection.Find(ctx, impl.buildMatchStage(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	events := make([]*dom_auditevent.AuditEvent, 0)
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+
+	// Handle empty results case
+	if len(events) == 0 {
+		return &dom_auditevent.AuditEventFilterResult{
+			Events:  events,
+			HasMore: false,
+		}, nil
+	}
+
+	// Check if there are more results
+	hasMore := false
+	if len(events) > int(filter.Limit) {
+		hasMore = true
+		events = events[:len(events)-1]
+	}
+
+	// Get last document info for next page
+	lastDoc := events[len(events)-1]
+
+	return &dom_auditevent.AuditEventFilterResult{
+		Events:        events,
+		HasMore:       hasMore,
+		LastID:        lastDoc.ID,
+		LastCreatedAt: lastDoc.CreatedAt,
+	}, nil
+}
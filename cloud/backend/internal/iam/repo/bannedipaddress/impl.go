@@ -48,6 +48,12 @@ func NewRepository(appCfg *config.Configuration, loggerp *zap.Logger, client *mo
 		{Keys: bson.D{
 			{Key: "value", Value: "text"},
 		}},
+		{
+			// Removes temporary bans once they expire; permanent bans do not
+			// have the field and are kept.
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
 	})
 	if err != nil {
 		// It is important that we crash the app on startup to meet the
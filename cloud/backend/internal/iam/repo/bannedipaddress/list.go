@@ -3,6 +3,7 @@ package bannedipaddress
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -11,6 +12,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
 	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // hasActiveFilters checks if any filters besides tenant_id are active
@@ -44,6 +46,9 @@ func (impl bannedIPAddressImpl) buildCountMatchStage(filter *dom_banip.BannedIPA
 }
 
 func (impl bannedIPAddressImpl) CountByFilter(ctx context.Context, filter *dom_banip.BannedIPAddressFilter) (uint64, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.CountByFilter")
+	defer span.End()
+
 	if filter == nil {
 		return 0, errors.New("filter cannot be nil")
 	}
@@ -132,6 +137,9 @@ func (impl bannedIPAddressImpl) buildMatchStage(filter *dom_banip.BannedIPAddres
 }
 
 func (impl bannedIPAddressImpl) ListByFilter(ctx context.Context, filter *dom_banip.BannedIPAddressFilter) (*dom_banip.BannedIPAddressFilterResult, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.ListByFilter")
+	defer span.End()
+
 	if filter == nil {
 		return nil, errors.New("filter cannot be nil")
 	}
@@ -204,6 +212,9 @@ func (impl bannedIPAddressImpl) ListByFilter(ctx context.Context, filter *dom_ba
 }
 
 func (impl bannedIPAddressImpl) DeleteByID(ctx context.Context, id primitive.ObjectID) error {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.DeleteByID")
+	defer span.End()
+
 	_, err := impl.Collection.DeleteOne(ctx, bson.M{"_id": id})
 	if err != nil {
 		impl.Logger.Error("database failed deletion error",
@@ -214,6 +225,9 @@ func (impl bannedIPAddressImpl) DeleteByID(ctx context.Context, id primitive.Obj
 }
 
 func (impl bannedIPAddressImpl) ListAllValues(ctx context.Context) ([]string, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.ListAllValues")
+	defer span.End()
+
 	// Create an empty collection to hold our results
 	var results []dom_banip.BannedIPAddress
 
@@ -240,3 +254,28 @@ func (impl bannedIPAddressImpl) ListAllValues(ctx context.Context) ([]string, er
 
 	return values, nil
 }
+
+// ListAllActive returns the permanent bans and the temporary bans which have
+// not expired yet; expired records may linger until the TTL index removes them.
+func (impl bannedIPAddressImpl) ListAllActive(ctx context.Context) ([]*dom_banip.BannedIPAddress, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.ListAllActive")
+	defer span.End()
+
+	filter := bson.M{"$or": []bson.M{
+		{"expires_at": bson.M{"$exists": false}},
+		{"expires_at": nil},
+		{"expires_at": bson.M{"$gt": time.Now()}},
+	}}
+
+	cursor, err := impl.Collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []*dom_banip.BannedIPAddress
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -8,9 +8,13 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 
 	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func (impl bannedIPAddressImpl) UpdateByID(ctx context.Context, m *dom_banip.BannedIPAddress) error {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.UpdateByID")
+	defer span.End()
+
 	filter := bson.M{"_id": m.ID}
 
 	update := bson.M{ // DEVELOPERS NOTE: https://stackoverflow.com/a/60946010
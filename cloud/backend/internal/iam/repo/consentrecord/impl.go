@@ -0,0 +1,54 @@
+package consentrecord
+
+import (
+	"context"
+	"log"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/consentrecord"
+)
+
+type consentRecordImpl struct {
+	Logger     *zap.Logger
+	DbClient   *mongo.Client
+	Collection *mongo.Collection
+}
+
+func NewRepository(appCfg *config.Configuration, loggerp *zap.Logger, client *mongo.Client) dom_consentrecord.Repository {
+	uc := client.Database(appCfg.DB.MapleAuthName).Collection("consent_records")
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{Keys: bson.D{
+			{Key: "federated_user_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		}},
+		{Keys: bson.D{
+			{Key: "type", Value: 1},
+			{Key: "document_version", Value: 1},
+		}},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	s := &consentRecordImpl{
+		Logger:     loggerp,
+		DbClient:   client,
+		Collection: uc,
+	}
+	return s
+}
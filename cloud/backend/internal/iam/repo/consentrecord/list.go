@@ -0,0 +1,36 @@
+package consentrecord
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	dom_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/consentrecord"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl consentRecordImpl) ListByFederatedUserID(ctx context.Context, federatedUserID primitive.ObjectID) ([]*dom_consentrecord.ConsentRecord, error) {
+	ctx, span := tracing.Start(ctx, "ConsentRecordRepository.ListByFederatedUserID")
+	defer span.End()
+
+	filter := bson.M{"federated_user_id": federatedUserID}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := impl.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		impl.Logger.Error("database list by federated user id error", zap.Any("error", err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := make([]*dom_consentrecord.ConsentRecord, 0)
+	if err := cursor.All(ctx, &results); err != nil {
+		impl.Logger.Error("database decode list error", zap.Any("error", err))
+		return nil, err
+	}
+	return results, nil
+}
@@ -8,9 +8,14 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func (impl userStorerImpl) CheckIfExistsByID(ctx context.Context, id primitive.ObjectID) (bool, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.CheckIfExistsByID")
+	defer span.End()
+
 	filter := bson.M{"_id": id}
 	count, err := impl.Collection.CountDocuments(ctx, filter)
 	if err != nil {
@@ -21,6 +26,9 @@ func (impl userStorerImpl) CheckIfExistsByID(ctx context.Context, id primitive.O
 }
 
 func (impl userStorerImpl) CheckIfExistsByEmail(ctx context.Context, email string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.CheckIfExistsByEmail")
+	defer span.End()
+
 	filter := bson.M{"email": email}
 	count, err := impl.Collection.CountDocuments(ctx, filter)
 	if err != nil {
@@ -8,9 +8,14 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func (impl userStorerImpl) DeleteByID(ctx context.Context, id primitive.ObjectID) error {
+	ctx, span := tracing.Start(ctx, "UserRepository.DeleteByID")
+	defer span.End()
+
 	_, err := impl.Collection.DeleteOne(ctx, bson.M{"_id": id})
 	if err != nil {
 		impl.Logger.Error("database failed deletion error",
@@ -21,6 +26,9 @@ func (impl userStorerImpl) DeleteByID(ctx context.Context, id primitive.ObjectID
 }
 
 func (impl userStorerImpl) DeleteByEmail(ctx context.Context, email string) error {
+	ctx, span := tracing.Start(ctx, "UserRepository.DeleteByEmail")
+	defer span.End()
+
 	_, err := impl.Collection.DeleteOne(ctx, bson.M{"email": email})
 	if err != nil {
 		impl.Logger.Error("database failed deletion error",
@@ -11,9 +11,13 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo"
 
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func (impl userStorerImpl) GetByID(ctx context.Context, id primitive.ObjectID) (*dom_user.FederatedUser, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.GetByID")
+	defer span.End()
+
 	filter := bson.M{"_id": id}
 
 	var result dom_user.FederatedUser
@@ -30,6 +34,9 @@ func (impl userStorerImpl) GetByID(ctx context.Context, id primitive.ObjectID) (
 }
 
 func (impl userStorerImpl) GetByEmail(ctx context.Context, email string) (*dom_user.FederatedUser, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.GetByEmail")
+	defer span.End()
+
 	filter := bson.M{"email": email}
 
 	var result dom_user.FederatedUser
@@ -46,6 +53,9 @@ func (impl userStorerImpl) GetByEmail(ctx context.Context, email string) (*dom_u
 }
 
 func (impl userStorerImpl) GetByVerificationCode(ctx context.Context, verificationCode string) (*dom_user.FederatedUser, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.GetByVerificationCode")
+	defer span.End()
+
 	filter := bson.M{"email_verification_code": verificationCode}
 
 	var result dom_user.FederatedUser
@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // buildCountMatchStage creates the match stage for the aggregation pipeline
@@ -77,6 +78,9 @@ func (s *userStorerImpl) hasActiveFilters(filter *dom_user.FederatedUserFilter)
 }
 
 func (s *userStorerImpl) CountByFilter(ctx context.Context, filter *dom_user.FederatedUserFilter) (uint64, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.CountByFilter")
+	defer span.End()
+
 	if filter == nil {
 		return 0, errors.New("filter cannot be nil")
 	}
@@ -189,6 +193,9 @@ func (impl *userStorerImpl) buildMatchStage(filter *dom_user.FederatedUserFilter
 }
 
 func (impl *userStorerImpl) ListByFilter(ctx context.Context, filter *dom_user.FederatedUserFilter) (*dom_user.FederatedUserFilterResult, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.ListByFilter")
+	defer span.End()
+
 	if filter == nil {
 		return nil, errors.New("filter cannot be nil")
 	}
@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // func (impl userStorerImpl) DeleteByID(ctx context.Context, id primitive.ObjectID) error {
@@ -42,6 +43,9 @@ import (
 // }
 
 func (impl userStorerImpl) UpdateByID(ctx context.Context, m *dom_user.FederatedUser) error {
+	ctx, span := tracing.Start(ctx, "UserRepository.UpdateByID")
+	defer span.End()
+
 	filter := bson.M{"_id": m.ID}
 
 	update := bson.M{ // DEVELOPERS NOTE: https://stackoverflow.com/a/60946010
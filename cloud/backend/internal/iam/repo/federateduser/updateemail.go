@@ -0,0 +1,45 @@
+package federateduser
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// UpdateEmailByID swaps the account's email in a single conditional write.
+// The update only applies while the stored email still equals `oldEmail` and
+// relies on the unique email index to reject addresses already in use.
+func (impl userStorerImpl) UpdateEmailByID(ctx context.Context, id primitive.ObjectID, oldEmail, newEmail string) error {
+	ctx, span := tracing.Start(ctx, "UserRepository.UpdateEmailByID")
+	defer span.End()
+
+	filter := bson.M{"_id": id, "email": oldEmail}
+
+	update := bson.M{
+		"$set": bson.M{
+			"email":       newEmail,
+			"modified_at": time.Now(),
+		},
+	}
+
+	result, err := impl.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return dom_user.ErrEmailAlreadyExists
+		}
+		impl.Logger.Error("database update email by id error", zap.Any("error", err))
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return dom_user.ErrEmailModified
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package invite
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl inviteImpl) Create(ctx context.Context, m *dom_invite.Invite) error {
+	ctx, span := tracing.Start(ctx, "InviteRepository.Create")
+	defer span.End()
+
+	if m.ID == primitive.NilObjectID {
+		m.ID = primitive.NewObjectID()
+	}
+
+	_, err := impl.Collection.InsertOne(ctx, m)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return httperror.WithCode(httperror.NewForBadRequestWithSingleField("code", "Invite code already exists"), httperror.CodeAlreadyExists)
+		}
+		impl.Logger.Error("database failed create error",
+			zap.Any("error", err))
+		return err
+	}
+
+	return nil
+}
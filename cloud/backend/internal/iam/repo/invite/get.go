@@ -0,0 +1,67 @@
+package invite
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl inviteImpl) GetByCode(ctx context.Context, code string) (*dom_invite.Invite, error) {
+	ctx, span := tracing.Start(ctx, "InviteRepository.GetByCode")
+	defer span.End()
+
+	filter := bson.M{"code": code}
+
+	var result dom_invite.Invite
+	err := impl.Collection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// This error means your query did not match any documents.
+			return nil, nil
+		}
+		impl.Logger.Error("database get by code error", zap.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (impl inviteImpl) ConsumeByCode(ctx context.Context, code string, module int, now time.Time) (*dom_invite.Invite, error) {
+	ctx, span := tracing.Start(ctx, "InviteRepository.ConsumeByCode")
+	defer span.End()
+
+	// Checking the remaining uses and the expiry in the same document update
+	// guarantees concurrent registrations can never overdraw an invite.
+	filter := bson.M{
+		"code":   code,
+		"module": module,
+		"$expr":  bson.M{"$lt": bson.A{"$use_count", "$max_uses"}},
+		"$or": bson.A{
+			bson.M{"expires_at": nil},
+			bson.M{"expires_at": bson.M{"$gt": now}},
+		},
+	}
+	update := bson.M{
+		"$inc": bson.M{"use_count": 1},
+		"$set": bson.M{"last_used_at": now},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var result dom_invite.Invite
+	err := impl.Collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		impl.Logger.Error("database consume by code error", zap.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
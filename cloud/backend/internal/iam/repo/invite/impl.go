@@ -0,0 +1,59 @@
+package invite
+
+import (
+	"context"
+	"log"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+)
+
+type inviteImpl struct {
+	Logger     *zap.Logger
+	DbClient   *mongo.Client
+	Collection *mongo.Collection
+}
+
+func NewRepository(appCfg *config.Configuration, loggerp *zap.Logger, client *mongo.Client) dom_invite.Repository {
+	uc := client.Database(appCfg.DB.MapleAuthName).Collection("invites")
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "code", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{
+			{Key: "module", Value: 1},
+			{Key: "created_at", Value: -1},
+		}},
+		{Keys: bson.D{
+			{Key: "created_by_user_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		}},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	s := &inviteImpl{
+		Logger:     loggerp,
+		DbClient:   client,
+		Collection: uc,
+	}
+	return s
+}
@@ -0,0 +1,93 @@
+package invite
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl inviteImpl) buildMatchStage(filter *dom_invite.InviteFilter) bson.M {
+	match := bson.M{}
+
+	// Handle cursor-based pagination
+	if filter.LastID != nil && filter.LastCreatedAt != nil {
+		match["$or"] = []bson.M{
+			{
+				"created_at": bson.M{"$lt": filter.LastCreatedAt},
+			},
+			{
+				"created_at": filter.LastCreatedAt,
+				"_id":        bson.M{"$lt": filter.LastID},
+			},
+		}
+	}
+
+	if filter.Module != 0 {
+		match["module"] = filter.Module
+	}
+	if filter.CreatedByUserID != nil {
+		match["created_by_user_id"] = filter.CreatedByUserID
+	}
+
+	return match
+}
+
+func (impl inviteImpl) ListByFilter(ctx context.Context, filter *dom_invite.InviteFilter) (*dom_invite.InviteFilterResult, error) {
+	ctx, span := tracing.Start(ctx, "InviteRepository.ListByFilter")
+	defer span.End()
+
+	if filter == nil {
+		return nil, errors.New("filter cannot be nil")
+	}
+
+	// Default limit if not specified
+	if filter.Limit <= 0 {
+		filter.Limit = 100
+	}
+
+	// Request one more document than needed to determine if there are more results
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetLimit(filter.Limit + 1)
+
+	cursor, err := impl.Collection.Find(ctx, impl.buildMatchStage(filter), opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	invites := make([]*dom_invite.Invite, 0)
+	if err := cursor.All(ctx, &invites); err != nil {
+		return nil, err
+	}
+
+	// Handle empty results case
+	if len(invites) == 0 {
+		return &dom_invite.InviteFilterResult{
+			Invites: invites,
+			HasMore: false,
+		}, nil
+	}
+
+	// Check if there are more results
+	hasMore := false
+	if len(invites) > int(filter.Limit) {
+		hasMore = true
+		invites = invites[:len(invites)-1]
+	}
+
+	// Get last document info for next page
+	lastDoc := invites[len(invites)-1]
+
+	return &dom_invite.InviteFilterResult{
+		Invites:       invites,
+		HasMore:       hasMore,
+		LastID:        lastDoc.ID,
+		LastCreatedAt: lastDoc.CreatedAt,
+	}, nil
+}
@@ -3,16 +3,32 @@ package repo
 import (
 	"go.uber.org/fx"
 
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/auditevent"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/consentrecord"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/organizationmember"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/templatedemailer"
 )
 
 func Module() fx.Option {
 	return fx.Options(
 		fx.Provide(
+			apikey.NewRepository,
+			auditevent.NewRepository,
+			consentrecord.NewRepository,
 			bannedipaddress.NewRepository,
 			federateduser.NewRepository,
+			invite.NewRepository,
+			oauthclient.NewRepository,
+			organization.NewRepository,
+			organizationinvitation.NewRepository,
+			organizationmember.NewRepository,
 
 			// Annotate the constructor to specify which parameter should receive the named dependency
 			fx.Annotate(
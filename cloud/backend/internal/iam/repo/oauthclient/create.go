@@ -0,0 +1,30 @@
+package oauthclient
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl oauthClientImpl) Create(ctx context.Context, m *dom_oauthclient.OAuthClient) error {
+	ctx, span := tracing.Start(ctx, "OauthClientRepository.Create")
+	defer span.End()
+
+	if m.ID == primitive.NilObjectID {
+		m.ID = primitive.NewObjectID()
+	}
+
+	_, err := impl.Collection.InsertOne(ctx, m)
+	if err != nil {
+		impl.Logger.Error("database failed create error",
+			zap.Any("error", err))
+		return err
+	}
+
+	return nil
+}
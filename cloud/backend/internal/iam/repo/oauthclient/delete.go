@@ -0,0 +1,24 @@
+package oauthclient
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl oauthClientImpl) DeleteByClientID(ctx context.Context, clientID string) error {
+	ctx, span := tracing.Start(ctx, "OauthClientRepository.DeleteByClientID")
+	defer span.End()
+
+	_, err := impl.Collection.DeleteOne(ctx, bson.M{"client_id": clientID})
+	if err != nil {
+		impl.Logger.Error("database failed deletion error",
+			zap.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package oauthclient
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl oauthClientImpl) GetByClientID(ctx context.Context, clientID string) (*dom_oauthclient.OAuthClient, error) {
+	ctx, span := tracing.Start(ctx, "OauthClientRepository.GetByClientID")
+	defer span.End()
+
+	filter := bson.M{"client_id": clientID}
+
+	var result dom_oauthclient.OAuthClient
+	err := impl.Collection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// This error means your query did not match any documents.
+			return nil, nil
+		}
+		impl.Logger.Error("database get by client id error", zap.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (impl oauthClientImpl) ListAll(ctx context.Context) ([]*dom_oauthclient.OAuthClient, error) {
+	ctx, span := tracing.Start(ctx, "OauthClientRepository.ListAll")
+	defer span.End()
+
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := impl.Collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		impl.Logger.Error("database list all error", zap.Any("error", err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := make([]*dom_oauthclient.OAuthClient, 0)
+	if err := cursor.All(ctx, &results); err != nil {
+		impl.Logger.Error("database decode list error", zap.Any("error", err))
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,54 @@
+package oauthclient
+
+import (
+	"context"
+	"log"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+)
+
+type oauthClientImpl struct {
+	Logger     *zap.Logger
+	DbClient   *mongo.Client
+	Collection *mongo.Collection
+}
+
+func NewRepository(appCfg *config.Configuration, loggerp *zap.Logger, client *mongo.Client) dom_oauthclient.Repository {
+	uc := client.Database(appCfg.DB.MapleAuthName).Collection("oauth_clients")
+
+	// Note:
+	// * 1 for ascending
+	// * -1 for descending
+	// * "text" for text indexes
+
+	// The following few lines of code will create the index for our app for this
+	// colleciton.
+	_, err := uc.Indexes().CreateMany(context.TODO(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "client_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{
+			{Key: "created_at", Value: -1},
+		}},
+	})
+	if err != nil {
+		// It is important that we crash the app on startup to meet the
+		// requirements of `google/wire` framework.
+		log.Fatal(err)
+	}
+
+	s := &oauthClientImpl{
+		Logger:     loggerp,
+		DbClient:   client,
+		Collection: uc,
+	}
+	return s
+}
@@ -0,0 +1,30 @@
+package organization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	dom_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationImpl) Create(ctx context.Context, m *dom_organization.Organization) error {
+	ctx, span := tracing.Start(ctx, "OrganizationRepository.Create")
+	defer span.End()
+
+	if m.ID == primitive.NilObjectID {
+		m.ID = primitive.NewObjectID()
+	}
+
+	_, err := impl.Collection.InsertOne(ctx, m)
+	if err != nil {
+		impl.Logger.Error("database failed create error",
+			zap.Any("error", err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package organization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	dom_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationImpl) GetByID(ctx context.Context, id primitive.ObjectID) (*dom_organization.Organization, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationRepository.GetByID")
+	defer span.End()
+
+	filter := bson.M{"_id": id}
+
+	var result dom_organization.Organization
+	err := impl.Collection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// This error means your query did not match any documents.
+			return nil, nil
+		}
+		impl.Logger.Error("database get by id error", zap.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (impl organizationImpl) ListByIDs(ctx context.Context, ids []primitive.ObjectID) ([]*dom_organization.Organization, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationRepository.ListByIDs")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return []*dom_organization.Organization{}, nil
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := impl.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		impl.Logger.Error("database list by ids error", zap.Any("error", err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := []*dom_organization.Organization{}
+	if err := cursor.All(ctx, &results); err != nil {
+		impl.Logger.Error("database decode error", zap.Any("error", err))
+		return nil, err
+	}
+	return results, nil
+}
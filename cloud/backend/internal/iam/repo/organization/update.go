@@ -0,0 +1,30 @@
+package organization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	dom_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationImpl) UpdateByID(ctx context.Context, m *dom_organization.Organization) error {
+	ctx, span := tracing.Start(ctx, "OrganizationRepository.UpdateByID")
+	defer span.End()
+
+	filter := bson.M{"_id": m.ID}
+
+	update := bson.M{ // DEVELOPERS NOTE: https://stackoverflow.com/a/60946010
+		"$set": m,
+	}
+
+	_, err := impl.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		impl.Logger.Error("database update by id error", zap.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package organizationinvitation
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationInvitationImpl) Create(ctx context.Context, m *dom_invitation.OrganizationInvitation) error {
+	ctx, span := tracing.Start(ctx, "OrganizationInvitationRepository.Create")
+	defer span.End()
+
+	if m.ID == primitive.NilObjectID {
+		m.ID = primitive.NewObjectID()
+	}
+
+	_, err := impl.Collection.InsertOne(ctx, m)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "An invitation was already sent to this email address"), httperror.CodeInvitationAlreadySent)
+		}
+		impl.Logger.Error("database failed create error",
+			zap.Any("error", err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package organizationinvitation
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationInvitationImpl) GetByID(ctx context.Context, id primitive.ObjectID) (*dom_invitation.OrganizationInvitation, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationInvitationRepository.GetByID")
+	defer span.End()
+
+	return impl.get(ctx, bson.M{"_id": id})
+}
+
+func (impl organizationInvitationImpl) GetByTokenHash(ctx context.Context, tokenHash string) (*dom_invitation.OrganizationInvitation, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationInvitationRepository.GetByTokenHash")
+	defer span.End()
+
+	return impl.get(ctx, bson.M{"token_hash": tokenHash})
+}
+
+func (impl organizationInvitationImpl) get(ctx context.Context, filter bson.M) (*dom_invitation.OrganizationInvitation, error) {
+	var result dom_invitation.OrganizationInvitation
+	err := impl.Collection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// This error means your query did not match any documents.
+			return nil, nil
+		}
+		impl.Logger.Error("database get error", zap.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,36 @@
+package organizationinvitation
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationInvitationImpl) ListByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) ([]*dom_invitation.OrganizationInvitation, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationInvitationRepository.ListByOrganizationID")
+	defer span.End()
+
+	filter := bson.M{"organization_id": organizationID}
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := impl.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		impl.Logger.Error("database list by organization error", zap.Any("error", err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := []*dom_invitation.OrganizationInvitation{}
+	if err := cursor.All(ctx, &results); err != nil {
+		impl.Logger.Error("database decode error", zap.Any("error", err))
+		return nil, err
+	}
+	return results, nil
+}
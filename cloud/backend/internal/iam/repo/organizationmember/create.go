@@ -0,0 +1,35 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationMemberImpl) Create(ctx context.Context, m *dom_member.OrganizationMember) error {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberRepository.Create")
+	defer span.End()
+
+	if m.ID == primitive.NilObjectID {
+		m.ID = primitive.NewObjectID()
+	}
+
+	_, err := impl.Collection.InsertOne(ctx, m)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "User is already a member of this organization"), httperror.CodeAlreadyMember)
+		}
+		impl.Logger.Error("database failed create error",
+			zap.Any("error", err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationMemberImpl) DeleteByID(ctx context.Context, id primitive.ObjectID) error {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberRepository.DeleteByID")
+	defer span.End()
+
+	_, err := impl.Collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		impl.Logger.Error("database failed deletion error",
+			zap.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationMemberImpl) GetByOrganizationIDAndUserID(ctx context.Context, organizationID, userID primitive.ObjectID) (*dom_member.OrganizationMember, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberRepository.GetByOrganizationIDAndUserID")
+	defer span.End()
+
+	filter := bson.M{
+		"organization_id":   organizationID,
+		"federated_user_id": userID,
+	}
+
+	var result dom_member.OrganizationMember
+	err := impl.Collection.FindOne(ctx, filter).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			// This error means your query did not match any documents.
+			return nil, nil
+		}
+		impl.Logger.Error("database get by organization and user error", zap.Any("error", err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (impl organizationMemberImpl) CountByOrganizationIDAndRole(ctx context.Context, organizationID primitive.ObjectID, role int8) (int64, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberRepository.CountByOrganizationIDAndRole")
+	defer span.End()
+
+	filter := bson.M{
+		"organization_id": organizationID,
+		"role":            role,
+	}
+
+	count, err := impl.Collection.CountDocuments(ctx, filter)
+	if err != nil {
+		impl.Logger.Error("database count by organization and role error", zap.Any("error", err))
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,49 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationMemberImpl) ListByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) ([]*dom_member.OrganizationMember, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberRepository.ListByOrganizationID")
+	defer span.End()
+
+	return impl.list(ctx, bson.M{"organization_id": organizationID})
+}
+
+func (impl organizationMemberImpl) ListByUserID(ctx context.Context, userID primitive.ObjectID) ([]*dom_member.OrganizationMember, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberRepository.ListByUserID")
+	defer span.End()
+
+	return impl.list(ctx, bson.M{"federated_user_id": userID})
+}
+
+func (impl organizationMemberImpl) list(ctx context.Context, filter bson.M) ([]*dom_member.OrganizationMember, error) {
+	opts := options.Find().SetSort(bson.D{
+		{Key: "role", Value: 1},
+		{Key: "created_at", Value: 1},
+	})
+
+	cursor, err := impl.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		impl.Logger.Error("database list error", zap.Any("error", err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	results := []*dom_member.OrganizationMember{}
+	if err := cursor.All(ctx, &results); err != nil {
+		impl.Logger.Error("database decode error", zap.Any("error", err))
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,33 @@
+package organizationmember
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl organizationMemberImpl) UpdateRoleByID(ctx context.Context, id primitive.ObjectID, role int8) error {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberRepository.UpdateRoleByID")
+	defer span.End()
+
+	filter := bson.M{"_id": id}
+	update := bson.M{
+		"$set": bson.M{
+			"role":        role,
+			"modified_at": time.Now(),
+		},
+	}
+
+	_, err := impl.Collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		impl.Logger.Error("database update role by id error", zap.Any("error", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package templatedemailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"path"
+	"text/template"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl *templatedEmailer) SendUserEmailChangeCodeEmail(ctx context.Context, monolithModule int, newEmail, verificationCode, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendUserEmailChangeCodeEmail")
+	defer span.End()
+
+	switch monolithModule {
+	case 1:
+		return impl.SendPaperCloudPropertyEvaluatorModuleUserEmailChangeCodeEmail(ctx, newEmail, verificationCode, firstName)
+	default:
+		return fmt.Errorf("unsupported monolith module: %d", monolithModule)
+	}
+}
+
+func (impl *templatedEmailer) SendPaperCloudPropertyEvaluatorModuleUserEmailChangeCodeEmail(ctx context.Context, newEmail, verificationCode, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendPaperCloudPropertyEvaluatorModuleUserEmailChangeCodeEmail")
+	defer span.End()
+
+	fp := path.Join("templates", "ipe/email_change_code.html")
+	tmpl, err := template.ParseFiles(fp)
+	if err != nil {
+		return fmt.Errorf("user email change code parsing error: %w", err)
+	}
+
+	var processed bytes.Buffer
+
+	// Render the HTML template with our data.
+	data := struct {
+		FirstName        string
+		VerificationCode string
+		Email            string
+	}{
+		FirstName:        firstName,
+		VerificationCode: verificationCode,
+		Email:            newEmail,
+	}
+	if err := tmpl.Execute(&processed, data); err != nil {
+		return fmt.Errorf("user email change code template execution error: %w", err)
+	}
+	body := processed.String() // DEVELOPERS NOTE: Convert our long sequence of data into a string.
+
+	if err := impl.incomePropertyEmailer.Send(ctx, impl.incomePropertyEmailer.GetSenderEmail(), "Confirm your new email address", newEmail, body); err != nil {
+		return fmt.Errorf("sending income property evaluator email change code error: %w", err)
+	}
+	log.Println("success in sending income property evaluator email change code email")
+	return nil
+}
+
+func (impl *templatedEmailer) SendUserEmailChangeNoticeEmail(ctx context.Context, monolithModule int, oldEmail, newEmail, cancelToken, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendUserEmailChangeNoticeEmail")
+	defer span.End()
+
+	switch monolithModule {
+	case 1:
+		return impl.SendPaperCloudPropertyEvaluatorModuleUserEmailChangeNoticeEmail(ctx, oldEmail, newEmail, cancelToken, firstName)
+	default:
+		return fmt.Errorf("unsupported monolith module: %d", monolithModule)
+	}
+}
+
+func (impl *templatedEmailer) SendPaperCloudPropertyEvaluatorModuleUserEmailChangeNoticeEmail(ctx context.Context, oldEmail, newEmail, cancelToken, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendPaperCloudPropertyEvaluatorModuleUserEmailChangeNoticeEmail")
+	defer span.End()
+
+	fp := path.Join("templates", "ipe/email_change_notice.html")
+	tmpl, err := template.ParseFiles(fp)
+	if err != nil {
+		return fmt.Errorf("user email change notice parsing error: %w", err)
+	}
+
+	var processed bytes.Buffer
+
+	// Render the HTML template with our data.
+	data := struct {
+		FirstName string
+		OldEmail  string
+		NewEmail  string
+		CancelURL string
+	}{
+		FirstName: firstName,
+		OldEmail:  oldEmail,
+		NewEmail:  newEmail,
+		CancelURL: fmt.Sprintf("https://%s/change-email/cancel?token=%s", impl.incomePropertyEmailer.GetFrontendDomainName(), url.QueryEscape(cancelToken)),
+	}
+	if err := tmpl.Execute(&processed, data); err != nil {
+		return fmt.Errorf("user email change notice template execution error: %w", err)
+	}
+	body := processed.String() // DEVELOPERS NOTE: Convert our long sequence of data into a string.
+
+	if err := impl.incomePropertyEmailer.Send(ctx, impl.incomePropertyEmailer.GetSenderEmail(), "Your email address is being changed", oldEmail, body); err != nil {
+		return fmt.Errorf("sending income property evaluator email change notice error: %w", err)
+	}
+	log.Println("success in sending income property evaluator email change notice email")
+	return nil
+}
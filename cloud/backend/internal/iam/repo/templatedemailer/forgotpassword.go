@@ -6,9 +6,14 @@ import (
 	"fmt"
 	"path"
 	"text/template"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func (impl *templatedEmailer) SendUserPasswordResetEmail(ctx context.Context, monolithModule int, email, verificationCode, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendUserPasswordResetEmail")
+	defer span.End()
+
 	switch monolithModule {
 	case 1:
 		return impl.SendPaperCloudPropertyEvaluatorUserPasswordResetEmail(ctx, email, verificationCode, firstName)
@@ -18,8 +23,10 @@ func (impl *templatedEmailer) SendUserPasswordResetEmail(ctx context.Context, mo
 }
 
 func (impl *templatedEmailer) SendPaperCloudPropertyEvaluatorUserPasswordResetEmail(ctx context.Context, email, verificationCode, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendPaperCloudPropertyEvaluatorUserPasswordResetEmail")
+	defer span.End()
 
-	fp := path.Join("templates", "iam/forgot_password.html")
+	fp := path.Join("templates", "ipe/forgot_password.html")
 	tmpl, err := template.ParseFiles(fp)
 	if err != nil {
 		return err
@@ -2,6 +2,7 @@ package templatedemailer
 
 import (
 	"context"
+	"time"
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/emailer/mailgun"
 )
@@ -10,7 +11,11 @@ import (
 type TemplatedEmailer interface {
 	SendUserVerificationEmail(ctx context.Context, monolithModule int, email, verificationCode, firstName string) error
 	SendUserPasswordResetEmail(ctx context.Context, monolithModule int, email, verificationCode, firstName string) error
-	SendUserLoginOneTimeTokenEmail(ctx context.Context, monolithModule int, email, oneTimeToken, firstName string) error
+	SendUserLoginOneTimeTokenEmail(ctx context.Context, monolithModule int, email, oneTimeToken, magicLinkToken, firstName string) error
+	SendUserEmailChangeCodeEmail(ctx context.Context, monolithModule int, newEmail, verificationCode, firstName string) error
+	SendUserEmailChangeNoticeEmail(ctx context.Context, monolithModule int, oldEmail, newEmail, cancelToken, firstName string) error
+	SendUserNewLoginAlertEmail(ctx context.Context, monolithModule int, email, device, country, disownToken, firstName string, loggedInAt time.Time) error
+	SendOrganizationInvitationEmail(ctx context.Context, monolithModule int, email, organizationName, invitedByName, token string, expiresInDays int) error
 }
 
 type templatedEmailer struct {
@@ -0,0 +1,64 @@
+package templatedemailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"path"
+	"text/template"
+	"time"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl *templatedEmailer) SendUserNewLoginAlertEmail(ctx context.Context, monolithModule int, email, device, country, disownToken, firstName string, loggedInAt time.Time) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendUserNewLoginAlertEmail")
+	defer span.End()
+
+	switch monolithModule {
+	case 1:
+		return impl.SendPaperCloudPropertyEvaluatorModuleUserNewLoginAlertEmail(ctx, email, device, country, disownToken, firstName, loggedInAt)
+	default:
+		return fmt.Errorf("unsupported monolith module: %d", monolithModule)
+	}
+}
+
+func (impl *templatedEmailer) SendPaperCloudPropertyEvaluatorModuleUserNewLoginAlertEmail(ctx context.Context, email, device, country, disownToken, firstName string, loggedInAt time.Time) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendPaperCloudPropertyEvaluatorModuleUserNewLoginAlertEmail")
+	defer span.End()
+
+	fp := path.Join("templates", "ipe/login_alert.html")
+	tmpl, err := template.ParseFiles(fp)
+	if err != nil {
+		return fmt.Errorf("user new login alert parsing error: %w", err)
+	}
+
+	var processed bytes.Buffer
+
+	// Render the HTML template with our data.
+	data := struct {
+		FirstName  string
+		Device     string
+		Country    string
+		LoggedInAt string
+		DisownURL  string
+	}{
+		FirstName:  firstName,
+		Device:     device,
+		Country:    country,
+		LoggedInAt: loggedInAt.UTC().Format("January 2, 2006 at 15:04 MST"),
+		DisownURL:  fmt.Sprintf("https://%s/login/not-me?token=%s", impl.incomePropertyEmailer.GetFrontendDomainName(), url.QueryEscape(disownToken)),
+	}
+	if err := tmpl.Execute(&processed, data); err != nil {
+		return fmt.Errorf("user new login alert template execution error: %w", err)
+	}
+	body := processed.String() // DEVELOPERS NOTE: Convert our long sequence of data into a string.
+
+	if err := impl.incomePropertyEmailer.Send(ctx, impl.incomePropertyEmailer.GetSenderEmail(), "New login to your account", email, body); err != nil {
+		return fmt.Errorf("sending income property evaluator new login alert error: %w", err)
+	}
+	log.Println("success in sending income property evaluator new login alert email")
+	return nil
+}
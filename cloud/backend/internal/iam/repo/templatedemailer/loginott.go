@@ -5,20 +5,29 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"path"
 	"text/template"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
-func (impl *templatedEmailer) SendUserLoginOneTimeTokenEmail(ctx context.Context, monolithModule int, email, oneTimeToken, firstName string) error {
+func (impl *templatedEmailer) SendUserLoginOneTimeTokenEmail(ctx context.Context, monolithModule int, email, oneTimeToken, magicLinkToken, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendUserLoginOneTimeTokenEmail")
+	defer span.End()
+
 	switch monolithModule {
 	case 1:
-		return impl.SendPaperCloudPropertyEvaluatorModuleUserLoginOneTimeTokenEmail(ctx, email, oneTimeToken, firstName)
+		return impl.SendPaperCloudPropertyEvaluatorModuleUserLoginOneTimeTokenEmail(ctx, email, oneTimeToken, magicLinkToken, firstName)
 	default:
 		return fmt.Errorf("unsupported monolith module: %d", monolithModule)
 	}
 }
 
-func (impl *templatedEmailer) SendPaperCloudPropertyEvaluatorModuleUserLoginOneTimeTokenEmail(ctx context.Context, email, oneTimeToken, firstName string) error {
+func (impl *templatedEmailer) SendPaperCloudPropertyEvaluatorModuleUserLoginOneTimeTokenEmail(ctx context.Context, email, oneTimeToken, magicLinkToken, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendPaperCloudPropertyEvaluatorModuleUserLoginOneTimeTokenEmail")
+	defer span.End()
+
 	fp := path.Join("templates", "ipe/login_ott.html")
 	tmpl, err := template.ParseFiles(fp)
 	if err != nil {
@@ -27,15 +36,23 @@ func (impl *templatedEmailer) SendPaperCloudPropertyEvaluatorModuleUserLoginOneT
 
 	var processed bytes.Buffer
 
+	// The magic link is optional and only included when a token was issued.
+	var magicLinkURL string
+	if magicLinkToken != "" {
+		magicLinkURL = fmt.Sprintf("https://%s/login/magic-link?email=%s&token=%s", impl.incomePropertyEmailer.GetFrontendDomainName(), url.QueryEscape(email), url.QueryEscape(magicLinkToken))
+	}
+
 	// Render the HTML template with our data.
 	data := struct {
-		FirstName string
-		OTT       string
-		Email     string
+		FirstName    string
+		OTT          string
+		Email        string
+		MagicLinkURL string
 	}{
-		FirstName: firstName,
-		OTT:       oneTimeToken,
-		Email:     email,
+		FirstName:    firstName,
+		OTT:          oneTimeToken,
+		Email:        email,
+		MagicLinkURL: magicLinkURL,
 	}
 	if err := tmpl.Execute(&processed, data); err != nil {
 		return fmt.Errorf("user verification template execution error: %w", err)
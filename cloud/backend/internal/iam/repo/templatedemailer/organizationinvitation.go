@@ -0,0 +1,64 @@
+package templatedemailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"path"
+	"text/template"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+func (impl *templatedEmailer) SendOrganizationInvitationEmail(ctx context.Context, monolithModule int, email, organizationName, invitedByName, token string, expiresInDays int) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendOrganizationInvitationEmail")
+	defer span.End()
+
+	switch monolithModule {
+	case 1:
+		return impl.SendPaperCloudPropertyEvaluatorModuleOrganizationInvitationEmail(ctx, email, organizationName, invitedByName, token, expiresInDays)
+	default:
+		return fmt.Errorf("unsupported monolith module: %d", monolithModule)
+	}
+}
+
+func (impl *templatedEmailer) SendPaperCloudPropertyEvaluatorModuleOrganizationInvitationEmail(ctx context.Context, email, organizationName, invitedByName, token string, expiresInDays int) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendPaperCloudPropertyEvaluatorModuleOrganizationInvitationEmail")
+	defer span.End()
+
+	fp := path.Join("templates", "ipe/organization_invitation.html")
+	tmpl, err := template.ParseFiles(fp)
+	if err != nil {
+		return fmt.Errorf("organization invitation parsing error: %w", err)
+	}
+
+	var processed bytes.Buffer
+
+	// Render the HTML template with our data.
+	data := struct {
+		Email            string
+		OrganizationName string
+		InvitedByName    string
+		ExpiresInDays    int
+		AcceptURL        string
+	}{
+		Email:            email,
+		OrganizationName: organizationName,
+		InvitedByName:    invitedByName,
+		ExpiresInDays:    expiresInDays,
+		AcceptURL:        fmt.Sprintf("https://%s/organizations/invitations/accept?token=%s", impl.incomePropertyEmailer.GetFrontendDomainName(), url.QueryEscape(token)),
+	}
+	if err := tmpl.Execute(&processed, data); err != nil {
+		return fmt.Errorf("organization invitation template execution error: %w", err)
+	}
+	body := processed.String() // DEVELOPERS NOTE: Convert our long sequence of data into a string.
+
+	subject := fmt.Sprintf("You have been invited to join %s", organizationName)
+	if err := impl.incomePropertyEmailer.Send(ctx, impl.incomePropertyEmailer.GetSenderEmail(), subject, email, body); err != nil {
+		return fmt.Errorf("sending income property evaluator organization invitation error: %w", err)
+	}
+	log.Println("success in sending income property evaluator organization invitation email")
+	return nil
+}
@@ -7,9 +7,14 @@ import (
 	"log"
 	"path"
 	"text/template"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func (impl *templatedEmailer) SendUserVerificationEmail(ctx context.Context, monolithModule int, email, verificationCode, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendUserVerificationEmail")
+	defer span.End()
+
 	switch monolithModule {
 	case 1:
 		return impl.SendPaperCloudPropertyEvaluatorModuleUserVerificationEmail(ctx, email, verificationCode, firstName)
@@ -19,6 +24,9 @@ func (impl *templatedEmailer) SendUserVerificationEmail(ctx context.Context, mon
 }
 
 func (impl *templatedEmailer) SendPaperCloudPropertyEvaluatorModuleUserVerificationEmail(ctx context.Context, email, verificationCode, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendPaperCloudPropertyEvaluatorModuleUserVerificationEmail")
+	defer span.End()
+
 	fp := path.Join("templates", "ipe/user_verification_email.html")
 	tmpl, err := template.ParseFiles(fp)
 	if err != nil {
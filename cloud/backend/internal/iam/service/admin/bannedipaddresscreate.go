@@ -0,0 +1,140 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_bannedipaddress "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/blacklist"
+)
+
+type CreateBannedIPAddressRequestDTO struct {
+	// Value is an IP address, CIDR range or IPv6 prefix.
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+
+	// ExpiresAt makes the ban temporary, it is permanent if omitted.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// CreateBannedIPAddressService bans an IP address or range. The caller must
+// notify the blacklist once the transaction is committed so every instance
+// starts enforcing it.
+type CreateBannedIPAddressService interface {
+	Execute(sessCtx context.Context, req *CreateBannedIPAddressRequestDTO) (*dom_banip.BannedIPAddress, error)
+}
+
+type createBannedIPAddressServiceImpl struct {
+	config                           *config.Configuration
+	logger                           *zap.Logger
+	bannedIPAddressGetByValueUseCase uc_bannedipaddress.BannedIPAddressGetByValueUseCase
+	bannedIPAddressDeleteByIDUseCase uc_bannedipaddress.BannedIPAddressDeleteByIDUseCase
+	createBannedIPAddressUseCase     uc_bannedipaddress.CreateBannedIPAddressUseCase
+	auditEventCreateUseCase          uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewCreateBannedIPAddressService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	bannedIPAddressGetByValueUseCase uc_bannedipaddress.BannedIPAddressGetByValueUseCase,
+	bannedIPAddressDeleteByIDUseCase uc_bannedipaddress.BannedIPAddressDeleteByIDUseCase,
+	createBannedIPAddressUseCase uc_bannedipaddress.CreateBannedIPAddressUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) CreateBannedIPAddressService {
+	return &createBannedIPAddressServiceImpl{
+		config:                           config,
+		logger:                           logger,
+		bannedIPAddressGetByValueUseCase: bannedIPAddressGetByValueUseCase,
+		bannedIPAddressDeleteByIDUseCase: bannedIPAddressDeleteByIDUseCase,
+		createBannedIPAddressUseCase:     createBannedIPAddressUseCase,
+		auditEventCreateUseCase:          auditEventCreateUseCase,
+	}
+}
+
+func (svc *createBannedIPAddressServiceImpl) Execute(sessCtx context.Context, req *CreateBannedIPAddressRequestDTO) (*dom_banip.BannedIPAddress, error) {
+	sessCtx, span := tracing.Start(sessCtx, "CreateBannedIPAddressService.Execute")
+	defer span.End()
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("federateduser id not found in context")
+	}
+
+	now := time.Now()
+	e := make(map[string]string)
+	value, err := blacklist.NormalizeValue(req.Value)
+	if strings.TrimSpace(req.Value) == "" {
+		e["value"] = "Value is required"
+	} else if err != nil {
+		e["value"] = "Value must be an IP address or CIDR range"
+	} else if strings.HasSuffix(value, "/0") {
+		e["value"] = "Value must not include every IP address"
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		e["expires_at"] = "Expiry must be in the future"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	existing, err := svc.bannedIPAddressGetByValueUseCase.Execute(sessCtx, value)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if !existing.IsExpired(now) {
+			return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("value", "Value is already banned"), httperror.CodeAlreadyExists)
+		}
+		// The TTL index has not removed the expired ban yet; remove it now
+		// as the value must be unique.
+		if err := svc.bannedIPAddressDeleteByIDUseCase.Execute(sessCtx, existing.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ban := &dom_banip.BannedIPAddress{
+		ID:              primitive.NewObjectID(),
+		Value:           value,
+		Reason:          strings.TrimSpace(req.Reason),
+		CreatedByUserID: userID,
+		CreatedAt:       now,
+	}
+	if req.ExpiresAt != nil {
+		ban.ExpiresAt = *req.ExpiresAt
+	}
+	if err := svc.createBannedIPAddressUseCase.Execute(sessCtx, ban); err != nil {
+		return nil, err
+	}
+
+	details := map[string]string{
+		"banned_ip_address_id": ban.ID.Hex(),
+		"value":                ban.Value,
+		"reason":               ban.Reason,
+	}
+	if !ban.ExpiresAt.IsZero() {
+		details["expires_at"] = ban.ExpiresAt.Format(time.RFC3339)
+	}
+	event := newAuditEvent(dom_auditevent.AuditEventTypeAdminIPAddressBanned, userID, details)
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("ip address banned by administrator",
+		zap.String("value", ban.Value),
+		zap.Time("expires_at", ban.ExpiresAt))
+	return ban, nil
+}
@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_bannedipaddress "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// DeleteBannedIPAddressService lifts a ban. The caller must notify the
+// blacklist once the transaction is committed. Entries of the blacklist files
+// cannot be removed at runtime.
+type DeleteBannedIPAddressService interface {
+	Execute(sessCtx context.Context, id primitive.ObjectID) error
+}
+
+type deleteBannedIPAddressServiceImpl struct {
+	config                           *config.Configuration
+	logger                           *zap.Logger
+	bannedIPAddressGetByIDUseCase    uc_bannedipaddress.BannedIPAddressGetByIDUseCase
+	bannedIPAddressDeleteByIDUseCase uc_bannedipaddress.BannedIPAddressDeleteByIDUseCase
+	auditEventCreateUseCase          uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewDeleteBannedIPAddressService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	bannedIPAddressGetByIDUseCase uc_bannedipaddress.BannedIPAddressGetByIDUseCase,
+	bannedIPAddressDeleteByIDUseCase uc_bannedipaddress.BannedIPAddressDeleteByIDUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) DeleteBannedIPAddressService {
+	return &deleteBannedIPAddressServiceImpl{
+		config:                           config,
+		logger:                           logger,
+		bannedIPAddressGetByIDUseCase:    bannedIPAddressGetByIDUseCase,
+		bannedIPAddressDeleteByIDUseCase: bannedIPAddressDeleteByIDUseCase,
+		auditEventCreateUseCase:          auditEventCreateUseCase,
+	}
+}
+
+func (svc *deleteBannedIPAddressServiceImpl) Execute(sessCtx context.Context, id primitive.ObjectID) error {
+	sessCtx, span := tracing.Start(sessCtx, "DeleteBannedIPAddressService.Execute")
+	defer span.End()
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return errors.New("federateduser id not found in context")
+	}
+
+	ban, err := svc.bannedIPAddressGetByIDUseCase.Execute(sessCtx, id)
+	if err != nil {
+		return err
+	}
+	if ban == nil {
+		return httperror.NewForNotFoundWithSingleField("id", "Banned IP address does not exist")
+	}
+
+	if err := svc.bannedIPAddressDeleteByIDUseCase.Execute(sessCtx, id); err != nil {
+		return err
+	}
+
+	event := newAuditEvent(dom_auditevent.AuditEventTypeAdminIPAddressUnbanned, userID, map[string]string{
+		"banned_ip_address_id": ban.ID.Hex(),
+		"value":                ban.Value,
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return err
+	}
+
+	svc.logger.Info("ip address unbanned by administrator",
+		zap.String("value", ban.Value))
+	return nil
+}
@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	uc_bannedipaddress "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type ListBannedIPAddressesService interface {
+	Execute(sessCtx context.Context, filter *dom_banip.BannedIPAddressFilter) (*dom_banip.BannedIPAddressFilterResult, error)
+}
+
+type listBannedIPAddressesServiceImpl struct {
+	config                             *config.Configuration
+	logger                             *zap.Logger
+	bannedIPAddressListByFilterUseCase uc_bannedipaddress.BannedIPAddressListByFilterUseCase
+}
+
+func NewListBannedIPAddressesService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	bannedIPAddressListByFilterUseCase uc_bannedipaddress.BannedIPAddressListByFilterUseCase,
+) ListBannedIPAddressesService {
+	return &listBannedIPAddressesServiceImpl{
+		config:                             config,
+		logger:                             logger,
+		bannedIPAddressListByFilterUseCase: bannedIPAddressListByFilterUseCase,
+	}
+}
+
+func (svc *listBannedIPAddressesServiceImpl) Execute(sessCtx context.Context, filter *dom_banip.BannedIPAddressFilter) (*dom_banip.BannedIPAddressFilterResult, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ListBannedIPAddressesService.Execute")
+	defer span.End()
+
+	res, err := svc.bannedIPAddressListByFilterUseCase.Execute(sessCtx, filter)
+	if err != nil {
+		svc.logger.Error("failed listing banned ip addresses", zap.Any("error", err))
+		return nil, err
+	}
+	return res, nil
+}
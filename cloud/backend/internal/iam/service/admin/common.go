@@ -0,0 +1,78 @@
+package admin
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+)
+
+// FederatedUserResponseDTO is the administrator view of a federated user. It
+// purposefully excludes the E2EE key material and verification codes.
+type FederatedUserResponseDTO struct {
+	ID                    primitive.ObjectID `json:"id"`
+	Email                 string             `json:"email"`
+	FirstName             string             `json:"first_name"`
+	LastName              string             `json:"last_name"`
+	Name                  string             `json:"name"`
+	LexicalName           string             `json:"lexical_name"`
+	Role                  int8               `json:"role"`
+	Status                int8               `json:"status"`
+	WasEmailVerified      bool               `json:"was_email_verified"`
+	Phone                 string             `json:"phone,omitempty"`
+	Country               string             `json:"country,omitempty"`
+	Timezone              string             `json:"timezone"`
+	Region                string             `json:"region,omitempty"`
+	City                  string             `json:"city,omitempty"`
+	OTPEnabled            bool               `json:"otp_enabled"`
+	CreatedFromIPAddress  string             `json:"created_from_ip_address"`
+	CreatedAt             time.Time          `json:"created_at"`
+	ModifiedFromIPAddress string             `json:"modified_from_ip_address"`
+	ModifiedByUserID      primitive.ObjectID `json:"modified_by_user_id"`
+	ModifiedAt            time.Time          `json:"modified_at"`
+	ModifiedByName        string             `json:"modified_by_name"`
+	InviteID              primitive.ObjectID `json:"invite_id,omitempty"`
+}
+
+func newFederatedUserResponseDTO(u *dom_user.FederatedUser) *FederatedUserResponseDTO {
+	return &FederatedUserResponseDTO{
+		ID:                    u.ID,
+		Email:                 u.Email,
+		FirstName:             u.FirstName,
+		LastName:              u.LastName,
+		Name:                  u.Name,
+		LexicalName:           u.LexicalName,
+		Role:                  u.Role,
+		Status:                u.Status,
+		WasEmailVerified:      u.WasEmailVerified,
+		Phone:                 u.Phone,
+		Country:               u.Country,
+		Timezone:              u.Timezone,
+		Region:                u.Region,
+		City:                  u.City,
+		OTPEnabled:            u.OTPEnabled,
+		CreatedFromIPAddress:  u.CreatedFromIPAddress,
+		CreatedAt:             u.CreatedAt,
+		ModifiedFromIPAddress: u.ModifiedFromIPAddress,
+		ModifiedByUserID:      u.ModifiedByUserID,
+		ModifiedAt:            u.ModifiedAt,
+		ModifiedByName:        u.ModifiedByName,
+		InviteID:              u.InviteID,
+	}
+}
+
+// newAuditEvent creates the audit event for an action the administrator in
+// the session performed on `subjectUserID`; the actor is filled in from the
+// session when the event is recorded.
+func newAuditEvent(eventType string, subjectUserID primitive.ObjectID, details map[string]string) *dom_auditevent.AuditEvent {
+	return &dom_auditevent.AuditEvent{
+		ID:            primitive.NewObjectID(),
+		Type:          eventType,
+		Outcome:       dom_auditevent.AuditEventOutcomeSuccess,
+		SubjectUserID: subjectUserID,
+		Details:       details,
+		CreatedAt:     time.Now(),
+	}
+}
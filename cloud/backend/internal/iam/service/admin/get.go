@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type GetFederatedUserService interface {
+	Execute(sessCtx context.Context, id primitive.ObjectID) (*FederatedUserResponseDTO, error)
+}
+
+type getFederatedUserServiceImpl struct {
+	config             *config.Configuration
+	logger             *zap.Logger
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase
+}
+
+func NewGetFederatedUserService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+) GetFederatedUserService {
+	return &getFederatedUserServiceImpl{
+		config:             config,
+		logger:             logger,
+		userGetByIDUseCase: userGetByIDUseCase,
+	}
+}
+
+func (svc *getFederatedUserServiceImpl) Execute(sessCtx context.Context, id primitive.ObjectID) (*FederatedUserResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GetFederatedUserService.Execute")
+	defer span.End()
+
+	u, err := svc.userGetByIDUseCase.Execute(sessCtx, id)
+	if err != nil {
+		svc.logger.Error("failed getting federated user", zap.Any("error", err))
+		return nil, err
+	}
+	if u == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "User does not exist"), httperror.CodeUserNotFound)
+	}
+	return newFederatedUserResponseDTO(u), nil
+}
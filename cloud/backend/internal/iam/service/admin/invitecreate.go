@@ -0,0 +1,139 @@
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type CreateInviteRequestDTO struct {
+	// Code is optional; a random code is generated when left empty.
+	Code string `json:"code,omitempty"`
+
+	// Module refers to which module the invite lets people register for.
+	Module    int        `json:"module"`
+	MaxUses   int        `json:"max_uses"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Note      string     `json:"note,omitempty"`
+}
+
+type CreateInviteService interface {
+	Execute(sessCtx context.Context, req *CreateInviteRequestDTO) (*dom_invite.Invite, error)
+}
+
+type createInviteServiceImpl struct {
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	inviteCreateUseCase     uc_invite.InviteCreateUseCase
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewCreateInviteService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	inviteCreateUseCase uc_invite.InviteCreateUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) CreateInviteService {
+	return &createInviteServiceImpl{
+		config:                  config,
+		logger:                  logger,
+		inviteCreateUseCase:     inviteCreateUseCase,
+		auditEventCreateUseCase: auditEventCreateUseCase,
+	}
+}
+
+func (svc *createInviteServiceImpl) Execute(sessCtx context.Context, req *CreateInviteRequestDTO) (*dom_invite.Invite, error) {
+	sessCtx, span := tracing.Start(sessCtx, "CreateInviteService.Execute")
+	defer span.End()
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("federateduser id not found in context")
+	}
+	userName, _ := sessCtx.Value(constants.SessionFederatedUserName).(string)
+
+	req.Code = uc_invite.NormalizeCode(req.Code)
+
+	e := make(map[string]string)
+	if len(req.Code) > 64 {
+		e["code"] = "Code is too long"
+	}
+	if req.Module == 0 {
+		e["module"] = "Module is required"
+	} else if req.Module != int(constants.MonolithModulePaperCloudPropertyEvaluator) {
+		e["module"] = "Module is invalid"
+	}
+	if req.MaxUses <= 0 {
+		e["max_uses"] = "Max uses must be greater than zero"
+	}
+	if req.ExpiresAt != nil && req.ExpiresAt.Before(time.Now()) {
+		e["expires_at"] = "Expiry must be in the future"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	if req.Code == "" {
+		code, err := generateInviteCode()
+		if err != nil {
+			svc.logger.Error("failed generating invite code", zap.Any("error", err))
+			return nil, err
+		}
+		req.Code = code
+	}
+
+	invite := &dom_invite.Invite{
+		ID:              primitive.NewObjectID(),
+		Code:            req.Code,
+		Module:          req.Module,
+		MaxUses:         req.MaxUses,
+		ExpiresAt:       req.ExpiresAt,
+		Note:            req.Note,
+		CreatedByUserID: userID,
+		CreatedByName:   userName,
+		CreatedAt:       time.Now(),
+	}
+	if err := svc.inviteCreateUseCase.Execute(sessCtx, invite); err != nil {
+		return nil, err
+	}
+
+	event := newAuditEvent(dom_auditevent.AuditEventTypeAdminInviteCreated, userID, map[string]string{
+		"invite_id": invite.ID.Hex(),
+		"module":    strconv.Itoa(invite.Module),
+		"max_uses":  strconv.Itoa(invite.MaxUses),
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("invite created by administrator",
+		zap.String("invite_id", invite.ID.Hex()))
+	return invite, nil
+}
+
+// generateInviteCode returns a random code which is easy to read out loud
+// and type in.
+func generateInviteCode() (string, error) {
+	b := make([]byte, 5)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b), nil
+}
@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+	uc_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type ListInvitesService interface {
+	Execute(sessCtx context.Context, filter *dom_invite.InviteFilter) (*dom_invite.InviteFilterResult, error)
+}
+
+type listInvitesServiceImpl struct {
+	config                    *config.Configuration
+	logger                    *zap.Logger
+	inviteListByFilterUseCase uc_invite.InviteListByFilterUseCase
+}
+
+func NewListInvitesService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	inviteListByFilterUseCase uc_invite.InviteListByFilterUseCase,
+) ListInvitesService {
+	return &listInvitesServiceImpl{
+		config:                    config,
+		logger:                    logger,
+		inviteListByFilterUseCase: inviteListByFilterUseCase,
+	}
+}
+
+func (svc *listInvitesServiceImpl) Execute(sessCtx context.Context, filter *dom_invite.InviteFilter) (*dom_invite.InviteFilterResult, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ListInvitesService.Execute")
+	defer span.End()
+
+	res, err := svc.inviteListByFilterUseCase.Execute(sessCtx, filter)
+	if err != nil {
+		svc.logger.Error("failed listing invites", zap.Any("error", err))
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type ListFederatedUsersResponseDTO struct {
+	Users         []*FederatedUserResponseDTO `json:"users"`
+	HasMore       bool                        `json:"has_more"`
+	LastID        primitive.ObjectID          `json:"last_id,omitempty"`
+	LastCreatedAt time.Time                   `json:"last_created_at"`
+	TotalCount    uint64                      `json:"total_count"`
+}
+
+type ListFederatedUsersService interface {
+	Execute(sessCtx context.Context, filter *dom_user.FederatedUserFilter) (*ListFederatedUsersResponseDTO, error)
+}
+
+type listFederatedUsersServiceImpl struct {
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	userListByFilterUseCase uc_user.FederatedUserListByFilterUseCase
+}
+
+func NewListFederatedUsersService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	userListByFilterUseCase uc_user.FederatedUserListByFilterUseCase,
+) ListFederatedUsersService {
+	return &listFederatedUsersServiceImpl{
+		config:                  config,
+		logger:                  logger,
+		userListByFilterUseCase: userListByFilterUseCase,
+	}
+}
+
+func (svc *listFederatedUsersServiceImpl) Execute(sessCtx context.Context, filter *dom_user.FederatedUserFilter) (*ListFederatedUsersResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ListFederatedUsersService.Execute")
+	defer span.End()
+
+	res, err := svc.userListByFilterUseCase.Execute(sessCtx, filter)
+	if err != nil {
+		svc.logger.Error("failed listing federated users", zap.Any("error", err))
+		return nil, err
+	}
+
+	users := make([]*FederatedUserResponseDTO, 0, len(res.Users))
+	for _, u := range res.Users {
+		users = append(users, newFederatedUserResponseDTO(u))
+	}
+
+	return &ListFederatedUsersResponseDTO{
+		Users:         users,
+		HasMore:       res.HasMore,
+		LastID:        res.LastID,
+		LastCreatedAt: res.LastCreatedAt,
+		TotalCount:    res.TotalCount,
+	}, nil
+}
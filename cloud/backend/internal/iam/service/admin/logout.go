@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type ForceLogoutFederatedUserService interface {
+	Execute(sessCtx context.Context, id primitive.ObjectID) error
+}
+
+type forceLogoutFederatedUserServiceImpl struct {
+	config                    *config.Configuration
+	logger                    *zap.Logger
+	userGetByIDUseCase        uc_user.FederatedUserGetByIDUseCase
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase
+	auditEventCreateUseCase   uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewForceLogoutFederatedUserService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) ForceLogoutFederatedUserService {
+	return &forceLogoutFederatedUserServiceImpl{
+		config:                    config,
+		logger:                    logger,
+		userGetByIDUseCase:        userGetByIDUseCase,
+		userRevokeSessionsUseCase: userRevokeSessionsUseCase,
+		auditEventCreateUseCase:   auditEventCreateUseCase,
+	}
+}
+
+func (svc *forceLogoutFederatedUserServiceImpl) Execute(sessCtx context.Context, id primitive.ObjectID) error {
+	sessCtx, span := tracing.Start(sessCtx, "ForceLogoutFederatedUserService.Execute")
+	defer span.End()
+
+	u, err := svc.userGetByIDUseCase.Execute(sessCtx, id)
+	if err != nil {
+		return err
+	}
+	if u == nil {
+		return httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "User does not exist"), httperror.CodeUserNotFound)
+	}
+
+	if err := svc.userRevokeSessionsUseCase.Execute(sessCtx, u.ID, ""); err != nil {
+		svc.logger.Error("failed revoking federated user sessions", zap.Any("error", err))
+		return err
+	}
+
+	event := newAuditEvent(dom_auditevent.AuditEventTypeAdminUserForcedLogout, u.ID, nil)
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return err
+	}
+
+	svc.logger.Info("federated user sessions revoked by administrator",
+		zap.String("user_id", u.ID.Hex()))
+	return nil
+}
@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// DeleteOAuthClientService removes a relying party. Tokens which were
+// already issued to it stay valid until they expire.
+type DeleteOAuthClientService interface {
+	Execute(sessCtx context.Context, clientID string) error
+}
+
+type deleteOAuthClientServiceImpl struct {
+	config                             *config.Configuration
+	logger                             *zap.Logger
+	oauthClientGetByClientIDUseCase    uc_oauthclient.OAuthClientGetByClientIDUseCase
+	oauthClientDeleteByClientIDUseCase uc_oauthclient.OAuthClientDeleteByClientIDUseCase
+	auditEventCreateUseCase            uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewDeleteOAuthClientService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	oauthClientGetByClientIDUseCase uc_oauthclient.OAuthClientGetByClientIDUseCase,
+	oauthClientDeleteByClientIDUseCase uc_oauthclient.OAuthClientDeleteByClientIDUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) DeleteOAuthClientService {
+	return &deleteOAuthClientServiceImpl{
+		config:                             config,
+		logger:                             logger,
+		oauthClientGetByClientIDUseCase:    oauthClientGetByClientIDUseCase,
+		oauthClientDeleteByClientIDUseCase: oauthClientDeleteByClientIDUseCase,
+		auditEventCreateUseCase:            auditEventCreateUseCase,
+	}
+}
+
+func (svc *deleteOAuthClientServiceImpl) Execute(sessCtx context.Context, clientID string) error {
+	sessCtx, span := tracing.Start(sessCtx, "DeleteOAuthClientService.Execute")
+	defer span.End()
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return errors.New("federateduser id not found in context")
+	}
+
+	client, err := svc.oauthClientGetByClientIDUseCase.Execute(sessCtx, clientID)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		return httperror.NewForNotFoundWithSingleField("client_id", "OAuth client does not exist")
+	}
+
+	if err := svc.oauthClientDeleteByClientIDUseCase.Execute(sessCtx, clientID); err != nil {
+		return err
+	}
+
+	event := newAuditEvent(dom_auditevent.AuditEventTypeAdminOAuthClientDeleted, userID, map[string]string{
+		"client_id": client.ClientID,
+		"name":      client.Name,
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return err
+	}
+
+	svc.logger.Info("oauth client deleted by administrator",
+		zap.String("client_id", clientID))
+	return nil
+}
@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	uc_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type ListOAuthClientsService interface {
+	Execute(sessCtx context.Context) ([]*dom_oauthclient.OAuthClient, error)
+}
+
+type listOAuthClientsServiceImpl struct {
+	config                    *config.Configuration
+	logger                    *zap.Logger
+	oauthClientListAllUseCase uc_oauthclient.OAuthClientListAllUseCase
+}
+
+func NewListOAuthClientsService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	oauthClientListAllUseCase uc_oauthclient.OAuthClientListAllUseCase,
+) ListOAuthClientsService {
+	return &listOAuthClientsServiceImpl{
+		config:                    config,
+		logger:                    logger,
+		oauthClientListAllUseCase: oauthClientListAllUseCase,
+	}
+}
+
+func (svc *listOAuthClientsServiceImpl) Execute(sessCtx context.Context) ([]*dom_oauthclient.OAuthClient, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ListOAuthClientsService.Execute")
+	defer span.End()
+
+	res, err := svc.oauthClientListAllUseCase.Execute(sessCtx)
+	if err != nil {
+		svc.logger.Error("failed listing oauth clients", zap.Any("error", err))
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_emailer "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/emailer"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/random"
+)
+
+type ResendFederatedUserVerificationRequestDTO struct {
+	// Module refers to which module the verification email is sent for.
+	Module int `json:"module,omitempty"`
+}
+
+type ResendFederatedUserVerificationService interface {
+	Execute(sessCtx context.Context, id primitive.ObjectID, req *ResendFederatedUserVerificationRequestDTO) error
+}
+
+type resendFederatedUserVerificationServiceImpl struct {
+	config                                    *config.Configuration
+	logger                                    *zap.Logger
+	userGetByIDUseCase                        uc_user.FederatedUserGetByIDUseCase
+	userUpdateUseCase                         uc_user.FederatedUserUpdateUseCase
+	sendFederatedUserVerificationEmailUseCase uc_emailer.SendFederatedUserVerificationEmailUseCase
+	auditEventCreateUseCase                   uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewResendFederatedUserVerificationService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+	userUpdateUseCase uc_user.FederatedUserUpdateUseCase,
+	sendFederatedUserVerificationEmailUseCase uc_emailer.SendFederatedUserVerificationEmailUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) ResendFederatedUserVerificationService {
+	return &resendFederatedUserVerificationServiceImpl{
+		config:             config,
+		logger:             logger,
+		userGetByIDUseCase: userGetByIDUseCase,
+		userUpdateUseCase:  userUpdateUseCase,
+		sendFederatedUserVerificationEmailUseCase: sendFederatedUserVerificationEmailUseCase,
+		auditEventCreateUseCase:                   auditEventCreateUseCase,
+	}
+}
+
+func (svc *resendFederatedUserVerificationServiceImpl) Execute(sessCtx context.Context, id primitive.ObjectID, req *ResendFederatedUserVerificationRequestDTO) error {
+	sessCtx, span := tracing.Start(sessCtx, "ResendFederatedUserVerificationService.Execute")
+	defer span.End()
+
+	if req.Module == 0 {
+		req.Module = 1 // 1=PAPERCLOUD
+	}
+
+	u, err := svc.userGetByIDUseCase.Execute(sessCtx, id)
+	if err != nil {
+		return err
+	}
+	if u == nil {
+		return httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "User does not exist"), httperror.CodeUserNotFound)
+	}
+	if u.WasEmailVerified {
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "User has already verified their email"), httperror.CodeEmailAlreadyVerified)
+	}
+
+	// Issue a fresh code so a previously expired one does not get resent.
+	code, err := random.GenerateSixDigitCode()
+	if err != nil {
+		return err
+	}
+	u.EmailVerificationCode = code
+	u.EmailVerificationExpiry = time.Now().Add(72 * time.Hour)
+	if err := svc.userUpdateUseCase.Execute(sessCtx, u); err != nil {
+		svc.logger.Error("failed updating federated user", zap.Any("error", err))
+		return err
+	}
+
+	if err := svc.sendFederatedUserVerificationEmailUseCase.Execute(sessCtx, req.Module, u); err != nil {
+		svc.logger.Error("failed sending verification email", zap.Any("error", err))
+		return err
+	}
+
+	event := newAuditEvent(dom_auditevent.AuditEventTypeAdminUserVerificationResent, u.ID, map[string]string{
+		"module": strconv.Itoa(req.Module),
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return err
+	}
+
+	svc.logger.Info("federated user verification email resent by administrator",
+		zap.String("user_id", u.ID.Hex()))
+	return nil
+}
@@ -0,0 +1,140 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type ChangeFederatedUserRoleRequestDTO struct {
+	Role int8 `json:"role"`
+}
+
+type ChangeFederatedUserRoleService interface {
+	Execute(sessCtx context.Context, id primitive.ObjectID, req *ChangeFederatedUserRoleRequestDTO) (*FederatedUserResponseDTO, error)
+}
+
+type changeFederatedUserRoleServiceImpl struct {
+	config                    *config.Configuration
+	logger                    *zap.Logger
+	userGetByIDUseCase        uc_user.FederatedUserGetByIDUseCase
+	userUpdateUseCase         uc_user.FederatedUserUpdateUseCase
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase
+	auditEventCreateUseCase   uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewChangeFederatedUserRoleService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+	userUpdateUseCase uc_user.FederatedUserUpdateUseCase,
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) ChangeFederatedUserRoleService {
+	return &changeFederatedUserRoleServiceImpl{
+		config:                    config,
+		logger:                    logger,
+		userGetByIDUseCase:        userGetByIDUseCase,
+		userUpdateUseCase:         userUpdateUseCase,
+		userRevokeSessionsUseCase: userRevokeSessionsUseCase,
+		auditEventCreateUseCase:   auditEventCreateUseCase,
+	}
+}
+
+func (svc *changeFederatedUserRoleServiceImpl) Execute(sessCtx context.Context, id primitive.ObjectID, req *ChangeFederatedUserRoleRequestDTO) (*FederatedUserResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ChangeFederatedUserRoleService.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Get required from context.
+	//
+
+	adminID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("federateduser id not found in context")
+	}
+	adminName, _ := sessCtx.Value(constants.SessionFederatedUserName).(string)
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+
+	//
+	// STEP 2: Validation.
+	//
+
+	e := make(map[string]string)
+	switch req.Role {
+	case dom_user.FederatedUserRoleRoot, dom_user.FederatedUserRoleCompany, dom_user.FederatedUserRoleIndividual:
+	default:
+		e["role"] = "Role is invalid"
+	}
+	if id == adminID {
+		e["id"] = "You cannot change the role of your own account"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 3: Update the user.
+	//
+
+	u, err := svc.userGetByIDUseCase.Execute(sessCtx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "User does not exist"), httperror.CodeUserNotFound)
+	}
+
+	oldRole := u.Role
+	u.Role = req.Role
+	u.ModifiedAt = time.Now()
+	u.ModifiedByUserID = adminID
+	u.ModifiedByName = adminName
+	u.ModifiedFromIPAddress = ipAddress
+	if err := svc.userUpdateUseCase.Execute(sessCtx, u); err != nil {
+		svc.logger.Error("failed updating federated user", zap.Any("error", err))
+		return nil, err
+	}
+
+	// The role is cached with the session so we force the user to login again
+	// for the new role to take effect.
+	if err := svc.userRevokeSessionsUseCase.Execute(sessCtx, u.ID, ""); err != nil {
+		svc.logger.Error("failed revoking federated user sessions", zap.Any("error", err))
+		return nil, err
+	}
+
+	//
+	// STEP 4: Record our audit trail.
+	//
+
+	event := newAuditEvent(dom_auditevent.AuditEventTypeAdminUserRoleChanged, u.ID, map[string]string{
+		"old_role": strconv.Itoa(int(oldRole)),
+		"new_role": strconv.Itoa(int(u.Role)),
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("federated user role changed by administrator",
+		zap.String("admin_id", adminID.Hex()),
+		zap.String("user_id", u.ID.Hex()),
+		zap.Int8("old_role", oldRole),
+		zap.Int8("new_role", u.Role))
+
+	return newFederatedUserResponseDTO(u), nil
+}
@@ -0,0 +1,141 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type ChangeFederatedUserStatusRequestDTO struct {
+	Status int8 `json:"status"`
+}
+
+type ChangeFederatedUserStatusService interface {
+	Execute(sessCtx context.Context, id primitive.ObjectID, req *ChangeFederatedUserStatusRequestDTO) (*FederatedUserResponseDTO, error)
+}
+
+type changeFederatedUserStatusServiceImpl struct {
+	config                    *config.Configuration
+	logger                    *zap.Logger
+	userGetByIDUseCase        uc_user.FederatedUserGetByIDUseCase
+	userUpdateUseCase         uc_user.FederatedUserUpdateUseCase
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase
+	auditEventCreateUseCase   uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewChangeFederatedUserStatusService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+	userUpdateUseCase uc_user.FederatedUserUpdateUseCase,
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) ChangeFederatedUserStatusService {
+	return &changeFederatedUserStatusServiceImpl{
+		config:                    config,
+		logger:                    logger,
+		userGetByIDUseCase:        userGetByIDUseCase,
+		userUpdateUseCase:         userUpdateUseCase,
+		userRevokeSessionsUseCase: userRevokeSessionsUseCase,
+		auditEventCreateUseCase:   auditEventCreateUseCase,
+	}
+}
+
+func (svc *changeFederatedUserStatusServiceImpl) Execute(sessCtx context.Context, id primitive.ObjectID, req *ChangeFederatedUserStatusRequestDTO) (*FederatedUserResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ChangeFederatedUserStatusService.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Get required from context.
+	//
+
+	adminID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("federateduser id not found in context")
+	}
+	adminName, _ := sessCtx.Value(constants.SessionFederatedUserName).(string)
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+
+	//
+	// STEP 2: Validation.
+	//
+
+	e := make(map[string]string)
+	switch req.Status {
+	case dom_user.FederatedUserStatusActive, dom_user.FederatedUserStatusLocked, dom_user.FederatedUserStatusArchived:
+	default:
+		e["status"] = "Status is invalid"
+	}
+	if id == adminID {
+		e["id"] = "You cannot change the status of your own account"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 3: Update the user.
+	//
+
+	u, err := svc.userGetByIDUseCase.Execute(sessCtx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "User does not exist"), httperror.CodeUserNotFound)
+	}
+
+	oldStatus := u.Status
+	u.Status = req.Status
+	u.ModifiedAt = time.Now()
+	u.ModifiedByUserID = adminID
+	u.ModifiedByName = adminName
+	u.ModifiedFromIPAddress = ipAddress
+	if err := svc.userUpdateUseCase.Execute(sessCtx, u); err != nil {
+		svc.logger.Error("failed updating federated user", zap.Any("error", err))
+		return nil, err
+	}
+
+	// Locked and archived users must not keep any active session.
+	if u.Status != dom_user.FederatedUserStatusActive {
+		if err := svc.userRevokeSessionsUseCase.Execute(sessCtx, u.ID, ""); err != nil {
+			svc.logger.Error("failed revoking federated user sessions", zap.Any("error", err))
+			return nil, err
+		}
+	}
+
+	//
+	// STEP 4: Record our audit trail.
+	//
+
+	event := newAuditEvent(dom_auditevent.AuditEventTypeAdminUserStatusChanged, u.ID, map[string]string{
+		"old_status": strconv.Itoa(int(oldStatus)),
+		"new_status": strconv.Itoa(int(u.Status)),
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("federated user status changed by administrator",
+		zap.String("admin_id", adminID.Hex()),
+		zap.String("user_id", u.ID.Hex()),
+		zap.Int8("old_status", oldStatus),
+		zap.Int8("new_status", u.Status))
+
+	return newFederatedUserResponseDTO(u), nil
+}
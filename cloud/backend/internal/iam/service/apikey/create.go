@@ -0,0 +1,165 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/apikey"
+	uc_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+const (
+	defaultAPIKeyExpiryDays = 90
+	maxAPIKeyExpiryDays     = 365
+)
+
+type CreateAPIKeyRequestDTO struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays int      `json:"expires_in_days,omitempty"`
+}
+
+// CreateAPIKeyResponseDTO is the only time the plaintext key is returned.
+type CreateAPIKeyResponseDTO struct {
+	ID        primitive.ObjectID `json:"id"`
+	Name      string             `json:"name"`
+	Key       string             `json:"key"`
+	Hint      string             `json:"hint"`
+	Scopes    []string           `json:"scopes"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type CreateAPIKeyService interface {
+	Execute(sessCtx context.Context, req *CreateAPIKeyRequestDTO) (*CreateAPIKeyResponseDTO, error)
+}
+
+type createAPIKeyServiceImpl struct {
+	config              *config.Configuration
+	logger              *zap.Logger
+	apiKeyCreateUseCase uc_apikey.APIKeyCreateUseCase
+}
+
+func NewCreateAPIKeyService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	apiKeyCreateUseCase uc_apikey.APIKeyCreateUseCase,
+) CreateAPIKeyService {
+	return &createAPIKeyServiceImpl{
+		config:              config,
+		logger:              logger,
+		apiKeyCreateUseCase: apiKeyCreateUseCase,
+	}
+}
+
+func (svc *createAPIKeyServiceImpl) Execute(sessCtx context.Context, req *CreateAPIKeyRequestDTO) (*CreateAPIKeyResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "CreateAPIKeyService.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Get required from context.
+	//
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("federateduser id not found in context")
+	}
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+
+	// API keys must not be able to mint more API keys.
+	if _, isAPIKey := sessCtx.Value(constants.SessionAPIKeyID).(primitive.ObjectID); isAPIKey {
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "API keys cannot be created using an API key"), httperror.CodePermissionDenied)
+	}
+
+	//
+	// STEP 2: Validation.
+	//
+
+	if req == nil {
+		svc.logger.Warn("Failed validation with nothing received")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("non_field_error", "Request is required in submission"), httperror.CodeMalformedRequest)
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.ExpiresInDays == 0 {
+		req.ExpiresInDays = defaultAPIKeyExpiryDays
+	}
+
+	e := make(map[string]string)
+	if req.Name == "" {
+		e["name"] = "Name is required"
+	} else if len(req.Name) > 100 {
+		e["name"] = "Name is too long"
+	}
+	if len(req.Scopes) == 0 {
+		e["scopes"] = "At least one scope is required"
+	}
+	for _, scope := range req.Scopes {
+		if !dom_apikey.IsValidScope(scope) {
+			e["scopes"] = fmt.Sprintf("Unsupported scope: %s", scope)
+			break
+		}
+	}
+	if req.ExpiresInDays < 1 || req.ExpiresInDays > maxAPIKeyExpiryDays {
+		e["expires_in_days"] = fmt.Sprintf("Must be between 1 and %d days", maxAPIKeyExpiryDays)
+	}
+	if len(e) != 0 {
+		svc.logger.Warn("Failed validation", zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 3: Generate the key. Only the hash is stored.
+	//
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		svc.logger.Error("Failed generating api key", zap.Any("error", err))
+		return nil, err
+	}
+	plaintext := dom_apikey.APIKeyPrefix + hex.EncodeToString(secret)
+
+	now := time.Now()
+	apiKey := &dom_apikey.APIKey{
+		ID:                   primitive.NewObjectID(),
+		FederatedUserID:      userID,
+		Name:                 req.Name,
+		Hint:                 plaintext[:len(dom_apikey.APIKeyPrefix)+6],
+		KeyHash:              uc_apikey.HashAPIKey(plaintext),
+		Scopes:               req.Scopes,
+		ExpiresAt:            now.Add(time.Duration(req.ExpiresInDays) * 24 * time.Hour),
+		CreatedAt:            now,
+		CreatedFromIPAddress: ipAddress,
+	}
+	if err := svc.apiKeyCreateUseCase.Execute(sessCtx, apiKey); err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("API key created",
+		zap.String("user_id", userID.Hex()),
+		zap.String("api_key_id", apiKey.ID.Hex()))
+
+	return &CreateAPIKeyResponseDTO{
+		ID:        apiKey.ID,
+		Name:      apiKey.Name,
+		Key:       plaintext,
+		Hint:      apiKey.Hint,
+		Scopes:    apiKey.Scopes,
+		ExpiresAt: apiKey.ExpiresAt,
+		CreatedAt: apiKey.CreatedAt,
+	}, nil
+}
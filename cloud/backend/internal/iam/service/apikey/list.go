@@ -0,0 +1,62 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/apikey"
+	uc_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type ListAPIKeysResponseDTO struct {
+	APIKeys []*dom_apikey.APIKey `json:"api_keys"`
+}
+
+type ListAPIKeysService interface {
+	Execute(sessCtx context.Context) (*ListAPIKeysResponseDTO, error)
+}
+
+type listAPIKeysServiceImpl struct {
+	config                             *config.Configuration
+	logger                             *zap.Logger
+	apiKeyListByFederatedUserIDUseCase uc_apikey.APIKeyListByFederatedUserIDUseCase
+}
+
+func NewListAPIKeysService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	apiKeyListByFederatedUserIDUseCase uc_apikey.APIKeyListByFederatedUserIDUseCase,
+) ListAPIKeysService {
+	return &listAPIKeysServiceImpl{
+		config:                             config,
+		logger:                             logger,
+		apiKeyListByFederatedUserIDUseCase: apiKeyListByFederatedUserIDUseCase,
+	}
+}
+
+func (svc *listAPIKeysServiceImpl) Execute(sessCtx context.Context) (*ListAPIKeysResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ListAPIKeysService.Execute")
+	defer span.End()
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("federateduser id not found in context")
+	}
+
+	apiKeys, err := svc.apiKeyListByFederatedUserIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListAPIKeysResponseDTO{
+		APIKeys: apiKeys,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	uc_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type RevokeAPIKeyService interface {
+	Execute(sessCtx context.Context, id primitive.ObjectID) error
+}
+
+type revokeAPIKeyServiceImpl struct {
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	apiKeyGetByIDUseCase    uc_apikey.APIKeyGetByIDUseCase
+	apiKeyDeleteByIDUseCase uc_apikey.APIKeyDeleteByIDUseCase
+}
+
+func NewRevokeAPIKeyService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	apiKeyGetByIDUseCase uc_apikey.APIKeyGetByIDUseCase,
+	apiKeyDeleteByIDUseCase uc_apikey.APIKeyDeleteByIDUseCase,
+) RevokeAPIKeyService {
+	return &revokeAPIKeyServiceImpl{
+		config:                  config,
+		logger:                  logger,
+		apiKeyGetByIDUseCase:    apiKeyGetByIDUseCase,
+		apiKeyDeleteByIDUseCase: apiKeyDeleteByIDUseCase,
+	}
+}
+
+func (svc *revokeAPIKeyServiceImpl) Execute(sessCtx context.Context, id primitive.ObjectID) error {
+	sessCtx, span := tracing.Start(sessCtx, "RevokeAPIKeyService.Execute")
+	defer span.End()
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return errors.New("federateduser id not found in context")
+	}
+
+	apiKey, err := svc.apiKeyGetByIDUseCase.Execute(sessCtx, id)
+	if err != nil {
+		return err
+	}
+	// Do not reveal the existence of keys belonging to other users.
+	if apiKey == nil || apiKey.FederatedUserID != userID {
+		return httperror.NewForNotFoundWithSingleField("id", "API key does not exist")
+	}
+
+	if err := svc.apiKeyDeleteByIDUseCase.Execute(sessCtx, id); err != nil {
+		return err
+	}
+
+	svc.logger.Info("API key revoked",
+		zap.String("user_id", userID.Hex()),
+		zap.String("api_key_id", id.Hex()))
+	return nil
+}
@@ -0,0 +1,124 @@
+package consent
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/consentrecord"
+	uc_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/consentrecord"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type AcceptTermsOfServiceRequestDTO struct {
+	// Version is the version of the terms of service the user was shown. It
+	// must match the current version so users never accept a document they
+	// have not seen.
+	Version string `json:"version"`
+}
+
+type AcceptTermsOfServiceService interface {
+	Execute(sessCtx context.Context, req *AcceptTermsOfServiceRequestDTO) error
+}
+
+type acceptTermsOfServiceServiceImpl struct {
+	config                     *config.Configuration
+	logger                     *zap.Logger
+	userGetByIDUseCase         uc_user.FederatedUserGetByIDUseCase
+	userUpdateUseCase          uc_user.FederatedUserUpdateUseCase
+	consentRecordCreateUseCase uc_consentrecord.ConsentRecordCreateUseCase
+}
+
+func NewAcceptTermsOfServiceService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	uc1 uc_user.FederatedUserGetByIDUseCase,
+	uc2 uc_user.FederatedUserUpdateUseCase,
+	uc3 uc_consentrecord.ConsentRecordCreateUseCase,
+) AcceptTermsOfServiceService {
+	return &acceptTermsOfServiceServiceImpl{config, logger, uc1, uc2, uc3}
+}
+
+func (svc *acceptTermsOfServiceServiceImpl) Execute(sessCtx context.Context, req *AcceptTermsOfServiceRequestDTO) error {
+	sessCtx, span := tracing.Start(sessCtx, "AcceptTermsOfServiceService.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	if req == nil {
+		svc.logger.Warn("Failed validation with nothing received")
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("non_field_error", "Request is required in submission"), httperror.CodeMalformedRequest)
+	}
+
+	e := make(map[string]string)
+	if req.Version == "" {
+		e["version"] = "Version is required"
+	} else if req.Version != svc.config.App.TermsOfServiceVersion {
+		e["version"] = "Version is not the current terms of service"
+	}
+	if len(e) != 0 {
+		svc.logger.Warn("Failed validation",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get the user.
+	//
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return errors.New("federateduser id not found in context")
+	}
+	user, err := svc.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		svc.logger.Error("failed getting federateduser", zap.Any("error", err))
+		return err
+	}
+	if user == nil {
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("message", "User does not exist"), httperror.CodeUserNotFound)
+	}
+
+	//
+	// STEP 3: Record the acceptance.
+	//
+
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+	now := time.Now()
+	user.AgreeTermsOfService = true
+	user.AgreeTermsOfServiceVersion = req.Version
+	user.AgreeTermsOfServiceAt = now
+	user.ModifiedAt = now
+	user.ModifiedByUserID = user.ID
+	user.ModifiedByName = user.Name
+	user.ModifiedFromIPAddress = ipAddress
+	if err := svc.userUpdateUseCase.Execute(sessCtx, user); err != nil {
+		svc.logger.Error("failed updating federateduser", zap.Any("error", err))
+		return err
+	}
+
+	record := &dom_consentrecord.ConsentRecord{
+		FederatedUserID: user.ID,
+		Type:            dom_consentrecord.ConsentTypeTermsOfService,
+		DocumentVersion: req.Version,
+		Granted:         true,
+		CreatedAt:       now,
+	}
+	if err := svc.consentRecordCreateUseCase.Execute(sessCtx, record); err != nil {
+		svc.logger.Error("failed recording consent", zap.Any("error", err))
+		return err
+	}
+
+	return nil
+}
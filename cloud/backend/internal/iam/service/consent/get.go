@@ -0,0 +1,87 @@
+package consent
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/consentrecord"
+	uc_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/consentrecord"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// ConsentsResponseDTO is the current consent state of the authenticated user
+// together with the full history from the consent ledger.
+type ConsentsResponseDTO struct {
+	CurrentTermsOfServiceVersion                   string                             `json:"current_terms_of_service_version"`
+	AgreeTermsOfServiceVersion                     string                             `json:"agree_terms_of_service_version"`
+	AgreeTermsOfServiceAt                          time.Time                          `json:"agree_terms_of_service_at,omitempty"`
+	TermsOfServiceOutdated                         bool                               `json:"terms_of_service_outdated"`
+	AgreePromotions                                bool                               `json:"agree_promotions"`
+	AgreeToTrackingAcrossThirdPartyAppsAndServices bool                               `json:"agree_to_tracking_across_third_party_apps_and_services"`
+	Records                                        []*dom_consentrecord.ConsentRecord `json:"records"`
+}
+
+type GetMyConsentsService interface {
+	Execute(sessCtx context.Context) (*ConsentsResponseDTO, error)
+}
+
+type getMyConsentsServiceImpl struct {
+	config                                    *config.Configuration
+	logger                                    *zap.Logger
+	userGetByIDUseCase                        uc_user.FederatedUserGetByIDUseCase
+	consentRecordListByFederatedUserIDUseCase uc_consentrecord.ConsentRecordListByFederatedUserIDUseCase
+}
+
+func NewGetMyConsentsService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	uc1 uc_user.FederatedUserGetByIDUseCase,
+	uc2 uc_consentrecord.ConsentRecordListByFederatedUserIDUseCase,
+) GetMyConsentsService {
+	return &getMyConsentsServiceImpl{config, logger, uc1, uc2}
+}
+
+func (svc *getMyConsentsServiceImpl) Execute(sessCtx context.Context) (*ConsentsResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GetMyConsentsService.Execute")
+	defer span.End()
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("federateduser id not found in context")
+	}
+
+	user, err := svc.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		svc.logger.Error("failed getting federateduser", zap.Any("error", err))
+		return nil, err
+	}
+	if user == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("message", "User does not exist"), httperror.CodeUserNotFound)
+	}
+
+	records, err := svc.consentRecordListByFederatedUserIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		svc.logger.Error("failed listing consent records", zap.Any("error", err))
+		return nil, err
+	}
+
+	return &ConsentsResponseDTO{
+		CurrentTermsOfServiceVersion:                   svc.config.App.TermsOfServiceVersion,
+		AgreeTermsOfServiceVersion:                     user.AgreeTermsOfServiceVersion,
+		AgreeTermsOfServiceAt:                          user.AgreeTermsOfServiceAt,
+		TermsOfServiceOutdated:                         user.IsTermsOfServiceOutdated(svc.config.App.TermsOfServiceVersion),
+		AgreePromotions:                                user.AgreePromotions,
+		AgreeToTrackingAcrossThirdPartyAppsAndServices: user.AgreeToTrackingAcrossThirdPartyAppsAndServices,
+		Records: records,
+	}, nil
+}
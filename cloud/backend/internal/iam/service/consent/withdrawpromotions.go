@@ -0,0 +1,93 @@
+package consent
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/consentrecord"
+	uc_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/consentrecord"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// WithdrawPromotionsConsentService withdraws the consent of the
+// authenticated user to receive marketing promotions.
+type WithdrawPromotionsConsentService interface {
+	Execute(sessCtx context.Context) error
+}
+
+type withdrawPromotionsConsentServiceImpl struct {
+	config                     *config.Configuration
+	logger                     *zap.Logger
+	userGetByIDUseCase         uc_user.FederatedUserGetByIDUseCase
+	userUpdateUseCase          uc_user.FederatedUserUpdateUseCase
+	consentRecordCreateUseCase uc_consentrecord.ConsentRecordCreateUseCase
+}
+
+func NewWithdrawPromotionsConsentService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	uc1 uc_user.FederatedUserGetByIDUseCase,
+	uc2 uc_user.FederatedUserUpdateUseCase,
+	uc3 uc_consentrecord.ConsentRecordCreateUseCase,
+) WithdrawPromotionsConsentService {
+	return &withdrawPromotionsConsentServiceImpl{config, logger, uc1, uc2, uc3}
+}
+
+func (svc *withdrawPromotionsConsentServiceImpl) Execute(sessCtx context.Context) error {
+	sessCtx, span := tracing.Start(sessCtx, "WithdrawPromotionsConsentService.Execute")
+	defer span.End()
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return errors.New("federateduser id not found in context")
+	}
+	user, err := svc.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		svc.logger.Error("failed getting federateduser", zap.Any("error", err))
+		return err
+	}
+	if user == nil {
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("message", "User does not exist"), httperror.CodeUserNotFound)
+	}
+
+	// Withdrawing twice is harmless but we only record actual changes.
+	if !user.AgreePromotions {
+		return nil
+	}
+
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+	now := time.Now()
+	user.AgreePromotions = false
+	user.ModifiedAt = now
+	user.ModifiedByUserID = user.ID
+	user.ModifiedByName = user.Name
+	user.ModifiedFromIPAddress = ipAddress
+	if err := svc.userUpdateUseCase.Execute(sessCtx, user); err != nil {
+		svc.logger.Error("failed updating federateduser", zap.Any("error", err))
+		return err
+	}
+
+	record := &dom_consentrecord.ConsentRecord{
+		FederatedUserID: user.ID,
+		Type:            dom_consentrecord.ConsentTypePromotions,
+		DocumentVersion: user.AgreeTermsOfServiceVersion,
+		Granted:         false,
+		CreatedAt:       now,
+	}
+	if err := svc.consentRecordCreateUseCase.Execute(sessCtx, record); err != nil {
+		svc.logger.Error("failed recording consent", zap.Any("error", err))
+		return err
+	}
+
+	return nil
+}
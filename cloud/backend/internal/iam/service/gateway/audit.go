@@ -0,0 +1,38 @@
+package gateway
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodb"
+)
+
+// recordAuditEvent appends the event to the audit log. Failing to record an
+// event is logged but never blocks the authentication flow. The failures are
+// recorded outside of the transaction, which the error returned next aborts.
+func recordAuditEvent(ctx context.Context, logger *zap.Logger, uc uc_auditevent.AuditEventCreateUseCase, event *dom_auditevent.AuditEvent) {
+	if event.Outcome == dom_auditevent.AuditEventOutcomeFailure {
+		ctx = mongodb.WithoutSession(ctx)
+	}
+	if err := uc.Execute(ctx, event); err != nil {
+		logger.Error("Failed to record audit event",
+			zap.String("type", event.Type),
+			zap.Error(err))
+	}
+}
+
+// loginAuditEvent returns an audit event for an authentication step of
+// `userID`; during login there is no session so the user is also the actor.
+func loginAuditEvent(eventType, outcome string, userID primitive.ObjectID, details map[string]string) *dom_auditevent.AuditEvent {
+	return &dom_auditevent.AuditEvent{
+		Type:          eventType,
+		Outcome:       outcome,
+		ActorUserID:   userID,
+		SubjectUserID: userID,
+		Details:       details,
+	}
+}
@@ -0,0 +1,263 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_emailer "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/emailer"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/random"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+const (
+	emailChangeExpiry      = 30 * time.Minute
+	emailChangeMaxAttempts = 5
+)
+
+// EmailChangeData is the pending email change kept in the cache under
+// `email_change:<user id>` until it is confirmed, cancelled or expires. The
+// cancel token is indexed separately under `email_change_cancel:<token>` so
+// the link in the notice sent to the old address works without a session.
+type EmailChangeData struct {
+	FederatedUserID string    `json:"federated_user_id"`
+	OldEmail        string    `json:"old_email"`
+	NewEmail        string    `json:"new_email"`
+	Code            string    `json:"code"`
+	CancelToken     string    `json:"cancel_token"`
+	Attempts        int       `json:"attempts"`
+	CreatedAt       time.Time `json:"created_at"`
+	ExpiresAt       time.Time `json:"expires_at"`
+}
+
+func emailChangeCacheKey(userID string) string {
+	return fmt.Sprintf("email_change:%s", userID)
+}
+
+func emailChangeCancelCacheKey(cancelToken string) string {
+	return fmt.Sprintf("email_change_cancel:%s", cancelToken)
+}
+
+// getEmailChangeData returns the pending email change of the user or nil if
+// there is none.
+func getEmailChangeData(ctx context.Context, cache mongodbcache.Cacher, userID string) (*EmailChangeData, error) {
+	dataJSON, err := cache.Get(ctx, emailChangeCacheKey(userID))
+	if err != nil || dataJSON == nil {
+		return nil, err
+	}
+	var data EmailChangeData
+	if err := json.Unmarshal(dataJSON, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// deleteEmailChangeData removes the pending email change and its cancel
+// token index.
+func deleteEmailChangeData(ctx context.Context, cache mongodbcache.Cacher, logger *zap.Logger, data *EmailChangeData) {
+	if err := cache.Delete(ctx, emailChangeCacheKey(data.FederatedUserID)); err != nil {
+		logger.Warn("Failed to delete email change from cache", zap.Error(err))
+	}
+	if err := cache.Delete(ctx, emailChangeCancelCacheKey(data.CancelToken)); err != nil {
+		logger.Warn("Failed to delete email change cancel token from cache", zap.Error(err))
+	}
+}
+
+// invalidatePendingLogin deletes the login OTT issued for `email` along with
+// the challenge it was exchanged for, if any.
+func invalidatePendingLogin(ctx context.Context, cache mongodbcache.Cacher, logger *zap.Logger, email string) {
+	cacheKey := loginOTTCacheKey(email)
+	ottDataJSON, err := cache.Get(ctx, cacheKey)
+	if err != nil || ottDataJSON == nil {
+		return
+	}
+	var ottData LoginOTTData
+	if err := json.Unmarshal(ottDataJSON, &ottData); err == nil && ottData.ChallengeID != "" {
+		if err := cache.Delete(ctx, fmt.Sprintf("login_challenge:%s", ottData.ChallengeID)); err != nil {
+			logger.Warn("Failed to delete login challenge from cache", zap.Error(err))
+		}
+	}
+	if err := cache.Delete(ctx, cacheKey); err != nil {
+		logger.Warn("Failed to delete login OTT from cache", zap.Error(err))
+	}
+}
+
+// Data structures for requesting an email change
+type GatewayRequestEmailChangeRequestIDO struct {
+	NewEmail string `json:"newEmail"`
+}
+
+type GatewayRequestEmailChangeResponseIDO struct {
+	Message string `json:"message"`
+}
+
+// Service interface for requesting an email change
+type GatewayRequestEmailChangeService interface {
+	Execute(sessCtx context.Context, req *GatewayRequestEmailChangeRequestIDO) (*GatewayRequestEmailChangeResponseIDO, error)
+}
+
+// Implementation of request email change service
+type gatewayRequestEmailChangeServiceImpl struct {
+	config                       *config.Configuration
+	logger                       *zap.Logger
+	cache                        mongodbcache.Cacher
+	userGetByIDUseCase           uc_user.FederatedUserGetByIDUseCase
+	userGetByEmailUseCase        uc_user.FederatedUserGetByEmailUseCase
+	sendEmailChangeCodeUseCase   uc_emailer.SendEmailChangeCodeEmailUseCase
+	sendEmailChangeNoticeUseCase uc_emailer.SendEmailChangeNoticeEmailUseCase
+	auditEventCreateUseCase      uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewGatewayRequestEmailChangeService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase,
+	sendEmailChangeCodeUseCase uc_emailer.SendEmailChangeCodeEmailUseCase,
+	sendEmailChangeNoticeUseCase uc_emailer.SendEmailChangeNoticeEmailUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) GatewayRequestEmailChangeService {
+	return &gatewayRequestEmailChangeServiceImpl{
+		config:                       config,
+		logger:                       logger,
+		cache:                        cache,
+		userGetByIDUseCase:           userGetByIDUseCase,
+		userGetByEmailUseCase:        userGetByEmailUseCase,
+		sendEmailChangeCodeUseCase:   sendEmailChangeCodeUseCase,
+		sendEmailChangeNoticeUseCase: sendEmailChangeNoticeUseCase,
+		auditEventCreateUseCase:      auditEventCreateUseCase,
+	}
+}
+
+func (s *gatewayRequestEmailChangeServiceImpl) Execute(sessCtx context.Context, req *GatewayRequestEmailChangeRequestIDO) (*GatewayRequestEmailChangeResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayRequestEmailChangeService.Execute")
+	defer span.End()
+
+	// Get the authenticated user from the session
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting federated user id from context")
+		return nil, errors.New("federated user id not found in context")
+	}
+
+	// Sanitize input
+	req.NewEmail = strings.ToLower(req.NewEmail)
+	req.NewEmail = strings.ReplaceAll(req.NewEmail, " ", "")
+	req.NewEmail = strings.ReplaceAll(req.NewEmail, "\t", "")
+	req.NewEmail = strings.TrimSpace(req.NewEmail)
+
+	// Validate input
+	e := make(map[string]string)
+	if req.NewEmail == "" {
+		e["newEmail"] = "New email is required"
+	} else if len(req.NewEmail) > 255 {
+		e["newEmail"] = "New email is too long"
+	} else if _, err := mail.ParseAddress(req.NewEmail); err != nil {
+		e["newEmail"] = "New email is invalid"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	user, err := s.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "User does not exist"), httperror.CodeUserNotFound)
+	}
+	if user.Email == req.NewEmail {
+		return nil, httperror.NewForBadRequestWithSingleField("newEmail", "New email must be different from the current email")
+	}
+
+	// Reject addresses already in use up front; the final update is still
+	// guarded by the unique email index.
+	existing, err := s.userGetByEmailUseCase.Execute(sessCtx, req.NewEmail)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("newEmail", "Email address already exists"), httperror.CodeEmailAlreadyExists)
+	}
+
+	// Only one change may be pending at a time so replace any previous one.
+	previous, err := getEmailChangeData(sessCtx, s.cache, userID.Hex())
+	if err != nil {
+		s.logger.Warn("Failed to retrieve pending email change", zap.Error(err))
+	}
+	if previous != nil {
+		deleteEmailChangeData(sessCtx, s.cache, s.logger, previous)
+	}
+
+	code, err := random.GenerateSixDigitCode()
+	if err != nil {
+		return nil, err
+	}
+	cancelToken := make([]byte, 32)
+	if _, err := rand.Read(cancelToken); err != nil {
+		s.logger.Error("Failed to generate cancel token", zap.Error(err))
+		return nil, fmt.Errorf("failed to process email change: %w", err)
+	}
+
+	data := EmailChangeData{
+		FederatedUserID: userID.Hex(),
+		OldEmail:        user.Email,
+		NewEmail:        req.NewEmail,
+		Code:            code,
+		CancelToken:     base64.RawURLEncoding.EncodeToString(cancelToken),
+		CreatedAt:       time.Now(),
+		ExpiresAt:       time.Now().Add(emailChangeExpiry),
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		s.logger.Error("Failed to marshal email change data", zap.Error(err))
+		return nil, fmt.Errorf("failed to process email change: %w", err)
+	}
+	if err := s.cache.SetWithExpiry(sessCtx, emailChangeCacheKey(data.FederatedUserID), dataJSON, emailChangeExpiry); err != nil {
+		s.logger.Error("Failed to store email change in cache", zap.Error(err))
+		return nil, fmt.Errorf("failed to process email change: %w", err)
+	}
+	if err := s.cache.SetWithExpiry(sessCtx, emailChangeCancelCacheKey(data.CancelToken), []byte(data.FederatedUserID), emailChangeExpiry); err != nil {
+		s.logger.Error("Failed to store email change cancel token in cache", zap.Error(err))
+		return nil, fmt.Errorf("failed to process email change: %w", err)
+	}
+
+	// 1=PAPERCLOUD
+	if err := s.sendEmailChangeCodeUseCase.Execute(sessCtx, 1, data.NewEmail, data.Code, user.FirstName); err != nil {
+		s.logger.Error("Failed to send email change code", zap.Error(err))
+		deleteEmailChangeData(sessCtx, s.cache, s.logger, &data)
+		return nil, fmt.Errorf("failed to send email change code: %w", err)
+	}
+	if err := s.sendEmailChangeNoticeUseCase.Execute(sessCtx, 1, data.OldEmail, data.NewEmail, data.CancelToken, user.FirstName); err != nil {
+		s.logger.Error("Failed to send email change notice", zap.Error(err))
+		deleteEmailChangeData(sessCtx, s.cache, s.logger, &data)
+		return nil, fmt.Errorf("failed to send email change notice: %w", err)
+	}
+
+	recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, &dom_auditevent.AuditEvent{
+		Type:    dom_auditevent.AuditEventTypeEmailChangeRequested,
+		Outcome: dom_auditevent.AuditEventOutcomeSuccess,
+		Details: map[string]string{"old_email": data.OldEmail, "new_email": data.NewEmail},
+	})
+
+	return &GatewayRequestEmailChangeResponseIDO{
+		Message: "A verification code has been sent to your new email address",
+	}, nil
+}
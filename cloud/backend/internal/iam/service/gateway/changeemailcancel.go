@@ -0,0 +1,91 @@
+package gateway
+
+import (
+	"context"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// Data structures for cancelling an email change from the link sent to the
+// old address.
+type GatewayCancelEmailChangeRequestIDO struct {
+	Token string `json:"token"`
+}
+
+type GatewayCancelEmailChangeResponseIDO struct {
+	Message string `json:"message"`
+}
+
+// Service interface for cancelling an email change
+type GatewayCancelEmailChangeService interface {
+	Execute(sessCtx context.Context, req *GatewayCancelEmailChangeRequestIDO) (*GatewayCancelEmailChangeResponseIDO, error)
+}
+
+// Implementation of cancel email change service
+type gatewayCancelEmailChangeServiceImpl struct {
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	cache                   mongodbcache.Cacher
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewGatewayCancelEmailChangeService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) GatewayCancelEmailChangeService {
+	return &gatewayCancelEmailChangeServiceImpl{
+		config:                  config,
+		logger:                  logger,
+		cache:                   cache,
+		auditEventCreateUseCase: auditEventCreateUseCase,
+	}
+}
+
+func (s *gatewayCancelEmailChangeServiceImpl) Execute(sessCtx context.Context, req *GatewayCancelEmailChangeRequestIDO) (*GatewayCancelEmailChangeResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayCancelEmailChangeService.Execute")
+	defer span.End()
+
+	req.Token = strings.TrimSpace(req.Token)
+	if req.Token == "" {
+		return nil, httperror.NewForBadRequestWithSingleField("token", "Token is required")
+	}
+
+	userIDBytes, err := s.cache.Get(sessCtx, emailChangeCancelCacheKey(req.Token))
+	if err != nil || userIDBytes == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired token"), httperror.CodeInvalidToken)
+	}
+
+	data, err := getEmailChangeData(sessCtx, s.cache, string(userIDBytes))
+	if err != nil {
+		s.logger.Error("Failed to retrieve pending email change", zap.Error(err))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired token"), httperror.CodeInvalidToken)
+	}
+	if data == nil || data.CancelToken != req.Token {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired token"), httperror.CodeInvalidToken)
+	}
+
+	deleteEmailChangeData(sessCtx, s.cache, s.logger, data)
+
+	userID, _ := primitive.ObjectIDFromHex(data.FederatedUserID)
+	recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, &dom_auditevent.AuditEvent{
+		Type:          dom_auditevent.AuditEventTypeEmailChangeCancelled,
+		Outcome:       dom_auditevent.AuditEventOutcomeSuccess,
+		SubjectUserID: userID,
+		Details:       map[string]string{"old_email": data.OldEmail, "new_email": data.NewEmail},
+	})
+
+	return &GatewayCancelEmailChangeResponseIDO{
+		Message: "The email change has been cancelled",
+	}, nil
+}
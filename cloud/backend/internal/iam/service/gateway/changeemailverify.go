@@ -0,0 +1,132 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// Data structures for confirming an email change
+type GatewayVerifyEmailChangeRequestIDO struct {
+	Code string `json:"code"`
+}
+
+type GatewayVerifyEmailChangeResponseIDO struct {
+	Message string `json:"message"`
+	Email   string `json:"email"`
+}
+
+// Service interface for confirming an email change
+type GatewayVerifyEmailChangeService interface {
+	Execute(sessCtx context.Context, req *GatewayVerifyEmailChangeRequestIDO) (*GatewayVerifyEmailChangeResponseIDO, error)
+}
+
+// Implementation of verify email change service
+type gatewayVerifyEmailChangeServiceImpl struct {
+	config                    *config.Configuration
+	logger                    *zap.Logger
+	cache                     mongodbcache.Cacher
+	userUpdateEmailUseCase    uc_user.FederatedUserUpdateEmailUseCase
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase
+	auditEventCreateUseCase   uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewGatewayVerifyEmailChangeService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	userUpdateEmailUseCase uc_user.FederatedUserUpdateEmailUseCase,
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) GatewayVerifyEmailChangeService {
+	return &gatewayVerifyEmailChangeServiceImpl{
+		config:                    config,
+		logger:                    logger,
+		cache:                     cache,
+		userUpdateEmailUseCase:    userUpdateEmailUseCase,
+		userRevokeSessionsUseCase: userRevokeSessionsUseCase,
+		auditEventCreateUseCase:   auditEventCreateUseCase,
+	}
+}
+
+func (s *gatewayVerifyEmailChangeServiceImpl) Execute(sessCtx context.Context, req *GatewayVerifyEmailChangeRequestIDO) (*GatewayVerifyEmailChangeResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayVerifyEmailChangeService.Execute")
+	defer span.End()
+
+	// Get the authenticated user from the session
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting federated user id from context")
+		return nil, errors.New("federated user id not found in context")
+	}
+
+	req.Code = strings.TrimSpace(req.Code)
+	if req.Code == "" {
+		return nil, httperror.NewForBadRequestWithSingleField("code", "Verification code is required")
+	}
+
+	data, err := getEmailChangeData(sessCtx, s.cache, userID.Hex())
+	if err != nil {
+		s.logger.Error("Failed to retrieve pending email change", zap.Error(err))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("code", "Invalid or expired verification code"), httperror.CodeInvalidVerificationCode)
+	}
+	if data == nil || time.Now().After(data.ExpiresAt) {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("code", "Invalid or expired verification code"), httperror.CodeInvalidVerificationCode)
+	}
+
+	if !isOTTMatch(data.Code, req.Code) {
+		// Count the failure and drop the pending change once too many
+		// guesses were made.
+		data.Attempts++
+		if data.Attempts >= emailChangeMaxAttempts {
+			deleteEmailChangeData(sessCtx, s.cache, s.logger, data)
+		} else if dataJSON, err := json.Marshal(data); err == nil {
+			if err := s.cache.SetWithExpiry(sessCtx, emailChangeCacheKey(data.FederatedUserID), dataJSON, time.Until(data.ExpiresAt)); err != nil {
+				s.logger.Warn("Failed to update email change in cache", zap.Error(err))
+			}
+		}
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("code", "Invalid verification code"), httperror.CodeInvalidVerificationCode)
+	}
+
+	// The pending change is single use
+	deleteEmailChangeData(sessCtx, s.cache, s.logger, data)
+
+	if err := s.userUpdateEmailUseCase.Execute(sessCtx, userID, data.OldEmail, data.NewEmail); err != nil {
+		s.logger.Warn("Failed to update email", zap.Error(err))
+		return nil, err
+	}
+
+	// Email is the login identifier so anything issued against the old
+	// address, including every session, is no longer valid.
+	invalidatePendingLogin(sessCtx, s.cache, s.logger, data.OldEmail)
+	invalidatePendingLogin(sessCtx, s.cache, s.logger, data.NewEmail)
+	if err := s.userRevokeSessionsUseCase.Execute(sessCtx, userID, ""); err != nil {
+		s.logger.Error("Failed to revoke sessions after email change", zap.Error(err))
+		return nil, err
+	}
+
+	recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, &dom_auditevent.AuditEvent{
+		Type:    dom_auditevent.AuditEventTypeEmailChanged,
+		Outcome: dom_auditevent.AuditEventOutcomeSuccess,
+		Details: map[string]string{"old_email": data.OldEmail, "new_email": data.NewEmail},
+	})
+
+	return &GatewayVerifyEmailChangeResponseIDO{
+		Message: "Email changed successfully, please log in with your new email address",
+		Email:   data.NewEmail,
+	}, nil
+}
@@ -0,0 +1,200 @@
+// cloud/backend/internal/iam/service/gateway/changepass.go
+package gateway
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// Data structures for changing the password. The client derives a new key
+// encryption key from the new password and salt, then re-wraps the master
+// key with it; the private key is re-wrapped with the (unchanged) master key.
+type GatewayChangePasswordRequestIDO struct {
+	ChallengeID         string `json:"challengeId"`
+	DecryptedData       string `json:"decryptedData"`
+	Salt                string `json:"salt"`
+	EncryptedMasterKey  string `json:"encryptedMasterKey"`
+	EncryptedPrivateKey string `json:"encryptedPrivateKey"`
+}
+
+type GatewayChangePasswordResponseIDO struct {
+	Message string `json:"message"`
+}
+
+// Service interface for changing the password
+type GatewayChangePasswordService interface {
+	Execute(sessCtx context.Context, req *GatewayChangePasswordRequestIDO) (*GatewayChangePasswordResponseIDO, error)
+}
+
+// Implementation of change password service
+type gatewayChangePasswordServiceImpl struct {
+	config                    *config.Configuration
+	logger                    *zap.Logger
+	cache                     mongodbcache.Cacher
+	userGetByIDUseCase        uc_user.FederatedUserGetByIDUseCase
+	userUpdateUseCase         uc_user.FederatedUserUpdateUseCase
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase
+	auditEventCreateUseCase   uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewGatewayChangePasswordService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+	userUpdateUseCase uc_user.FederatedUserUpdateUseCase,
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) GatewayChangePasswordService {
+	return &gatewayChangePasswordServiceImpl{
+		config:                    config,
+		logger:                    logger,
+		cache:                     cache,
+		userGetByIDUseCase:        userGetByIDUseCase,
+		userUpdateUseCase:         userUpdateUseCase,
+		userRevokeSessionsUseCase: userRevokeSessionsUseCase,
+		auditEventCreateUseCase:   auditEventCreateUseCase,
+	}
+}
+
+func (s *gatewayChangePasswordServiceImpl) Execute(sessCtx context.Context, req *GatewayChangePasswordRequestIDO) (*GatewayChangePasswordResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayChangePasswordService.Execute")
+	defer span.End()
+
+	// Get the authenticated user from the session
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting federated user id from context")
+		return nil, errors.New("federated user id not found in context")
+	}
+	sessionID, _ := sessCtx.Value(constants.SessionID).(string)
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+
+	// Validate input
+	e := make(map[string]string)
+	if req.ChallengeID == "" {
+		e["challengeId"] = "Challenge ID is required"
+	}
+	if req.DecryptedData == "" {
+		e["decryptedData"] = "Decrypted data is required"
+	}
+	validateWrappedKeys(e, req.Salt, req.EncryptedMasterKey, req.EncryptedPrivateKey)
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	// Retrieve challenge data from cache
+	challengeCacheKey := fmt.Sprintf("change_password_challenge:%s", req.ChallengeID)
+	challengeDataJSON, err := s.cache.Get(sessCtx, challengeCacheKey)
+	if err != nil || challengeDataJSON == nil {
+		s.logger.Warn("Password change challenge not found", zap.Any("error", err))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Invalid or expired challenge"), httperror.CodeInvalidChallenge)
+	}
+
+	var challengeData ChallengeData
+	if err := json.Unmarshal(challengeDataJSON, &challengeData); err != nil {
+		s.logger.Error("Failed to unmarshal challenge data", zap.Error(err))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Invalid challenge"), httperror.CodeInvalidChallenge)
+	}
+
+	// Verify the challenge was issued to this user and is still fresh
+	if challengeData.FederatedUserID != userID.Hex() {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Invalid challenge"), httperror.CodeInvalidChallenge)
+	}
+	if time.Now().After(challengeData.ExpiresAt) {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Challenge has expired"), httperror.CodeChallengeExpired)
+	}
+	if challengeData.IsVerified {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Challenge has already been used"), httperror.CodeChallengeUsed)
+	}
+	if challengeData.Challenge != req.DecryptedData {
+		s.logger.Warn("Password change challenge verification failed",
+			zap.String("user_id", userID.Hex()))
+		recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, &dom_auditevent.AuditEvent{
+			Type:    dom_auditevent.AuditEventTypeLoginChallengeFailed,
+			Outcome: dom_auditevent.AuditEventOutcomeFailure,
+			Details: map[string]string{"flow": "change_password"},
+		})
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("decryptedData", "Invalid challenge response"), httperror.CodeInvalidChallengeResponse)
+	}
+
+	// The challenge is single use
+	if err := s.cache.Delete(sessCtx, challengeCacheKey); err != nil {
+		s.logger.Warn("Failed to delete challenge from cache", zap.Error(err))
+	}
+
+	// Get user from database
+	user, err := s.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "User does not exist"), httperror.CodeUserNotFound)
+	}
+
+	// Swap in the re-wrapped keys
+	user.Salt = req.Salt
+	user.EncryptedMasterKey = req.EncryptedMasterKey
+	user.EncryptedPrivateKey = req.EncryptedPrivateKey
+	user.ModifiedAt = time.Now()
+	user.ModifiedByUserID = user.ID
+	user.ModifiedByName = user.Name
+	user.ModifiedFromIPAddress = ipAddress
+	if err := s.userUpdateUseCase.Execute(sessCtx, user); err != nil {
+		s.logger.Error("Failed to update user keys", zap.Error(err))
+		return nil, err
+	}
+
+	// Every other session was unlocked with the old password, revoke them
+	if err := s.userRevokeSessionsUseCase.Execute(sessCtx, user.ID, sessionID); err != nil {
+		s.logger.Error("Failed to revoke sessions", zap.Error(err))
+		return nil, err
+	}
+
+	recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, &dom_auditevent.AuditEvent{
+		Type:    dom_auditevent.AuditEventTypePasswordChanged,
+		Details: map[string]string{"other_sessions_revoked": "true"},
+	})
+
+	s.logger.Info("Password changed", zap.String("user_id", user.ID.Hex()))
+
+	return &GatewayChangePasswordResponseIDO{
+		Message: "Password changed successfully",
+	}, nil
+}
+
+// validateWrappedKeys checks that the salt and re-wrapped keys submitted by the
+// client are present and base64 encoded, recording problems in `e`.
+func validateWrappedKeys(e map[string]string, salt, encryptedMasterKey, encryptedPrivateKey string) {
+	if salt == "" {
+		e["salt"] = "Salt is required"
+	} else if b, err := base64.StdEncoding.DecodeString(salt); err != nil || len(b) < 16 {
+		e["salt"] = "Salt must be at least 16 bytes and base64 encoded"
+	}
+	if encryptedMasterKey == "" {
+		e["encryptedMasterKey"] = "Encrypted master key is required"
+	} else if _, err := base64.StdEncoding.DecodeString(encryptedMasterKey); err != nil {
+		e["encryptedMasterKey"] = "Encrypted master key must be base64 encoded"
+	}
+	if encryptedPrivateKey == "" {
+		e["encryptedPrivateKey"] = "Encrypted private key is required"
+	} else if _, err := base64.StdEncoding.DecodeString(encryptedPrivateKey); err != nil {
+		e["encryptedPrivateKey"] = "Encrypted private key must be base64 encoded"
+	}
+}
@@ -0,0 +1,125 @@
+// cloud/backend/internal/iam/service/gateway/changepasschallenge.go
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// GatewayChangePasswordChallengeResponseIDO returns the currently wrapped keys
+// so the client can unwrap the master key with the old password, along with a
+// challenge proving the client still holds the private key.
+type GatewayChangePasswordChallengeResponseIDO struct {
+	Salt                string `json:"salt"`
+	EncryptedMasterKey  string `json:"encryptedMasterKey"`
+	EncryptedPrivateKey string `json:"encryptedPrivateKey"`
+	EncryptedChallenge  string `json:"encryptedChallenge"`
+	ChallengeID         string `json:"challengeId"`
+}
+
+// Service interface for issuing a password change challenge
+type GatewayChangePasswordChallengeService interface {
+	Execute(sessCtx context.Context) (*GatewayChangePasswordChallengeResponseIDO, error)
+}
+
+// Implementation of password change challenge service
+type gatewayChangePasswordChallengeServiceImpl struct {
+	config             *config.Configuration
+	logger             *zap.Logger
+	cache              mongodbcache.Cacher
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase
+}
+
+func NewGatewayChangePasswordChallengeService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+) GatewayChangePasswordChallengeService {
+	return &gatewayChangePasswordChallengeServiceImpl{
+		config:             config,
+		logger:             logger,
+		cache:              cache,
+		userGetByIDUseCase: userGetByIDUseCase,
+	}
+}
+
+func (s *gatewayChangePasswordChallengeServiceImpl) Execute(sessCtx context.Context) (*GatewayChangePasswordChallengeResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayChangePasswordChallengeService.Execute")
+	defer span.End()
+
+	// Get the authenticated user from the session
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		s.logger.Error("Failed getting federated user id from context")
+		return nil, errors.New("federated user id not found in context")
+	}
+
+	user, err := s.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "User does not exist"), httperror.CodeUserNotFound)
+	}
+
+	// Generate a challenge for the password change
+	challenge := make([]byte, 32)
+	if _, err := rand.Read(challenge); err != nil {
+		s.logger.Error("Failed to generate challenge", zap.Error(err))
+		return nil, fmt.Errorf("failed to process password change: %w", err)
+	}
+
+	challengeID := uuid.New().String()
+	challengeData := ChallengeData{
+		Email:           user.Email,
+		ChallengeID:     challengeID,
+		Challenge:       base64.StdEncoding.EncodeToString(challenge),
+		CreatedAt:       time.Now(),
+		ExpiresAt:       time.Now().Add(5 * time.Minute), // Challenge valid for 5 minutes
+		IsVerified:      false,
+		FederatedUserID: user.ID.Hex(),
+	}
+
+	challengeDataJSON, err := json.Marshal(challengeData)
+	if err != nil {
+		s.logger.Error("Failed to marshal challenge data", zap.Error(err))
+		return nil, fmt.Errorf("failed to process password change: %w", err)
+	}
+
+	challengeCacheKey := fmt.Sprintf("change_password_challenge:%s", challengeID)
+	if err := s.cache.SetWithExpiry(sessCtx, challengeCacheKey, challengeDataJSON, 5*time.Minute); err != nil {
+		s.logger.Error("Failed to store challenge in cache", zap.Error(err))
+		return nil, fmt.Errorf("failed to process password change: %w", err)
+	}
+
+	encryptedChallenge, err := getEncryptedChallenge(challenge, user)
+	if err != nil {
+		s.logger.Error("Failed to encrypt challenge", zap.Error(err))
+		return nil, fmt.Errorf("failed to process password change: %w", err)
+	}
+
+	return &GatewayChangePasswordChallengeResponseIDO{
+		Salt:                user.Salt,
+		EncryptedMasterKey:  user.EncryptedMasterKey,
+		EncryptedPrivateKey: user.EncryptedPrivateKey,
+		EncryptedChallenge:  encryptedChallenge,
+		ChallengeID:         challengeID,
+	}, nil
+}
@@ -12,9 +12,15 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_emailer "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/emailer"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/ipcountryblocker"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
 )
@@ -24,6 +30,10 @@ type GatewayCompleteLoginRequestIDO struct {
 	Email         string `json:"email"`
 	ChallengeID   string `json:"challengeId"`
 	DecryptedData string `json:"decryptedData"`
+
+	// DeviceID is generated and persisted by the client so we can tell
+	// devices with the same user agent apart; it is optional.
+	DeviceID string `json:"deviceId"`
 }
 
 type GatewayCompleteLoginResponseIDO struct {
@@ -40,12 +50,16 @@ type GatewayCompleteLoginService interface {
 
 // Implementation of complete login service
 type gatewayCompleteLoginServiceImpl struct {
-	config                *config.Configuration
-	logger                *zap.Logger
-	cache                 mongodbcache.Cacher
-	jwtProvider           jwt.Provider
-	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase
-	userUpdateUseCase     uc_user.FederatedUserUpdateUseCase
+	config                   *config.Configuration
+	logger                   *zap.Logger
+	cache                    mongodbcache.Cacher
+	jwtProvider              jwt.Provider
+	userGetByEmailUseCase    uc_user.FederatedUserGetByEmailUseCase
+	userUpdateUseCase        uc_user.FederatedUserUpdateUseCase
+	userAddSessionUseCase    uc_user.FederatedUserAddSessionUseCase
+	auditEventCreateUseCase  uc_auditevent.AuditEventCreateUseCase
+	sendNewLoginAlertUseCase uc_emailer.SendNewLoginAlertEmailUseCase
+	ipCountryBlocker         ipcountryblocker.Provider
 }
 
 func NewGatewayCompleteLoginService(
@@ -55,18 +69,29 @@ func NewGatewayCompleteLoginService(
 	jwtProvider jwt.Provider,
 	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase,
 	userUpdateUseCase uc_user.FederatedUserUpdateUseCase,
+	userAddSessionUseCase uc_user.FederatedUserAddSessionUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+	sendNewLoginAlertUseCase uc_emailer.SendNewLoginAlertEmailUseCase,
+	ipCountryBlocker ipcountryblocker.Provider,
 ) GatewayCompleteLoginService {
 	return &gatewayCompleteLoginServiceImpl{
-		config:                config,
-		logger:                logger,
-		cache:                 cache,
-		jwtProvider:           jwtProvider,
-		userGetByEmailUseCase: userGetByEmailUseCase,
-		userUpdateUseCase:     userUpdateUseCase,
+		config:                   config,
+		logger:                   logger,
+		cache:                    cache,
+		jwtProvider:              jwtProvider,
+		userGetByEmailUseCase:    userGetByEmailUseCase,
+		userUpdateUseCase:        userUpdateUseCase,
+		userAddSessionUseCase:    userAddSessionUseCase,
+		auditEventCreateUseCase:  auditEventCreateUseCase,
+		sendNewLoginAlertUseCase: sendNewLoginAlertUseCase,
+		ipCountryBlocker:         ipCountryBlocker,
 	}
 }
 
 func (s *gatewayCompleteLoginServiceImpl) Execute(sessCtx context.Context, req *GatewayCompleteLoginRequestIDO) (*GatewayCompleteLoginResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayCompleteLoginService.Execute")
+	defer span.End()
+
 	// Validate input
 	e := make(map[string]string)
 	if req.Email == "" {
@@ -91,34 +116,34 @@ func (s *gatewayCompleteLoginServiceImpl) Execute(sessCtx context.Context, req *
 	challengeDataJSON, err := s.cache.Get(sessCtx, challengeCacheKey)
 	if err != nil {
 		s.logger.Error("Failed to retrieve challenge data", zap.Error(err))
-		return nil, httperror.NewForBadRequestWithSingleField("challengeId", "Invalid or expired challenge")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Invalid or expired challenge"), httperror.CodeInvalidChallenge)
 	}
 
 	if challengeDataJSON == nil {
 		s.logger.Error("Challenge data not found in cache")
-		return nil, httperror.NewForBadRequestWithSingleField("challengeId", "Invalid or expired challenge")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Invalid or expired challenge"), httperror.CodeInvalidChallenge)
 	}
 
 	// Unmarshal the data from JSON
 	var challengeData ChallengeData
 	if err := json.Unmarshal(challengeDataJSON, &challengeData); err != nil {
 		s.logger.Error("Failed to unmarshal challenge data", zap.Error(err))
-		return nil, httperror.NewForBadRequestWithSingleField("challengeId", "Invalid challenge")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Invalid challenge"), httperror.CodeInvalidChallenge)
 	}
 
 	// Verify the challenge
 	if challengeData.Email != req.Email {
-		return nil, httperror.NewForBadRequestWithSingleField("email", "Email address does not match challenge")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "Email address does not match challenge"), httperror.CodeInvalidChallenge)
 	}
 
 	// Check expiry
 	if time.Now().After(challengeData.ExpiresAt) {
-		return nil, httperror.NewForBadRequestWithSingleField("challengeId", "Challenge has expired")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Challenge has expired"), httperror.CodeChallengeExpired)
 	}
 
 	// Check if already verified
 	if challengeData.IsVerified {
-		return nil, httperror.NewForBadRequestWithSingleField("challengeId", "Challenge has already been used")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Challenge has already been used"), httperror.CodeChallengeUsed)
 	}
 
 	// Verify the decrypted data
@@ -130,7 +155,14 @@ func (s *gatewayCompleteLoginServiceImpl) Execute(sessCtx context.Context, req *
 		s.logger.Error("Challenge verification failed",
 			zap.String("stored", storedChallenge),
 			zap.String("provided", req.DecryptedData))
-		return nil, httperror.NewForBadRequestWithSingleField("decryptedData", "Invalid challenge response")
+		failedUserID, _ := primitive.ObjectIDFromHex(challengeData.FederatedUserID)
+		recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+			dom_auditevent.AuditEventTypeLoginChallengeFailed,
+			dom_auditevent.AuditEventOutcomeFailure,
+			failedUserID,
+			map[string]string{"flow": "login"},
+		))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("decryptedData", "Invalid challenge response"), httperror.CodeInvalidChallengeResponse)
 	}
 
 	// Get user from database
@@ -139,11 +171,24 @@ func (s *gatewayCompleteLoginServiceImpl) Execute(sessCtx context.Context, req *
 		return nil, err
 	}
 	if user == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("email", "Email address does not exist")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "Email address does not exist"), httperror.CodeEmailNotFound)
 	}
 
+	// Remember the device and country of this login so the user can be
+	// alerted when they log in from somewhere new. Users without any known
+	// device have never been tracked before so their first login is not new.
+	loggedInAt := time.Now()
+	userAgent, _ := sessCtx.Value(constants.SessionUserAgent).(string)
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+	fingerprint := loginDeviceFingerprint(userAgent, req.DeviceID)
+	country := s.lookupLoginCountry(sessCtx, ipAddress)
+	isTracked := len(user.KnownLoginDevices) > 0
+	isNewDevice := isTracked && !user.HasKnownLoginDevice(fingerprint)
+	isNewCountry := isTracked && country != "" && !user.HasKnownLoginCountry(country)
+	user.RememberLogin(fingerprint, userAgent, country, loggedInAt)
+
 	// Update last login timestamp if needed
-	user.ModifiedAt = time.Now()
+	user.ModifiedAt = loggedInAt
 	if err := s.userUpdateUseCase.Execute(sessCtx, user); err != nil {
 		s.logger.Warn("Failed to update user last login time", zap.Error(err))
 		// Continue anyway, as this is not critical
@@ -165,7 +210,23 @@ func (s *gatewayCompleteLoginServiceImpl) Execute(sessCtx context.Context, req *
 	}
 
 	// Generate JWT tokens
-	return s.generateTokens(sessCtx, user)
+	resp, err := s.generateTokens(sessCtx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+		dom_auditevent.AuditEventTypeLoginSucceeded,
+		dom_auditevent.AuditEventOutcomeSuccess,
+		user.ID,
+		nil,
+	))
+
+	if isNewDevice || isNewCountry {
+		s.sendNewLoginAlert(sessCtx, user, userAgent, country, loggedInAt, isNewDevice, isNewCountry)
+	}
+
+	return resp, nil
 }
 
 // generateTokens creates access and refresh tokens for the user
@@ -189,6 +250,11 @@ func (s *gatewayCompleteLoginServiceImpl) generateTokens(ctx context.Context, us
 		return nil, fmt.Errorf("failed to store session: %w", err)
 	}
 
+	// Track the session against the user so it can be revoked later
+	if err := s.userAddSessionUseCase.Execute(ctx, user.ID, sessionUUID, rtExpiry); err != nil {
+		return nil, fmt.Errorf("failed to index session: %w", err)
+	}
+
 	// Generate JWT tokens
 	accessToken, accessTokenExpiry, refreshToken, refreshTokenExpiry, err := s.jwtProvider.GenerateJWTTokenPair(sessionUUID, atExpiry, rtExpiry)
 	if err != nil {
@@ -0,0 +1,138 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// Data structures for disowning a login from the "this wasn't me" link sent
+// with a new login alert.
+type GatewayDisownLoginRequestIDO struct {
+	Token string `json:"token"`
+}
+
+type GatewayDisownLoginResponseIDO struct {
+	Message string `json:"message"`
+}
+
+// Service interface for disowning a login
+type GatewayDisownLoginService interface {
+	Execute(sessCtx context.Context, req *GatewayDisownLoginRequestIDO) (*GatewayDisownLoginResponseIDO, error)
+}
+
+// Implementation of disown login service
+type gatewayDisownLoginServiceImpl struct {
+	config                    *config.Configuration
+	logger                    *zap.Logger
+	cache                     mongodbcache.Cacher
+	userGetByIDUseCase        uc_user.FederatedUserGetByIDUseCase
+	userUpdateUseCase         uc_user.FederatedUserUpdateUseCase
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase
+	auditEventCreateUseCase   uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewGatewayDisownLoginService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+	userUpdateUseCase uc_user.FederatedUserUpdateUseCase,
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) GatewayDisownLoginService {
+	return &gatewayDisownLoginServiceImpl{
+		config:                    config,
+		logger:                    logger,
+		cache:                     cache,
+		userGetByIDUseCase:        userGetByIDUseCase,
+		userUpdateUseCase:         userUpdateUseCase,
+		userRevokeSessionsUseCase: userRevokeSessionsUseCase,
+		auditEventCreateUseCase:   auditEventCreateUseCase,
+	}
+}
+
+func (s *gatewayDisownLoginServiceImpl) Execute(sessCtx context.Context, req *GatewayDisownLoginRequestIDO) (*GatewayDisownLoginResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayDisownLoginService.Execute")
+	defer span.End()
+
+	req.Token = strings.TrimSpace(req.Token)
+	if req.Token == "" {
+		return nil, httperror.NewForBadRequestWithSingleField("token", "Token is required")
+	}
+
+	cacheKey := loginAlertCacheKey(req.Token)
+	dataJSON, err := s.cache.Get(sessCtx, cacheKey)
+	if err != nil || dataJSON == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired token"), httperror.CodeInvalidToken)
+	}
+	var data LoginAlertData
+	if err := json.Unmarshal(dataJSON, &data); err != nil {
+		s.logger.Error("Failed to unmarshal login alert data", zap.Error(err))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired token"), httperror.CodeInvalidToken)
+	}
+	userID, err := primitive.ObjectIDFromHex(data.FederatedUserID)
+	if err != nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired token"), httperror.CodeInvalidToken)
+	}
+
+	user, err := s.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired token"), httperror.CodeInvalidToken)
+	}
+
+	// Lock the account so whoever logged in cannot simply log in again; an
+	// archived account stays archived.
+	if user.Status == dom_user.FederatedUserStatusActive {
+		ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+		user.Status = dom_user.FederatedUserStatusLocked
+		user.ModifiedAt = time.Now()
+		user.ModifiedFromIPAddress = ipAddress
+		if err := s.userUpdateUseCase.Execute(sessCtx, user); err != nil {
+			s.logger.Error("Failed to lock federated user", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	// Refreshing the tokens issues a new session so the disowned login may no
+	// longer be on its original session; revoke them all.
+	if err := s.userRevokeSessionsUseCase.Execute(sessCtx, user.ID, ""); err != nil {
+		s.logger.Error("Failed to revoke federated user sessions", zap.Error(err))
+		return nil, err
+	}
+
+	if err := s.cache.Delete(sessCtx, cacheKey); err != nil {
+		s.logger.Warn("Failed to delete login alert from cache", zap.Error(err))
+	}
+
+	recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+		dom_auditevent.AuditEventTypeLoginDisowned,
+		dom_auditevent.AuditEventOutcomeSuccess,
+		user.ID,
+		map[string]string{"device": data.Device, "country": data.Country},
+	))
+
+	s.logger.Warn("federated user disowned a login and was locked",
+		zap.String("user_id", user.ID.Hex()))
+
+	return &GatewayDisownLoginResponseIDO{
+		Message: "All sessions have been signed out and your account has been locked, please contact support to unlock it",
+	}, nil
+}
@@ -9,6 +9,7 @@ import (
 	uc_emailer "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/emailer"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/random"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/password"
@@ -51,6 +52,9 @@ type GatewayForgotPasswordResponseIDO struct {
 }
 
 func (s *gatewayForgotPasswordServiceImpl) Execute(sessCtx context.Context, req *GatewayForgotPasswordRequestIDO) (*GatewayForgotPasswordResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayForgotPasswordService.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Sanization of input.
 	//
@@ -87,7 +91,7 @@ func (s *gatewayForgotPasswordServiceImpl) Execute(sessCtx context.Context, req
 		return nil, err
 	}
 	if u == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("email", "Email address does not exist")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "Email address does not exist"), httperror.CodeEmailNotFound)
 	}
 
 	//
@@ -108,6 +112,11 @@ func (s *gatewayForgotPasswordServiceImpl) Execute(sessCtx context.Context, req
 		return nil, err
 	}
 
+	// The new code gets its own attempts.
+	if err := s.cache.Delete(sessCtx, passwordResetFailedAttemptsCacheKey(u.ID.Hex())); err != nil {
+		return nil, err
+	}
+
 	//
 	// STEP 5: Send email
 	//
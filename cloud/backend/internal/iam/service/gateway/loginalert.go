@@ -0,0 +1,107 @@
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+)
+
+// loginAlertExpiry is how long the "this wasn't me" link of a new login
+// alert stays valid; it matches the lifetime of the refresh token.
+const loginAlertExpiry = 14 * 24 * time.Hour
+
+// LoginAlertData is stored in the cache under the disown token sent with a
+// new login alert.
+type LoginAlertData struct {
+	FederatedUserID string    `json:"federated_user_id"`
+	Device          string    `json:"device"`
+	Country         string    `json:"country"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func loginAlertCacheKey(disownToken string) string {
+	return fmt.Sprintf("login_alert:%s", disownToken)
+}
+
+// loginDeviceFingerprint identifies a device by its user agent and the
+// device ID the client generated for itself.
+func loginDeviceFingerprint(userAgent, deviceID string) string {
+	sum := sha256.Sum256([]byte(userAgent + "\x00" + deviceID))
+	return hex.EncodeToString(sum[:])
+}
+
+// lookupLoginCountry returns the country of the client IP address or an empty
+// string if it could not be determined.
+func (s *gatewayCompleteLoginServiceImpl) lookupLoginCountry(ctx context.Context, ipAddress string) string {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil {
+		return ""
+	}
+	country, err := s.ipCountryBlocker.GetCountryCode(ctx, ip)
+	if err != nil {
+		s.logger.Debug("Failed to look up login country", zap.Error(err))
+		return ""
+	}
+	return country
+}
+
+// sendNewLoginAlert emails the user about a login from a new device or
+// country with a link to disown it. Failing to alert the user is logged but
+// never blocks the login.
+func (s *gatewayCompleteLoginServiceImpl) sendNewLoginAlert(ctx context.Context, user *domain.FederatedUser, userAgent, country string, loggedInAt time.Time, isNewDevice, isNewCountry bool) {
+	disownToken := make([]byte, 32)
+	if _, err := rand.Read(disownToken); err != nil {
+		s.logger.Error("Failed to generate disown token", zap.Error(err))
+		return
+	}
+
+	device := userAgent
+	if device == "" {
+		device = "Unknown device"
+	}
+	data := LoginAlertData{
+		FederatedUserID: user.ID.Hex(),
+		Device:          device,
+		Country:         country,
+		CreatedAt:       loggedInAt,
+	}
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		s.logger.Error("Failed to marshal login alert data", zap.Error(err))
+		return
+	}
+	token := base64.RawURLEncoding.EncodeToString(disownToken)
+	if err := s.cache.SetWithExpiry(ctx, loginAlertCacheKey(token), dataJSON, loginAlertExpiry); err != nil {
+		s.logger.Error("Failed to store login alert in cache", zap.Error(err))
+		return
+	}
+
+	// 1=PAPERCLOUD
+	if err := s.sendNewLoginAlertUseCase.Execute(ctx, 1, user.Email, device, country, token, user.FirstName, loggedInAt); err != nil {
+		s.logger.Error("Failed to send new login alert", zap.Error(err))
+		return
+	}
+
+	recordAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+		dom_auditevent.AuditEventTypeLoginNewDeviceAlerted,
+		dom_auditevent.AuditEventOutcomeSuccess,
+		user.ID,
+		map[string]string{
+			"new_device":  strconv.FormatBool(isNewDevice),
+			"new_country": strconv.FormatBool(isNewCountry),
+			"country":     country,
+		},
+	))
+}
@@ -3,8 +3,13 @@ package gateway
 import (
 	"context"
 
+	"go.uber.org/zap"
+
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
 )
 
@@ -13,24 +18,36 @@ type GatewayLogoutService interface {
 }
 
 type gatewayLogoutServiceImpl struct {
-	cache mongodbcache.Cacher
+	cache                   mongodbcache.Cacher
+	logger                  *zap.Logger
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
 }
 
 func NewGatewayLogoutService(
 	cach mongodbcache.Cacher,
+	logger *zap.Logger,
+	uc1 uc_auditevent.AuditEventCreateUseCase,
 ) GatewayLogoutService {
-	return &gatewayLogoutServiceImpl{cach}
+	return &gatewayLogoutServiceImpl{cach, logger, uc1}
 }
 
 func (s *gatewayLogoutServiceImpl) Execute(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "GatewayLogoutService.Execute")
+	defer span.End()
+
 	// Extract from our session the following data.
 	sessionID, ok := ctx.Value(constants.SessionID).(string)
 	if !ok {
-		return httperror.NewForBadRequestWithSingleField("session_id", "not logged in")
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("session_id", "not logged in"), httperror.CodeUnauthenticated)
 	}
 
 	if err := s.cache.Delete(ctx, sessionID); err != nil {
 		return err
 	}
+
+	recordAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, &dom_auditevent.AuditEvent{
+		Type:    dom_auditevent.AuditEventTypeSessionRevoked,
+		Details: map[string]string{"reason": "logout"},
+	})
 	return nil
 }
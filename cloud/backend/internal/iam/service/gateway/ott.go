@@ -0,0 +1,113 @@
+package gateway
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// loginMagicLinkSubjectPrefix is prepended to the nonce signed into a magic
+// link token so the token can never be mistaken for a session ID.
+const loginMagicLinkSubjectPrefix = "login_magic_link:"
+
+func loginOTTCacheKey(email string) string {
+	return fmt.Sprintf("login_ott:%s", email)
+}
+
+func loginOTTEmailCooldownCacheKey(email string) string {
+	return fmt.Sprintf("login_ott_cooldown:email:%s", email)
+}
+
+func loginOTTIPCooldownCacheKey(ipAddress string) string {
+	return fmt.Sprintf("login_ott_cooldown:ip:%s", ipAddress)
+}
+
+// isCoolingDown returns true if `key` was set by `startCooldown` and has not
+// expired yet.
+func isCoolingDown(ctx context.Context, cache mongodbcache.Cacher, key string) bool {
+	val, err := cache.Get(ctx, key)
+	return err == nil && len(val) > 0
+}
+
+func startCooldown(ctx context.Context, cache mongodbcache.Cacher, key string, cooldown time.Duration) error {
+	if cooldown <= 0 {
+		return nil
+	}
+	return cache.SetWithExpiry(ctx, key, []byte(time.Now().UTC().Format(time.RFC3339)), cooldown)
+}
+
+// startLoginOTTCooldowns rejects the request with a `429 Too Many Requests`
+// error if a login code was recently requested for `email` or from
+// `ipAddress`, otherwise it starts a new cooldown for both. The cache is not
+// part of the database transaction so cooldowns persist even if the request
+// fails afterwards, which stops attackers probing for email addresses.
+func (s *gatewayRequestLoginOTTServiceImpl) startLoginOTTCooldowns(ctx context.Context, email, ipAddress string) error {
+	emailKey := loginOTTEmailCooldownCacheKey(email)
+	ipKey := loginOTTIPCooldownCacheKey(ipAddress)
+
+	if ipAddress != "" && isCoolingDown(ctx, s.cache, ipKey) {
+		s.logger.Warn("login ott requested during ip cooldown", zap.String("ip_address", ipAddress))
+		return httperror.WithCode(httperror.NewForSingleField(http.StatusTooManyRequests, "message", "Too many login requests, please wait before trying again"), httperror.CodeLoginThrottled)
+	}
+	if isCoolingDown(ctx, s.cache, emailKey) {
+		s.logger.Warn("login ott requested during email cooldown", zap.String("email", email))
+		return httperror.WithCode(httperror.NewForSingleField(http.StatusTooManyRequests, "email", "A login code was recently sent to this email, please wait before requesting another"), httperror.CodeLoginThrottled)
+	}
+
+	if ipAddress != "" {
+		if err := startCooldown(ctx, s.cache, ipKey, time.Duration(s.config.App.LoginOTTIPCooldownSeconds)*time.Second); err != nil {
+			return err
+		}
+	}
+	return startCooldown(ctx, s.cache, emailKey, time.Duration(s.config.App.LoginOTTEmailCooldownSeconds)*time.Second)
+}
+
+// isOTTMatch compares the codes in constant time.
+func isOTTMatch(expected, actual string) bool {
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(actual)) == 1
+}
+
+// recordOTTFailedAttempt counts a wrong code against `ottData`. Once
+// `maxAttempts` is reached the code is removed from the cache so it cannot
+// be guessed any further; a `maxAttempts` of zero disables the limit.
+func recordOTTFailedAttempt(ctx context.Context, cache mongodbcache.Cacher, logger *zap.Logger, ottData *LoginOTTData, maxAttempts int) error {
+	cacheKey := loginOTTCacheKey(ottData.Email)
+	ottData.FailedAttempts++
+
+	if maxAttempts > 0 && ottData.FailedAttempts >= maxAttempts {
+		logger.Warn("login ott invalidated after too many failed attempts",
+			zap.String("email", ottData.Email),
+			zap.Int("failed_attempts", ottData.FailedAttempts))
+		if err := cache.Delete(ctx, cacheKey); err != nil {
+			return err
+		}
+		return httperror.WithCode(httperror.NewForSingleField(http.StatusTooManyRequests, "ott", "Too many failed attempts, please request a new verification code"), httperror.CodeVerificationAttemptsExceeded)
+	}
+
+	ottDataJSON, err := json.Marshal(ottData)
+	if err != nil {
+		return err
+	}
+	if err := cache.SetWithExpiry(ctx, cacheKey, ottDataJSON, time.Until(ottData.ExpiresAt)); err != nil {
+		return err
+	}
+	return httperror.WithCode(httperror.NewForBadRequestWithSingleField("ott", "Invalid verification code"), httperror.CodeInvalidVerificationCode)
+}
+
+// parseLoginMagicLinkSubject returns the nonce of a magic link token subject.
+func parseLoginMagicLinkSubject(subject string) (string, bool) {
+	if !strings.HasPrefix(subject, loginMagicLinkSubjectPrefix) {
+		return "", false
+	}
+	nonce := strings.TrimPrefix(subject, loginMagicLinkSubjectPrefix)
+	return nonce, nonce != ""
+}
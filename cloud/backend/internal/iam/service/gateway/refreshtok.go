@@ -7,9 +7,13 @@ import (
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
 
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
 )
@@ -22,17 +26,23 @@ type GatewayRefreshTokenService interface {
 }
 
 type gatewayRefreshTokenServiceImpl struct {
-	cache                 mongodbcache.Cacher
-	jwtProvider           jwt.Provider
-	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase
+	logger                  *zap.Logger
+	cache                   mongodbcache.Cacher
+	jwtProvider             jwt.Provider
+	userGetByEmailUseCase   uc_user.FederatedUserGetByEmailUseCase
+	userAddSessionUseCase   uc_user.FederatedUserAddSessionUseCase
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
 }
 
 func NewGatewayRefreshTokenService(
+	logger *zap.Logger,
 	cach mongodbcache.Cacher,
 	jwtp jwt.Provider,
 	uc1 uc_user.FederatedUserGetByEmailUseCase,
+	uc2 uc_user.FederatedUserAddSessionUseCase,
+	uc3 uc_auditevent.AuditEventCreateUseCase,
 ) GatewayRefreshTokenService {
-	return &gatewayRefreshTokenServiceImpl{cach, jwtp, uc1}
+	return &gatewayRefreshTokenServiceImpl{logger, cach, jwtp, uc1, uc2, uc3}
 }
 
 type GatewayRefreshTokenRequestIDO struct {
@@ -52,6 +62,9 @@ func (s *gatewayRefreshTokenServiceImpl) Execute(
 	sessCtx context.Context,
 	req *GatewayRefreshTokenRequestIDO,
 ) (*GatewayRefreshTokenResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayRefreshTokenService.Execute")
+	defer span.End()
+
 	////
 	//// Extract the `sessionID` so we can process it.
 	////
@@ -93,12 +106,24 @@ func (s *gatewayRefreshTokenServiceImpl) Execute(
 		return nil, err
 	}
 
+	// Track the new session so it gets revoked alongside the others.
+	if err := s.userAddSessionUseCase.Execute(sessCtx, u.ID, newSessionUUID, rtExpiry); err != nil {
+		return nil, err
+	}
+
 	// Generate our JWT token.
 	accessToken, accessTokenExpiry, refreshToken, refreshTokenExpiry, err := s.jwtProvider.GenerateJWTTokenPair(newSessionUUID, atExpiry, rtExpiry)
 	if err != nil {
 		return nil, err
 	}
 
+	recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+		dom_auditevent.AuditEventTypeTokenRefreshed,
+		dom_auditevent.AuditEventOutcomeSuccess,
+		u.ID,
+		nil,
+	))
+
 	ido := &GatewayRefreshTokenResponseIDO{
 		Email:                  u.Email,
 		AccessToken:            accessToken,
@@ -11,10 +11,14 @@ import (
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/consentrecord"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	uc_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/consentrecord"
 	uc_emailer "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/emailer"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	uc_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/invite"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/random"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/password"
@@ -38,6 +42,8 @@ type gatewayFederatedUserRegisterServiceImpl struct {
 	userCreateUseCase                         uc_user.FederatedUserCreateUseCase
 	userUpdateUseCase                         uc_user.FederatedUserUpdateUseCase
 	sendFederatedUserVerificationEmailUseCase uc_emailer.SendFederatedUserVerificationEmailUseCase
+	inviteConsumeUseCase                      uc_invite.InviteConsumeUseCase
+	consentRecordCreateUseCase                uc_consentrecord.ConsentRecordCreateUseCase
 }
 
 func NewGatewayFederatedUserRegisterService(
@@ -50,13 +56,15 @@ func NewGatewayFederatedUserRegisterService(
 	uc2 uc_user.FederatedUserCreateUseCase,
 	uc3 uc_user.FederatedUserUpdateUseCase,
 	uc4 uc_emailer.SendFederatedUserVerificationEmailUseCase,
+	uc5 uc_invite.InviteConsumeUseCase,
+	uc6 uc_consentrecord.ConsentRecordCreateUseCase,
 ) GatewayFederatedUserRegisterService {
-	return &gatewayFederatedUserRegisterServiceImpl{cfg, logger, pp, cach, jwtp, uc1, uc2, uc3, uc4}
+	return &gatewayFederatedUserRegisterServiceImpl{cfg, logger, pp, cach, jwtp, uc1, uc2, uc3, uc4, uc5, uc6}
 }
 
 type RegisterCustomerRequestIDO struct {
 	// --- Application and personal identiable information (PII) ---
-	BetaAccessCode                                 string `json:"beta_access_code"` // Temporary code for beta access
+	BetaAccessCode                                 string `json:"beta_access_code"` // Invite code for beta access
 	FirstName                                      string `json:"first_name"`
 	LastName                                       string `json:"last_name"`
 	Email                                          string `json:"email"`
@@ -85,6 +93,9 @@ func (svc *gatewayFederatedUserRegisterServiceImpl) Execute(
 	sessCtx context.Context,
 	req *RegisterCustomerRequestIDO,
 ) error {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayFederatedUserRegisterService.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Sanitization of the input.
 	//
@@ -102,10 +113,6 @@ func (svc *gatewayFederatedUserRegisterServiceImpl) Execute(
 	e := make(map[string]string)
 	if req.BetaAccessCode == "" {
 		e["beta_access_code"] = "Beta access code is required"
-	} else {
-		if req.BetaAccessCode != svc.config.App.BetaAccessCode {
-			e["beta_access_code"] = "Invalid beta access code"
-		}
 	}
 	if req.FirstName == "" {
 		e["first_name"] = "First name is required"
@@ -179,10 +186,18 @@ func (svc *gatewayFederatedUserRegisterServiceImpl) Execute(
 		return err
 	}
 	if u != nil {
-		return httperror.NewForBadRequestWithSingleField("email", "Email address already exists")
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "Email address already exists"), httperror.CodeEmailAlreadyExists)
+	}
+
+	// Take one use of the invite. This happens inside the registration
+	// transaction so the use is given back if creating the user fails.
+	invite, err := svc.inviteConsumeUseCase.Execute(sessCtx, req.BetaAccessCode, req.Module)
+	if err != nil {
+		return err
 	}
+
 	// Create our federateduser.
-	u, err = svc.createCustomerFederatedUserForRequest(sessCtx, req)
+	u, err = svc.createCustomerFederatedUserForRequest(sessCtx, req, invite.ID)
 	if err != nil {
 		return err
 	}
@@ -194,7 +209,7 @@ func (svc *gatewayFederatedUserRegisterServiceImpl) Execute(
 	return nil
 }
 
-func (s *gatewayFederatedUserRegisterServiceImpl) createCustomerFederatedUserForRequest(sessCtx context.Context, req *RegisterCustomerRequestIDO) (*dom_user.FederatedUser, error) {
+func (s *gatewayFederatedUserRegisterServiceImpl) createCustomerFederatedUserForRequest(sessCtx context.Context, req *RegisterCustomerRequestIDO, inviteID primitive.ObjectID) (*dom_user.FederatedUser, error) {
 
 	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
 
@@ -204,6 +219,7 @@ func (s *gatewayFederatedUserRegisterServiceImpl) createCustomerFederatedUserFor
 	}
 
 	userID := primitive.NewObjectID()
+	now := time.Now()
 	u := &dom_user.FederatedUser{
 		// --- E2EE ---
 		Salt:                              req.Salt,
@@ -233,27 +249,30 @@ func (s *gatewayFederatedUserRegisterServiceImpl) createCustomerFederatedUserFor
 		AgreeTermsOfService: req.AgreeTermsOfService,
 		AgreePromotions:     req.AgreePromotions,
 		AgreeToTrackingAcrossThirdPartyAppsAndServices: req.AgreeToTrackingAcrossThirdPartyAppsAndServices,
-		CreatedByUserID:         userID,
-		CreatedAt:               time.Now(),
-		CreatedByName:           fmt.Sprintf("%s %s", req.FirstName, req.LastName),
-		CreatedFromIPAddress:    ipAddress,
-		ModifiedByUserID:        userID,
-		ModifiedAt:              time.Now(),
-		ModifiedByName:          fmt.Sprintf("%s %s", req.FirstName, req.LastName),
-		ModifiedFromIPAddress:   ipAddress,
-		WasEmailVerified:        false,
-		EmailVerificationCode:   fmt.Sprintf("%s", emailVerificationCode),
-		EmailVerificationExpiry: time.Now().Add(72 * time.Hour),
-		Status:                  dom_user.FederatedUserStatusActive,
-		HasShippingAddress:      false,
-		ShippingName:            "",
-		ShippingPhone:           "",
-		ShippingCountry:         "",
-		ShippingRegion:          "",
-		ShippingCity:            "",
-		ShippingPostalCode:      "",
-		ShippingAddressLine1:    "",
-		ShippingAddressLine2:    "",
+		AgreeTermsOfServiceVersion:                     s.config.App.TermsOfServiceVersion,
+		AgreeTermsOfServiceAt:                          now,
+		CreatedByUserID:                                userID,
+		CreatedAt:                                      time.Now(),
+		CreatedByName:                                  fmt.Sprintf("%s %s", req.FirstName, req.LastName),
+		CreatedFromIPAddress:                           ipAddress,
+		ModifiedByUserID:                               userID,
+		ModifiedAt:                                     time.Now(),
+		ModifiedByName:                                 fmt.Sprintf("%s %s", req.FirstName, req.LastName),
+		ModifiedFromIPAddress:                          ipAddress,
+		InviteID:                                       inviteID,
+		WasEmailVerified:                               false,
+		EmailVerificationCode:                          fmt.Sprintf("%s", emailVerificationCode),
+		EmailVerificationExpiry:                        time.Now().Add(72 * time.Hour),
+		Status:                                         dom_user.FederatedUserStatusActive,
+		HasShippingAddress:                             false,
+		ShippingName:                                   "",
+		ShippingPhone:                                  "",
+		ShippingCountry:                                "",
+		ShippingRegion:                                 "",
+		ShippingCity:                                   "",
+		ShippingPostalCode:                             "",
+		ShippingAddressLine1:                           "",
+		ShippingAddressLine2:                           "",
 	}
 	if req.CountryOther != "" {
 		u.Country = req.CountryOther
@@ -263,5 +282,31 @@ func (s *gatewayFederatedUserRegisterServiceImpl) createCustomerFederatedUserFor
 		return nil, err
 	}
 
+	// Append what the user agreed to during registration to the consent
+	// ledger. Terms of service acceptance is required by our validation.
+	var granted []string
+	if u.AgreeTermsOfService {
+		granted = append(granted, dom_consentrecord.ConsentTypeTermsOfService)
+	}
+	if u.AgreePromotions {
+		granted = append(granted, dom_consentrecord.ConsentTypePromotions)
+	}
+	if u.AgreeToTrackingAcrossThirdPartyAppsAndServices {
+		granted = append(granted, dom_consentrecord.ConsentTypeTracking)
+	}
+	for _, consentType := range granted {
+		record := &dom_consentrecord.ConsentRecord{
+			FederatedUserID: u.ID,
+			Type:            consentType,
+			DocumentVersion: u.AgreeTermsOfServiceVersion,
+			Granted:         true,
+			IPAddress:       ipAddress,
+			CreatedAt:       now,
+		}
+		if err := s.consentRecordCreateUseCase.Execute(sessCtx, record); err != nil {
+			return nil, err
+		}
+	}
+
 	return u, nil
 }
@@ -8,12 +8,20 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
 	uc_emailer "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/emailer"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/random"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
@@ -42,16 +50,24 @@ type LoginOTTData struct {
 	ClientIP    string    `json:"client_ip"`
 	IsVerified  bool      `json:"is_verified"`
 	ChallengeID string    `json:"challenge_id,omitempty"`
+
+	// FailedAttempts counts the wrong codes entered for this OTT.
+	FailedAttempts int `json:"failed_attempts"`
+
+	// MagicLinkNonce is signed into the magic link token emailed along with
+	// the code, if magic links are enabled.
+	MagicLinkNonce string `json:"magic_link_nonce,omitempty"`
 }
 
 // Implementation of OTT request service
 type gatewayRequestLoginOTTServiceImpl struct {
-	config                *config.Configuration
-	logger                *zap.Logger
-	cache                 mongodbcache.Cacher
-	jwtProvider           jwt.Provider
-	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase
-	sendOTTEmailUseCase   uc_emailer.SendLoginOTTEmailUseCase
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	cache                   mongodbcache.Cacher
+	jwtProvider             jwt.Provider
+	userGetByEmailUseCase   uc_user.FederatedUserGetByEmailUseCase
+	sendOTTEmailUseCase     uc_emailer.SendLoginOTTEmailUseCase
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
 }
 
 func NewGatewayRequestLoginOTTService(
@@ -61,18 +77,23 @@ func NewGatewayRequestLoginOTTService(
 	jwtProvider jwt.Provider,
 	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase,
 	sendOTTEmailUseCase uc_emailer.SendLoginOTTEmailUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
 ) GatewayRequestLoginOTTService {
 	return &gatewayRequestLoginOTTServiceImpl{
-		config:                config,
-		logger:                logger,
-		cache:                 cache,
-		jwtProvider:           jwtProvider,
-		userGetByEmailUseCase: userGetByEmailUseCase,
-		sendOTTEmailUseCase:   sendOTTEmailUseCase,
+		config:                  config,
+		logger:                  logger,
+		cache:                   cache,
+		jwtProvider:             jwtProvider,
+		userGetByEmailUseCase:   userGetByEmailUseCase,
+		sendOTTEmailUseCase:     sendOTTEmailUseCase,
+		auditEventCreateUseCase: auditEventCreateUseCase,
 	}
 }
 
 func (s *gatewayRequestLoginOTTServiceImpl) Execute(sessCtx context.Context, req *GatewayRequestLoginOTTRequestIDO) (*GatewayRequestLoginOTTResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayRequestLoginOTTService.Execute")
+	defer span.End()
+
 	// Validate input
 	e := make(map[string]string)
 	if req.Email == "" {
@@ -86,13 +107,39 @@ func (s *gatewayRequestLoginOTTServiceImpl) Execute(sessCtx context.Context, req
 	req.Email = strings.ToLower(req.Email)
 	req.Email = strings.ReplaceAll(req.Email, " ", "")
 
+	// Throttle before looking up the user so neither the victim's inbox
+	// nor our user base can be probed at full speed.
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+	if err := s.startLoginOTTCooldowns(sessCtx, req.Email, ipAddress); err != nil {
+		return nil, err
+	}
+
 	// Check if user exists
 	user, err := s.userGetByEmailUseCase.Execute(sessCtx, req.Email)
 	if err != nil {
 		return nil, err
 	}
 	if user == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("email", "Email address does not exist")
+		recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+			dom_auditevent.AuditEventTypeLoginOTTRequested,
+			dom_auditevent.AuditEventOutcomeFailure,
+			primitive.NilObjectID,
+			map[string]string{"email": req.Email, "reason": "unknown_email"},
+		))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "Email address does not exist"), httperror.CodeEmailNotFound)
+	}
+	switch user.Status {
+	case dom_user.FederatedUserStatusLocked, dom_user.FederatedUserStatusArchived:
+		recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+			dom_auditevent.AuditEventTypeLoginOTTRequested,
+			dom_auditevent.AuditEventOutcomeFailure,
+			user.ID,
+			map[string]string{"reason": "account_disabled"},
+		))
+		if user.Status == dom_user.FederatedUserStatusLocked {
+			return nil, httperror.WithCode(httperror.NewForLockedWithSingleField("email", "Account is locked, please contact support"), httperror.CodeAccountLocked)
+		}
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("email", "Account has been archived"), httperror.CodeAccountArchived)
 	}
 
 	// Generate OTT
@@ -107,12 +154,24 @@ func (s *gatewayRequestLoginOTTServiceImpl) Execute(sessCtx context.Context, req
 		OTT:        ott,
 		CreatedAt:  time.Now(),
 		ExpiresAt:  time.Now().Add(10 * time.Minute), // OTT valid for 10 minutes
-		ClientIP:   "",                               // IP could be stored here if needed
+		ClientIP:   ipAddress,
 		IsVerified: false,
 	}
 
+	// Generate a signed, single-use magic link token which completes this
+	// step without typing the code.
+	var magicLinkToken string
+	if s.config.App.LoginMagicLinkEnabled {
+		ottData.MagicLinkNonce = uuid.New().String()
+		magicLinkToken, _, err = s.jwtProvider.GenerateJWTToken(loginMagicLinkSubjectPrefix+ottData.MagicLinkNonce, time.Until(ottData.ExpiresAt))
+		if err != nil {
+			s.logger.Error("Failed to generate magic link token", zap.Error(err))
+			return nil, fmt.Errorf("failed to process login request: %w", err)
+		}
+	}
+
 	// Generate a unique cache key for this OTT
-	cacheKey := fmt.Sprintf("login_ott:%s", req.Email)
+	cacheKey := loginOTTCacheKey(req.Email)
 
 	// Marshal the data to JSON
 	ottDataJSON, err := json.Marshal(ottData)
@@ -129,11 +188,18 @@ func (s *gatewayRequestLoginOTTServiceImpl) Execute(sessCtx context.Context, req
 
 	// Send OTT via email
 	// 1=PAPERCLOUD
-	if err := s.sendOTTEmailUseCase.Execute(sessCtx, 1, user.Email, ott, user.FirstName); err != nil {
+	if err := s.sendOTTEmailUseCase.Execute(sessCtx, 1, user.Email, ott, magicLinkToken, user.FirstName); err != nil {
 		s.logger.Error("Failed to send OTT email", zap.Error(err))
 		return nil, fmt.Errorf("failed to send login code: %w", err)
 	}
 
+	recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+		dom_auditevent.AuditEventTypeLoginOTTRequested,
+		dom_auditevent.AuditEventOutcomeSuccess,
+		user.ID,
+		nil,
+	))
+
 	return &GatewayRequestLoginOTTResponseIDO{
 		Message: "A verification code has been sent to your email",
 	}, nil
@@ -2,10 +2,20 @@ package gateway
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
-	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
-	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/password"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
 )
 
@@ -14,28 +24,44 @@ type GatewayResetPasswordService interface {
 }
 
 type gatewayResetPasswordServiceImpl struct {
-	passwordProvider      password.Provider
-	cache                 mongodbcache.Cacher
-	jwtProvider           jwt.Provider
-	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase
-	userUpdateUseCase     uc_user.FederatedUserUpdateUseCase
+	config                    *config.Configuration
+	logger                    *zap.Logger
+	cache                     mongodbcache.Cacher
+	userGetByIDUseCase        uc_user.FederatedUserGetByIDUseCase
+	userUpdateUseCase         uc_user.FederatedUserUpdateUseCase
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase
+	auditEventCreateUseCase   uc_auditevent.AuditEventCreateUseCase
 }
 
 func NewGatewayResetPasswordService(
-	pp password.Provider,
-	cach mongodbcache.Cacher,
-	jwtp jwt.Provider,
-	uc1 uc_user.FederatedUserGetByEmailUseCase,
-	uc2 uc_user.FederatedUserUpdateUseCase,
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+	userUpdateUseCase uc_user.FederatedUserUpdateUseCase,
+	userRevokeSessionsUseCase uc_user.FederatedUserRevokeSessionsUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
 ) GatewayResetPasswordService {
-	return &gatewayResetPasswordServiceImpl{pp, cach, jwtp, uc1, uc2}
+	return &gatewayResetPasswordServiceImpl{
+		config:                    config,
+		logger:                    logger,
+		cache:                     cache,
+		userGetByIDUseCase:        userGetByIDUseCase,
+		userUpdateUseCase:         userUpdateUseCase,
+		userRevokeSessionsUseCase: userRevokeSessionsUseCase,
+		auditEventCreateUseCase:   auditEventCreateUseCase,
+	}
 }
 
+// GatewayResetPasswordRequestIDO completes the account recovery. The client has
+// unwrapped the master key with its recovery key, decrypted the challenge with
+// the private key and re-wrapped both keys under the new password.
 type GatewayResetPasswordRequestIDO struct {
-	Code            string `json:"code"`
-	Email           string `json:"email"`
-	Password        string `json:"password"`
-	PasswordConfirm string `json:"password_confirm"`
+	RecoveryID          string `json:"recoveryId"`
+	DecryptedData       string `json:"decryptedData"`
+	Salt                string `json:"salt"`
+	EncryptedMasterKey  string `json:"encryptedMasterKey"`
+	EncryptedPrivateKey string `json:"encryptedPrivateKey"`
 }
 
 type GatewayResetPasswordResponseIDO struct {
@@ -43,103 +69,110 @@ type GatewayResetPasswordResponseIDO struct {
 }
 
 func (s *gatewayResetPasswordServiceImpl) Execute(sessCtx context.Context, req *GatewayResetPasswordRequestIDO) (*GatewayResetPasswordResponseIDO, error) {
-	return nil, nil
-	// ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
-
-	// //
-	// // STEP 1: Sanization of input.
-	// //
-
-	// // Defensive Code: For security purposes we need to perform some sanitization on the inputs.
-	// req.Email = strings.ToLower(req.Email)
-	// req.Email = strings.ReplaceAll(req.Email, " ", "")
-	// req.Email = strings.ReplaceAll(req.Email, "\t", "")
-	// req.Email = strings.TrimSpace(req.Email)
-
-	// //
-	// // STEP 2: Validation of input.
-	// //
-
-	// e := make(map[string]string)
-	// if req.Email == "" {
-	// 	e["email"] = "Email address is required"
-	// }
-	// if len(req.Email) > 255 {
-	// 	e["email"] = "too long"
-	// }
-	// if req.Password == "" {
-	// 	e["password"] = "missing value"
-	// }
-	// if req.PasswordConfirm == "" {
-	// 	e["password_confirm"] = "missing value"
-	// }
-	// if req.PasswordConfirm != req.Password {
-	// 	e["password"] = "does not match"
-	// 	e["password_confirm"] = "does not match"
-	// }
-
-	// if len(e) != 0 {
-	// 	return nil, httperror.NewForBadRequest(&e)
-	// }
-
-	// //
-	// // STEP 3:
-	// //
-
-	// // Lookup the user in our database, else return a `400 Bad Request` error.
-	// u, err := s.userGetByEmailUseCase.Execute(sessCtx, req.Email)
-	// if err != nil {
-	// 	return nil, err
-	// }
-	// if u == nil {
-	// 	return nil, httperror.NewForBadRequestWithSingleField("email", "Email address does not exist")
-	// }
-
-	// //
-	// // STEP 4:
-	// //
-
-	// if req.Code != u.PasswordResetVerificationCode {
-	// 	return nil, httperror.NewForBadRequestWithSingleField("code", "Verification code is incorrect")
-
-	// }
-	// if time.Now().After(u.PasswordResetVerificationExpiry) {
-	// 	return nil, httperror.NewForBadRequestWithSingleField("code", "Verification code has expired")
-	// }
-
-	// //
-	// // STEP 4: Hash the password and update the user's password in the database.
-	// //
-
-	// password, err := sstring.NewSecureString(req.Password)
-	// if err != nil {
-	// 	return nil, err
-	// }
-	// defer password.Wipe()
-
-	// passwordHash, err := s.passwordProvider.GenerateHashFromPassword(password)
-	// if err != nil {
-	// 	return nil, err
-	// }
-
-	// // u.PasswordHash = passwordHash
-	// // u.PasswordHashAlgorithm = s.passwordProvider.AlgorithmName()
-	// u.PasswordResetVerificationCode = ""
-	// u.PasswordResetVerificationExpiry = time.Time{} // This is equivalent to not-set time
-	// u.ModifiedAt = time.Now()
-	// u.ModifiedByName = fmt.Sprintf("%s %s", u.FirstName, u.LastName)
-	// u.ModifiedFromIPAddress = ipAddress
-	// err = s.userUpdateUseCase.Execute(sessCtx, u)
-	// if err != nil {
-	// 	return nil, err
-	// }
-
-	// //
-	// // STEP 5: Done
-	// //
-
-	// // Return our auth keys.
-	// return &GatewayResetPasswordResponseIDO{
-	// 	Message: "Password reset email has been sent",
-	// }, nil
+	sessCtx, span := tracing.Start(sessCtx, "GatewayResetPasswordService.Execute")
+	defer span.End()
+
+	ipAddress, _ := sessCtx.Value(constants.SessionIPAddress).(string)
+
+	//
+	// STEP 1: Validation of input.
+	//
+
+	e := make(map[string]string)
+	if req.RecoveryID == "" {
+		e["recoveryId"] = "Recovery ID is required"
+	}
+	if req.DecryptedData == "" {
+		e["decryptedData"] = "Decrypted data is required"
+	}
+	validateWrappedKeys(e, req.Salt, req.EncryptedMasterKey, req.EncryptedPrivateKey)
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Verify the recovery challenge.
+	//
+
+	recoveryCacheKey := fmt.Sprintf("account_recovery:%s", req.RecoveryID)
+	challengeDataJSON, err := s.cache.Get(sessCtx, recoveryCacheKey)
+	if err != nil || challengeDataJSON == nil {
+		s.logger.Warn("Recovery challenge not found", zap.Any("error", err))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("recoveryId", "Invalid or expired recovery"), httperror.CodeInvalidToken)
+	}
+
+	var challengeData ChallengeData
+	if err := json.Unmarshal(challengeDataJSON, &challengeData); err != nil {
+		s.logger.Error("Failed to unmarshal recovery challenge", zap.Error(err))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("recoveryId", "Invalid recovery"), httperror.CodeInvalidToken)
+	}
+	if time.Now().After(challengeData.ExpiresAt) {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("recoveryId", "Recovery has expired"), httperror.CodeTokenExpired)
+	}
+	if challengeData.Challenge != req.DecryptedData {
+		s.logger.Warn("Recovery challenge verification failed",
+			zap.String("federated_user_id", challengeData.FederatedUserID))
+		failedUserID, _ := primitive.ObjectIDFromHex(challengeData.FederatedUserID)
+		recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+			dom_auditevent.AuditEventTypeLoginChallengeFailed,
+			dom_auditevent.AuditEventOutcomeFailure,
+			failedUserID,
+			map[string]string{"flow": "account_recovery"},
+		))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("decryptedData", "Invalid challenge response"), httperror.CodeInvalidChallengeResponse)
+	}
+
+	// The recovery is single use
+	if err := s.cache.Delete(sessCtx, recoveryCacheKey); err != nil {
+		s.logger.Warn("Failed to delete recovery challenge from cache", zap.Error(err))
+	}
+
+	//
+	// STEP 3: Replace the wrapped keys.
+	//
+
+	userID, err := primitive.ObjectIDFromHex(challengeData.FederatedUserID)
+	if err != nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("recoveryId", "Invalid recovery"), httperror.CodeInvalidToken)
+	}
+	u, err := s.userGetByIDUseCase.Execute(sessCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("recoveryId", "Invalid recovery"), httperror.CodeInvalidToken)
+	}
+
+	u.Salt = req.Salt
+	u.EncryptedMasterKey = req.EncryptedMasterKey
+	u.EncryptedPrivateKey = req.EncryptedPrivateKey
+	u.ModifiedAt = time.Now()
+	u.ModifiedByUserID = u.ID
+	u.ModifiedByName = fmt.Sprintf("%s %s", u.FirstName, u.LastName)
+	u.ModifiedFromIPAddress = ipAddress
+	if err := s.userUpdateUseCase.Execute(sessCtx, u); err != nil {
+		return nil, err
+	}
+
+	//
+	// STEP 4: Revoke every session.
+	//
+
+	if err := s.userRevokeSessionsUseCase.Execute(sessCtx, u.ID, ""); err != nil {
+		s.logger.Error("Failed to revoke sessions", zap.Error(err))
+		return nil, err
+	}
+
+	recordAuditEvent(sessCtx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+		dom_auditevent.AuditEventTypeAccountRecovered,
+		dom_auditevent.AuditEventOutcomeSuccess,
+		u.ID,
+		map[string]string{"sessions_revoked": "all"},
+	))
+
+	s.logger.Info("Account recovered", zap.String("user_id", u.ID.Hex()))
+
+	return &GatewayResetPasswordResponseIDO{
+		Message: "Password has been reset",
+	}, nil
 }
@@ -6,6 +6,7 @@ import (
 	uc_emailer "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/emailer"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type GatewaySendVerifyEmailService interface {
@@ -32,6 +33,9 @@ type GatewaySendVerifyEmailRequestIDO struct {
 }
 
 func (s *gatewaySendVerifyEmailServiceImpl) Execute(sessCtx context.Context, req *GatewaySendVerifyEmailRequestIDO) error {
+	sessCtx, span := tracing.Start(sessCtx, "GatewaySendVerifyEmailService.Execute")
+	defer span.End()
+
 	// Extract from our session the following data.
 	// sessionID := sessCtx.Value(constants.SessionID).(string)
 	//
@@ -57,7 +61,7 @@ func (s *gatewaySendVerifyEmailServiceImpl) Execute(sessCtx context.Context, req
 		return err
 	}
 	if u == nil {
-		return httperror.NewForBadRequestWithSingleField("email", "does not exist")
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "does not exist"), httperror.CodeEmailNotFound)
 	}
 
 	if err := s.sendFederatedUserVerificationEmailUseCase.Execute(context.Background(), req.Module, u); err != nil {
@@ -8,6 +8,7 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type GatewayVerifyEmailService interface {
@@ -36,6 +37,9 @@ type GatwayVerifyEmailResponseIDO struct {
 }
 
 func (s *gatewayVerifyEmailServiceImpl) Execute(sessCtx context.Context, req *GatewayVerifyEmailRequestIDO) (*GatwayVerifyEmailResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayVerifyEmailService.Execute")
+	defer span.End()
+
 	// Extract from our session the following data.
 	// sessionID := sessCtx.Value(constants.SessionID).(string)
 
@@ -47,7 +51,7 @@ func (s *gatewayVerifyEmailServiceImpl) Execute(sessCtx context.Context, req *Ga
 		return nil, err
 	}
 	if u == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("code", "does not exist")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("code", "does not exist"), httperror.CodeInvalidVerificationCode)
 	}
 
 	//TODO: Handle expiry dates.
@@ -0,0 +1,138 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// Data structures for magic link verification
+type GatewayVerifyLoginMagicLinkRequestIDO struct {
+	Email string `json:"email"`
+	Token string `json:"token"`
+}
+
+// Service interface for magic link verification. A magic link completes the
+// same step as `GatewayVerifyLoginOTTService` so it returns the same response.
+type GatewayVerifyLoginMagicLinkService interface {
+	Execute(sessCtx context.Context, req *GatewayVerifyLoginMagicLinkRequestIDO) (*GatewayVerifyLoginOTTResponseIDO, error)
+}
+
+// Implementation of magic link verification service
+type gatewayVerifyLoginMagicLinkServiceImpl struct {
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	cache                   mongodbcache.Cacher
+	jwtProvider             jwt.Provider
+	userGetByEmailUseCase   uc_user.FederatedUserGetByEmailUseCase
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewGatewayVerifyLoginMagicLinkService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	jwtProvider jwt.Provider,
+	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) GatewayVerifyLoginMagicLinkService {
+	return &gatewayVerifyLoginMagicLinkServiceImpl{
+		config:                  config,
+		logger:                  logger,
+		cache:                   cache,
+		jwtProvider:             jwtProvider,
+		userGetByEmailUseCase:   userGetByEmailUseCase,
+		auditEventCreateUseCase: auditEventCreateUseCase,
+	}
+}
+
+func (s *gatewayVerifyLoginMagicLinkServiceImpl) Execute(sessCtx context.Context, req *GatewayVerifyLoginMagicLinkRequestIDO) (*GatewayVerifyLoginOTTResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayVerifyLoginMagicLinkService.Execute")
+	defer span.End()
+
+	if !s.config.App.LoginMagicLinkEnabled {
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "Magic links are not enabled"), httperror.CodeMagicLinkDisabled)
+	}
+
+	// Validate input
+	e := make(map[string]string)
+	if req.Email == "" {
+		e["email"] = "Email address is required"
+	}
+	if req.Token == "" {
+		e["token"] = "Token is required"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	// Sanitize input
+	req.Email = strings.ToLower(req.Email)
+	req.Email = strings.ReplaceAll(req.Email, " ", "")
+	req.Token = strings.TrimSpace(req.Token)
+
+	// Verify the signature and expiry of the token.
+	subject, err := s.jwtProvider.ProcessJWTToken(req.Token)
+	if err != nil {
+		s.recordMagicLinkFailure(sessCtx, req.Email)
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired magic link"), httperror.CodeInvalidToken)
+	}
+	nonce, ok := parseLoginMagicLinkSubject(subject)
+	if !ok {
+		s.recordMagicLinkFailure(sessCtx, req.Email)
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired magic link"), httperror.CodeInvalidToken)
+	}
+
+	// Retrieve OTT data from cache
+	ottDataJSON, err := s.cache.Get(sessCtx, loginOTTCacheKey(req.Email))
+	if err != nil || ottDataJSON == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired magic link"), httperror.CodeInvalidToken)
+	}
+	var ottData LoginOTTData
+	if err := json.Unmarshal(ottDataJSON, &ottData); err != nil {
+		s.logger.Error("Failed to unmarshal OTT data", zap.Error(err))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid magic link"), httperror.CodeInvalidToken)
+	}
+
+	// Only the link sent with the latest code is valid and only once.
+	if time.Now().After(ottData.ExpiresAt) {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Magic link has expired"), httperror.CodeTokenExpired)
+	}
+	if ottData.IsVerified {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Magic link has already been used"), httperror.CodeTokenUsed)
+	}
+	if ottData.MagicLinkNonce == "" || !isOTTMatch(ottData.MagicLinkNonce, nonce) {
+		s.recordMagicLinkFailure(sessCtx, req.Email)
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invalid or expired magic link"), httperror.CodeInvalidToken)
+	}
+
+	return issueLoginChallenge(sessCtx, s.cache, s.logger, s.userGetByEmailUseCase, &ottData)
+}
+
+// recordMagicLinkFailure records a failed magic link attempt against the
+// account it was issued for.
+func (s *gatewayVerifyLoginMagicLinkServiceImpl) recordMagicLinkFailure(ctx context.Context, email string) {
+	userID := primitive.NilObjectID
+	if user, err := s.userGetByEmailUseCase.Execute(ctx, email); err == nil && user != nil {
+		userID = user.ID
+	}
+	recordAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+		dom_auditevent.AuditEventTypeLoginOTTFailed,
+		dom_auditevent.AuditEventOutcomeFailure,
+		userID,
+		map[string]string{"email": email, "reason": "invalid_magic_link"},
+	))
+}
@@ -11,13 +11,17 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/nacl/box"
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
 )
@@ -55,11 +59,12 @@ type GatewayVerifyLoginOTTService interface {
 
 // Implementation of OTT verification service
 type gatewayVerifyLoginOTTServiceImpl struct {
-	config                *config.Configuration
-	logger                *zap.Logger
-	cache                 mongodbcache.Cacher
-	jwtProvider           jwt.Provider
-	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	cache                   mongodbcache.Cacher
+	jwtProvider             jwt.Provider
+	userGetByEmailUseCase   uc_user.FederatedUserGetByEmailUseCase
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
 }
 
 func NewGatewayVerifyLoginOTTService(
@@ -68,17 +73,22 @@ func NewGatewayVerifyLoginOTTService(
 	cache mongodbcache.Cacher,
 	jwtProvider jwt.Provider,
 	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
 ) GatewayVerifyLoginOTTService {
 	return &gatewayVerifyLoginOTTServiceImpl{
-		config:                config,
-		logger:                logger,
-		cache:                 cache,
-		jwtProvider:           jwtProvider,
-		userGetByEmailUseCase: userGetByEmailUseCase,
+		config:                  config,
+		logger:                  logger,
+		cache:                   cache,
+		jwtProvider:             jwtProvider,
+		userGetByEmailUseCase:   userGetByEmailUseCase,
+		auditEventCreateUseCase: auditEventCreateUseCase,
 	}
 }
 
 func (s *gatewayVerifyLoginOTTServiceImpl) Execute(sessCtx context.Context, req *GatewayVerifyLoginOTTRequestIDO) (*GatewayVerifyLoginOTTResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayVerifyLoginOTTService.Execute")
+	defer span.End()
+
 	// Validate input
 	e := make(map[string]string)
 	if req.Email == "" {
@@ -97,53 +107,68 @@ func (s *gatewayVerifyLoginOTTServiceImpl) Execute(sessCtx context.Context, req
 	req.OTT = strings.TrimSpace(req.OTT)
 
 	// Retrieve OTT data from cache
-	cacheKey := fmt.Sprintf("login_ott:%s", req.Email)
+	cacheKey := loginOTTCacheKey(req.Email)
 	ottDataJSON, err := s.cache.Get(sessCtx, cacheKey)
 	if err != nil {
 		s.logger.Error("Failed to retrieve OTT data", zap.Error(err))
-		return nil, httperror.NewForBadRequestWithSingleField("ott", "Invalid or expired verification code")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("ott", "Invalid or expired verification code"), httperror.CodeInvalidVerificationCode)
 	}
 
 	if ottDataJSON == nil {
 		s.logger.Error("OTT data not found in cache")
-		return nil, httperror.NewForBadRequestWithSingleField("ott", "Invalid or expired verification code")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("ott", "Invalid or expired verification code"), httperror.CodeInvalidVerificationCode)
 	}
 
 	// Unmarshal the data from JSON
 	var ottData LoginOTTData
 	if err := json.Unmarshal(ottDataJSON, &ottData); err != nil {
 		s.logger.Error("Failed to unmarshal OTT data", zap.Error(err))
-		return nil, httperror.NewForBadRequestWithSingleField("ott", "Invalid verification code")
-	}
-
-	// Verify OTT
-	if ottData.OTT != req.OTT {
-		return nil, httperror.NewForBadRequestWithSingleField("ott", "Invalid verification code")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("ott", "Invalid verification code"), httperror.CodeInvalidVerificationCode)
 	}
 
 	// Check expiry
 	if time.Now().After(ottData.ExpiresAt) {
-		return nil, httperror.NewForBadRequestWithSingleField("ott", "Verification code has expired")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("ott", "Verification code has expired"), httperror.CodeVerificationCodeExpired)
 	}
 
 	// Check if already verified
 	if ottData.IsVerified {
-		return nil, httperror.NewForBadRequestWithSingleField("ott", "Verification code has already been used")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("ott", "Verification code has already been used"), httperror.CodeVerificationCodeUsed)
 	}
 
+	// Verify OTT
+	if !isOTTMatch(ottData.OTT, req.OTT) {
+		s.recordOTTFailure(sessCtx, req.Email)
+		return nil, recordOTTFailedAttempt(sessCtx, s.cache, s.logger, &ottData, s.config.App.LoginOTTMaxAttempts)
+	}
+
+	return issueLoginChallenge(sessCtx, s.cache, s.logger, s.userGetByEmailUseCase, &ottData)
+}
+
+// issueLoginChallenge marks the OTT as used and returns the encrypted
+// challenge the client must decrypt to complete the login.
+func issueLoginChallenge(
+	sessCtx context.Context,
+	cache mongodbcache.Cacher,
+	logger *zap.Logger,
+	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase,
+	ottData *LoginOTTData,
+) (*GatewayVerifyLoginOTTResponseIDO, error) {
+	cacheKey := loginOTTCacheKey(ottData.Email)
+
 	// Get user from database
-	user, err := s.userGetByEmailUseCase.Execute(sessCtx, req.Email)
+	user, err := userGetByEmailUseCase.Execute(sessCtx, ottData.Email)
 	if err != nil {
 		return nil, err
 	}
 	if user == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("email", "Email address does not exist")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "Email address does not exist"), httperror.CodeEmailNotFound)
 	}
 
 	// Generate a challenge for final verification
 	challenge := make([]byte, 32)
 	if _, err := rand.Read(challenge); err != nil {
-		s.logger.Error("Failed to generate challenge", zap.Error(err))
+		logger.Error("Failed to generate challenge", zap.Error(err))
 		return nil, fmt.Errorf("failed to process login: %w", err)
 	}
 
@@ -155,7 +180,7 @@ func (s *gatewayVerifyLoginOTTServiceImpl) Execute(sessCtx context.Context, req
 
 	// Store challenge in cache
 	challengeData := ChallengeData{
-		Email:           req.Email,
+		Email:           ottData.Email,
 		ChallengeID:     challengeID,
 		Challenge:       challengeBase64,
 		CreatedAt:       time.Now(),
@@ -170,13 +195,13 @@ func (s *gatewayVerifyLoginOTTServiceImpl) Execute(sessCtx context.Context, req
 	// Marshal the challenge data to JSON
 	challengeDataJSON, err := json.Marshal(challengeData)
 	if err != nil {
-		s.logger.Error("Failed to marshal challenge data", zap.Error(err))
+		logger.Error("Failed to marshal challenge data", zap.Error(err))
 		return nil, fmt.Errorf("failed to process login verification: %w", err)
 	}
 
 	// Store in cache with expiry
-	if err := s.cache.SetWithExpiry(sessCtx, challengeCacheKey, challengeDataJSON, 5*time.Minute); err != nil {
-		s.logger.Error("Failed to store challenge in cache", zap.Error(err))
+	if err := cache.SetWithExpiry(sessCtx, challengeCacheKey, challengeDataJSON, 5*time.Minute); err != nil {
+		logger.Error("Failed to store challenge in cache", zap.Error(err))
 		return nil, fmt.Errorf("failed to process login verification: %w", err)
 	}
 
@@ -187,18 +212,18 @@ func (s *gatewayVerifyLoginOTTServiceImpl) Execute(sessCtx context.Context, req
 	// Marshal the updated OTT data to JSON
 	updatedOTTDataJSON, err := json.Marshal(ottData)
 	if err != nil {
-		s.logger.Error("Failed to marshal updated OTT data", zap.Error(err))
+		logger.Error("Failed to marshal updated OTT data", zap.Error(err))
 		// Continue anyway, as the challenge is already stored
 	} else {
-		if err := s.cache.SetWithExpiry(sessCtx, cacheKey, updatedOTTDataJSON, 10*time.Minute); err != nil {
-			s.logger.Error("Failed to update OTT in cache", zap.Error(err))
+		if err := cache.SetWithExpiry(sessCtx, cacheKey, updatedOTTDataJSON, 10*time.Minute); err != nil {
+			logger.Error("Failed to update OTT in cache", zap.Error(err))
 			// Continue anyway, as the challenge is already stored
 		}
 	}
 
 	encryptedChallenge, err := getEncryptedChallenge(challenge, user)
 	if err != nil {
-		s.logger.Error("Failed to encrypt challenge", zap.Error(err))
+		logger.Error("Failed to encrypt challenge", zap.Error(err))
 		return nil, fmt.Errorf("failed to process login: %w", err)
 	}
 
@@ -253,3 +278,18 @@ func getEncryptedChallenge(challenge []byte, user *domain.FederatedUser) (string
 	// Return base64 encoded result
 	return base64.StdEncoding.EncodeToString(result), nil
 }
+
+// recordOTTFailure records a failed one-time token attempt against the
+// account it was issued for.
+func (s *gatewayVerifyLoginOTTServiceImpl) recordOTTFailure(ctx context.Context, email string) {
+	userID := primitive.NilObjectID
+	if user, err := s.userGetByEmailUseCase.Execute(ctx, email); err == nil && user != nil {
+		userID = user.ID
+	}
+	recordAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, loginAuditEvent(
+		dom_auditevent.AuditEventTypeLoginOTTFailed,
+		dom_auditevent.AuditEventOutcomeFailure,
+		userID,
+		map[string]string{"email": email},
+	))
+}
@@ -0,0 +1,212 @@
+// cloud/backend/internal/iam/service/gateway/verifyrecovery.go
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// Data structures for verifying the account recovery code which was emailed
+// by the forgot password flow
+type GatewayVerifyRecoveryRequestIDO struct {
+	Email string `json:"email"`
+	Code  string `json:"code"`
+}
+
+// GatewayVerifyRecoveryResponseIDO returns the master key wrapped with the
+// recovery key so the client can unwrap it locally, along with a challenge
+// proving the client recovered the private key.
+type GatewayVerifyRecoveryResponseIDO struct {
+	MasterKeyEncryptedWithRecoveryKey string `json:"masterKeyEncryptedWithRecoveryKey"`
+	EncryptedPrivateKey               string `json:"encryptedPrivateKey"`
+	EncryptedChallenge                string `json:"encryptedChallenge"`
+	RecoveryID                        string `json:"recoveryId"`
+}
+
+// Service interface for verifying the account recovery code
+type GatewayVerifyRecoveryService interface {
+	Execute(sessCtx context.Context, req *GatewayVerifyRecoveryRequestIDO) (*GatewayVerifyRecoveryResponseIDO, error)
+}
+
+// Implementation of verify recovery service
+type gatewayVerifyRecoveryServiceImpl struct {
+	config                *config.Configuration
+	logger                *zap.Logger
+	cache                 mongodbcache.Cacher
+	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase
+	userUpdateUseCase     uc_user.FederatedUserUpdateUseCase
+}
+
+func NewGatewayVerifyRecoveryService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase,
+	userUpdateUseCase uc_user.FederatedUserUpdateUseCase,
+) GatewayVerifyRecoveryService {
+	return &gatewayVerifyRecoveryServiceImpl{
+		config:                config,
+		logger:                logger,
+		cache:                 cache,
+		userGetByEmailUseCase: userGetByEmailUseCase,
+		userUpdateUseCase:     userUpdateUseCase,
+	}
+}
+
+func (s *gatewayVerifyRecoveryServiceImpl) Execute(sessCtx context.Context, req *GatewayVerifyRecoveryRequestIDO) (*GatewayVerifyRecoveryResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GatewayVerifyRecoveryService.Execute")
+	defer span.End()
+
+	// Sanitize input
+	req.Email = strings.ToLower(req.Email)
+	req.Email = strings.ReplaceAll(req.Email, " ", "")
+	req.Code = strings.TrimSpace(req.Code)
+
+	// Validate input
+	e := make(map[string]string)
+	if req.Email == "" {
+		e["email"] = "Email address is required"
+	}
+	if req.Code == "" {
+		e["code"] = "Verification code is required"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	// Get user from database
+	user, err := s.userGetByEmailUseCase.Execute(sessCtx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "Email address does not exist"), httperror.CodeEmailNotFound)
+	}
+
+	// Verify the emailed code proving ownership of the address
+	if user.PasswordResetVerificationCode == "" {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("code", "Verification code is incorrect"), httperror.CodeInvalidVerificationCode)
+	}
+	failedAttempts := s.failedAttempts(sessCtx, user)
+	if s.config.App.LoginOTTMaxAttempts > 0 && failedAttempts >= s.config.App.LoginOTTMaxAttempts {
+		return nil, httperror.WithCode(httperror.NewForSingleField(http.StatusTooManyRequests, "code", "Too many failed attempts, please request a new verification code"), httperror.CodeVerificationAttemptsExceeded)
+	}
+	if !isOTTMatch(user.PasswordResetVerificationCode, req.Code) {
+		return nil, s.recordFailedAttempt(sessCtx, user, failedAttempts+1)
+	}
+	if time.Now().After(user.PasswordResetVerificationExpiry) {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("code", "Verification code has expired"), httperror.CodeVerificationCodeExpired)
+	}
+	if user.MasterKeyEncryptedWithRecoveryKey == "" {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "Account has no recovery key"), httperror.CodeNoRecoveryKey)
+	}
+
+	// The code is single use
+	user.PasswordResetVerificationCode = ""
+	user.PasswordResetVerificationExpiry = time.Time{} // This is equivalent to not-set time
+	user.ModifiedAt = time.Now()
+	if err := s.userUpdateUseCase.Execute(sessCtx, user); err != nil {
+		return nil, err
+	}
+	if err := s.cache.Delete(sessCtx, passwordResetFailedAttemptsCacheKey(user.ID.Hex())); err != nil {
+		return nil, err
+	}
+
+	// Generate a challenge for the final step of the recovery
+	challenge := make([]byte, 32)
+	if _, err := rand.Read(challenge); err != nil {
+		s.logger.Error("Failed to generate challenge", zap.Error(err))
+		return nil, fmt.Errorf("failed to process recovery: %w", err)
+	}
+
+	recoveryID := uuid.New().String()
+	challengeData := ChallengeData{
+		Email:           user.Email,
+		ChallengeID:     recoveryID,
+		Challenge:       base64.StdEncoding.EncodeToString(challenge),
+		CreatedAt:       time.Now(),
+		ExpiresAt:       time.Now().Add(10 * time.Minute), // Recovery valid for 10 minutes
+		IsVerified:      false,
+		FederatedUserID: user.ID.Hex(),
+	}
+
+	challengeDataJSON, err := json.Marshal(challengeData)
+	if err != nil {
+		s.logger.Error("Failed to marshal challenge data", zap.Error(err))
+		return nil, fmt.Errorf("failed to process recovery: %w", err)
+	}
+
+	recoveryCacheKey := fmt.Sprintf("account_recovery:%s", recoveryID)
+	if err := s.cache.SetWithExpiry(sessCtx, recoveryCacheKey, challengeDataJSON, 10*time.Minute); err != nil {
+		s.logger.Error("Failed to store recovery challenge in cache", zap.Error(err))
+		return nil, fmt.Errorf("failed to process recovery: %w", err)
+	}
+
+	encryptedChallenge, err := getEncryptedChallenge(challenge, user)
+	if err != nil {
+		s.logger.Error("Failed to encrypt challenge", zap.Error(err))
+		return nil, fmt.Errorf("failed to process recovery: %w", err)
+	}
+
+	return &GatewayVerifyRecoveryResponseIDO{
+		MasterKeyEncryptedWithRecoveryKey: user.MasterKeyEncryptedWithRecoveryKey,
+		EncryptedPrivateKey:               user.EncryptedPrivateKey,
+		EncryptedChallenge:                encryptedChallenge,
+		RecoveryID:                        recoveryID,
+	}, nil
+}
+
+func passwordResetFailedAttemptsCacheKey(userID string) string {
+	return fmt.Sprintf("password_reset_failed_attempts:%s", userID)
+}
+
+// failedAttempts returns the number of wrong codes sent for the current
+// recovery code of `user`.
+func (s *gatewayVerifyRecoveryServiceImpl) failedAttempts(ctx context.Context, user *domain.FederatedUser) int {
+	val, err := s.cache.Get(ctx, passwordResetFailedAttemptsCacheKey(user.ID.Hex()))
+	if err != nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(string(val))
+	return n
+}
+
+// recordFailedAttempt counts a wrong code against the user. The count is
+// kept in the cache, which is not part of the database transaction, as the
+// transaction is aborted by the returned error. Like the login codes, every
+// code is rejected once `LoginOTTMaxAttempts` is reached so the code cannot
+// be guessed from many addresses within its expiry, until a new one is
+// requested.
+func (s *gatewayVerifyRecoveryServiceImpl) recordFailedAttempt(ctx context.Context, user *domain.FederatedUser, failedAttempts int) error {
+	if ttl := time.Until(user.PasswordResetVerificationExpiry); ttl > 0 {
+		cacheKey := passwordResetFailedAttemptsCacheKey(user.ID.Hex())
+		if err := s.cache.SetWithExpiry(ctx, cacheKey, []byte(strconv.Itoa(failedAttempts)), ttl); err != nil {
+			return err
+		}
+	}
+
+	if s.config.App.LoginOTTMaxAttempts > 0 && failedAttempts >= s.config.App.LoginOTTMaxAttempts {
+		s.logger.Warn("recovery code invalidated after too many failed attempts",
+			zap.String("user_id", user.ID.Hex()),
+			zap.Int("failed_attempts", failedAttempts))
+		return httperror.WithCode(httperror.NewForSingleField(http.StatusTooManyRequests, "code", "Too many failed attempts, please request a new verification code"), httperror.CodeVerificationAttemptsExceeded)
+	}
+	return httperror.WithCode(httperror.NewForBadRequestWithSingleField("code", "Verification code is incorrect"), httperror.CodeInvalidVerificationCode)
+}
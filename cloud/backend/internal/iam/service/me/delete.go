@@ -14,6 +14,7 @@ import (
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/password"
 	sstring "github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/securestring"
 )
@@ -51,6 +52,9 @@ func NewDeleteMeService(
 }
 
 func (svc *deleteMeServiceImpl) Execute(sessCtx context.Context, req *DeleteMeRequestDTO) error {
+	sessCtx, span := tracing.Start(sessCtx, "DeleteMeService.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation
 	//
@@ -86,7 +90,7 @@ func (svc *deleteMeServiceImpl) Execute(sessCtx context.Context, req *DeleteMeRe
 	if sessionFederatedUserRole == dom_user.FederatedUserRoleRoot {
 		svc.logger.Warn("admin is not allowed to delete themselves",
 			zap.Any("error", ""))
-		return httperror.NewForForbiddenWithSingleField("message", "admins do not have permission to delete themselves")
+		return httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "admins do not have permission to delete themselves"), httperror.CodePermissionDenied)
 	}
 
 	//
@@ -118,7 +122,7 @@ func (svc *deleteMeServiceImpl) Execute(sessCtx context.Context, req *DeleteMeRe
 	// passwordMatch, _ := svc.passwordProvider.ComparePasswordAndHash(securePassword, federateduser.PasswordHash)
 	// if !passwordMatch {
 	// 	svc.logger.Warn("Password verification failed")
-	// 	return httperror.NewForBadRequestWithSingleField("password", "Incorrect password")
+	// 	return httperror.WithCode(httperror.NewForBadRequestWithSingleField("password", "Incorrect password"), httperror.CodeIncorrectPassword)
 	// }
 
 	//
@@ -14,6 +14,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type MeResponseDTO struct {
@@ -109,6 +110,9 @@ func NewGetMeService(
 }
 
 func (svc *getMeServiceImpl) Execute(sessCtx context.Context) (*MeResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GetMeService.Execute")
+	defer span.End()
+
 	//
 	// Get required from context.
 	//
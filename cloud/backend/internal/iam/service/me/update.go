@@ -14,8 +14,11 @@ import (
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/consentrecord"
+	uc_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/consentrecord"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type UpdateMeRequestDTO struct {
@@ -40,6 +43,8 @@ type updateMeServiceImpl struct {
 	userGetByIDUseCase    uc_user.FederatedUserGetByIDUseCase
 	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase
 	userUpdateUseCase     uc_user.FederatedUserUpdateUseCase
+
+	consentRecordCreateUseCase uc_consentrecord.ConsentRecordCreateUseCase
 }
 
 func NewUpdateMeService(
@@ -48,6 +53,7 @@ func NewUpdateMeService(
 	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
 	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase,
 	userUpdateUseCase uc_user.FederatedUserUpdateUseCase,
+	consentRecordCreateUseCase uc_consentrecord.ConsentRecordCreateUseCase,
 ) UpdateMeService {
 	return &updateMeServiceImpl{
 		config:                config,
@@ -55,10 +61,15 @@ func NewUpdateMeService(
 		userGetByIDUseCase:    userGetByIDUseCase,
 		userGetByEmailUseCase: userGetByEmailUseCase,
 		userUpdateUseCase:     userUpdateUseCase,
+
+		consentRecordCreateUseCase: consentRecordCreateUseCase,
 	}
 }
 
 func (svc *updateMeServiceImpl) Execute(sessCtx context.Context, req *UpdateMeRequestDTO) (*MeResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "UpdateMeService.Execute")
+	defer span.End()
+
 	//
 	// Get required from context.
 	//
@@ -76,7 +87,7 @@ func (svc *updateMeServiceImpl) Execute(sessCtx context.Context, req *UpdateMeRe
 
 	if req == nil {
 		svc.logger.Warn("Failed validation with nothing received")
-		return nil, httperror.NewForBadRequestWithSingleField("non_field_error", "Request is required in submission")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("non_field_error", "Request is required in submission"), httperror.CodeMalformedRequest)
 	}
 
 	// Sanitization
@@ -172,6 +183,15 @@ func (svc *updateMeServiceImpl) Execute(sessCtx context.Context, req *UpdateMeRe
 	federateduser.Country = req.Country
 	federateduser.Region = req.Region
 	federateduser.Timezone = req.Timezone
+
+	// Keep track of consent changes so we can append them to the ledger.
+	changed := make(map[string]bool)
+	if federateduser.AgreePromotions != req.AgreePromotions {
+		changed[dom_consentrecord.ConsentTypePromotions] = req.AgreePromotions
+	}
+	if federateduser.AgreeToTrackingAcrossThirdPartyAppsAndServices != req.AgreeToTrackingAcrossThirdPartyAppsAndServices {
+		changed[dom_consentrecord.ConsentTypeTracking] = req.AgreeToTrackingAcrossThirdPartyAppsAndServices
+	}
 	federateduser.AgreePromotions = req.AgreePromotions
 	federateduser.AgreeToTrackingAcrossThirdPartyAppsAndServices = req.AgreeToTrackingAcrossThirdPartyAppsAndServices
 
@@ -182,6 +202,19 @@ func (svc *updateMeServiceImpl) Execute(sessCtx context.Context, req *UpdateMeRe
 		return nil, err
 	}
 
+	for consentType, granted := range changed {
+		record := &dom_consentrecord.ConsentRecord{
+			FederatedUserID: federateduser.ID,
+			Type:            consentType,
+			DocumentVersion: federateduser.AgreeTermsOfServiceVersion,
+			Granted:         granted,
+		}
+		if err := svc.consentRecordCreateUseCase.Execute(sessCtx, record); err != nil {
+			svc.logger.Error("Failed recording consent", zap.Any("error", err), zap.String("user_id", federateduser.ID.Hex()))
+			return nil, err
+		}
+	}
+
 	svc.logger.Debug("FederatedUser updated successfully",
 		zap.String("user_id", federateduser.ID.Hex()))
 
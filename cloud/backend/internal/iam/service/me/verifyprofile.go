@@ -14,6 +14,7 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type VerifyProfileRequestDTO struct {
@@ -99,6 +100,9 @@ func (s *verifyProfileServiceImpl) Execute(
 	sessCtx context.Context,
 	req *VerifyProfileRequestDTO,
 ) (*VerifyProfileResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "VerifyProfileService.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Get required from context.
 	//
@@ -119,7 +123,7 @@ func (s *verifyProfileServiceImpl) Execute(
 	}
 	if federateduser == nil {
 		s.logger.Error("FederatedUser not found", zap.Any("userID", userID))
-		return nil, httperror.NewForBadRequestWithSingleField("non_field_error", "FederatedUser not found")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("non_field_error", "FederatedUser not found"), httperror.CodeUserNotFound)
 	}
 
 	// Check if we need to override the federateduser role based on the request
@@ -4,7 +4,13 @@ package service
 import (
 	"go.uber.org/fx"
 
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/admin"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/consent"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/oauth2"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/securityevent"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/token"
 )
 
@@ -18,13 +24,60 @@ func Module() fx.Option {
 			// Add the new E2EE login services
 			gateway.NewGatewayRequestLoginOTTService,
 			gateway.NewGatewayVerifyLoginOTTService,
+			gateway.NewGatewayVerifyLoginMagicLinkService,
 			gateway.NewGatewayCompleteLoginService,
 			// Other services
 			gateway.NewGatewayLogoutService,
 			// gateway.NewGatewaySendVerifyEmailService,
 			gateway.NewGatewayRefreshTokenService,
-			// gateway.NewGatewayResetPasswordService,
-			// gateway.NewGatewayForgotPasswordService,
+			gateway.NewGatewayChangePasswordChallengeService,
+			gateway.NewGatewayChangePasswordService,
+			gateway.NewGatewayForgotPasswordService,
+			gateway.NewGatewayVerifyRecoveryService,
+			gateway.NewGatewayResetPasswordService,
+			gateway.NewGatewayRequestEmailChangeService,
+			gateway.NewGatewayVerifyEmailChangeService,
+			gateway.NewGatewayCancelEmailChangeService,
+			gateway.NewGatewayDisownLoginService,
+			apikey.NewCreateAPIKeyService,
+			apikey.NewListAPIKeysService,
+			apikey.NewRevokeAPIKeyService,
+			admin.NewListFederatedUsersService,
+			admin.NewGetFederatedUserService,
+			admin.NewChangeFederatedUserStatusService,
+			admin.NewChangeFederatedUserRoleService,
+			admin.NewForceLogoutFederatedUserService,
+			admin.NewResendFederatedUserVerificationService,
+			admin.NewCreateInviteService,
+			admin.NewListInvitesService,
+			admin.NewCreateOAuthClientService,
+			admin.NewListOAuthClientsService,
+			admin.NewDeleteOAuthClientService,
+			admin.NewCreateBannedIPAddressService,
+			admin.NewListBannedIPAddressesService,
+			admin.NewDeleteBannedIPAddressService,
+			oauth2.NewOAuth2DiscoveryService,
+			oauth2.NewOAuth2AuthorizeService,
+			oauth2.NewOAuth2GetAuthorizationRequestService,
+			oauth2.NewOAuth2CompleteAuthorizationService,
+			oauth2.NewOAuth2TokenService,
+			oauth2.NewOAuth2UserInfoService,
+			organization.NewCreateOrganizationService,
+			organization.NewListMyOrganizationsService,
+			organization.NewGetOrganizationService,
+			organization.NewUpdateOrganizationService,
+			organization.NewListOrganizationMembersService,
+			organization.NewChangeOrganizationMemberRoleService,
+			organization.NewRemoveOrganizationMemberService,
+			organization.NewCreateOrganizationInvitationService,
+			organization.NewListOrganizationInvitationsService,
+			organization.NewRevokeOrganizationInvitationService,
+			organization.NewAcceptOrganizationInvitationService,
+			consent.NewGetMyConsentsService,
+			consent.NewAcceptTermsOfServiceService,
+			consent.NewWithdrawPromotionsConsentService,
+			securityevent.NewListMySecurityEventsService,
+			securityevent.NewListSecurityEventsService,
 			// me.NewGetMeService,
 			// me.NewUpdateMeService,
 			// me.NewVerifyProfileService,
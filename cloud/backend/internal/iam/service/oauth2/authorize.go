@@ -0,0 +1,141 @@
+package oauth2
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	uc_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/oidc"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// OAuth2AuthorizeRequestIDO holds the query parameters of the authorization
+// endpoint, see RFC 6749 section 4.1.1 and RFC 7636 section 4.3.
+type OAuth2AuthorizeRequestIDO struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+type OAuth2AuthorizeResponseIDO struct {
+	// RedirectURL is either our login page or, when the request was
+	// rejected, the client's redirect URI carrying the error.
+	RedirectURL string
+}
+
+// Service interface for the authorization endpoint
+type OAuth2AuthorizeService interface {
+	Execute(ctx context.Context, req *OAuth2AuthorizeRequestIDO) (*OAuth2AuthorizeResponseIDO, error)
+}
+
+// Implementation of the authorization endpoint service
+type oauth2AuthorizeServiceImpl struct {
+	config                          *config.Configuration
+	logger                          *zap.Logger
+	cache                           mongodbcache.Cacher
+	oauthClientGetByClientIDUseCase uc_oauthclient.OAuthClientGetByClientIDUseCase
+}
+
+func NewOAuth2AuthorizeService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	oauthClientGetByClientIDUseCase uc_oauthclient.OAuthClientGetByClientIDUseCase,
+) OAuth2AuthorizeService {
+	return &oauth2AuthorizeServiceImpl{
+		config:                          config,
+		logger:                          logger,
+		cache:                           cache,
+		oauthClientGetByClientIDUseCase: oauthClientGetByClientIDUseCase,
+	}
+}
+
+func (s *oauth2AuthorizeServiceImpl) Execute(ctx context.Context, req *OAuth2AuthorizeRequestIDO) (*OAuth2AuthorizeResponseIDO, error) {
+	ctx, span := tracing.Start(ctx, "Oauth2AuthorizeService.Execute")
+	defer span.End()
+
+	// An unknown client or redirect URI must never be redirected to, the
+	// error is shown to the user instead. See RFC 6749 section 4.1.2.1.
+	if req.ClientID == "" {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidRequest, "client_id is required")
+	}
+	client, err := s.oauthClientGetByClientIDUseCase.Execute(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidClient, "Unknown client")
+	}
+	if req.RedirectURI == "" || !client.HasRedirectURI(req.RedirectURI) {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidRequest, "redirect_uri is not registered for this client")
+	}
+
+	// From here on errors are reported to the client through its redirect URI.
+	redirectError := func(code, description string) *OAuth2AuthorizeResponseIDO {
+		return &OAuth2AuthorizeResponseIDO{
+			RedirectURL: redirectWithParams(req.RedirectURI, url.Values{
+				"error":             {code},
+				"error_description": {description},
+				"state":             {req.State},
+			}),
+		}
+	}
+
+	if req.ResponseType != "code" {
+		return redirectError(ErrorUnsupportedResponseType, "Only the authorization code flow is supported"), nil
+	}
+	scopes := strings.Fields(req.Scope)
+	if !hasScope(scopes, dom_oauthclient.ScopeOpenID) {
+		return redirectError(ErrorInvalidScope, "The openid scope is required"), nil
+	}
+	for _, scope := range scopes {
+		if !dom_oauthclient.IsValidScope(scope) || !client.HasScope(scope) {
+			return redirectError(ErrorInvalidScope, "Scope is not allowed: "+scope), nil
+		}
+	}
+	if req.CodeChallenge == "" {
+		return redirectError(ErrorInvalidRequest, "code_challenge is required"), nil
+	}
+	if req.CodeChallengeMethod != oidc.CodeChallengeMethodS256 {
+		return redirectError(ErrorInvalidRequest, "code_challenge_method must be S256"), nil
+	}
+
+	// Keep the request while the user logs in and consents on the frontend.
+	data := AuthorizationRequestData{
+		RequestID:           uuid.New().String(),
+		ClientID:            client.ClientID,
+		ClientName:          client.Name,
+		RedirectURI:         req.RedirectURI,
+		Scopes:              scopes,
+		State:               req.State,
+		Nonce:               req.Nonce,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		CreatedAt:           time.Now(),
+		ExpiresAt:           time.Now().Add(authorizationRequestExpiry),
+	}
+	if err := setCachedJSON(ctx, s.cache, authorizationRequestCacheKey(data.RequestID), data, authorizationRequestExpiry); err != nil {
+		s.logger.Error("Failed to store authorization request in cache", zap.Error(err))
+		return nil, err
+	}
+
+	return &OAuth2AuthorizeResponseIDO{
+		RedirectURL: redirectWithParams(s.config.OIDC.LoginURL, url.Values{
+			"request_id": {data.RequestID},
+		}),
+	}, nil
+}
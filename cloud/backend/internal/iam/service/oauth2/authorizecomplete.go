@@ -0,0 +1,224 @@
+package oauth2
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	sv_gateway "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/service/gateway"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodb"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// OAuth2CompleteAuthorizationRequestIDO finishes an authorization request.
+// The user proves their identity exactly like when logging in: the frontend
+// runs the one-time token flow (`request-ott` and `verify-ott`) and submits
+// the decrypted challenge here instead of to `complete-login`.
+type OAuth2CompleteAuthorizationRequestIDO struct {
+	RequestID     string `json:"requestId"`
+	Email         string `json:"email"`
+	ChallengeID   string `json:"challengeId"`
+	DecryptedData string `json:"decryptedData"`
+	Consent       bool   `json:"consent"`
+}
+
+type OAuth2CompleteAuthorizationResponseIDO struct {
+	RedirectURI string `json:"redirect_uri"`
+}
+
+// Service interface for completing an authorization request
+type OAuth2CompleteAuthorizationService interface {
+	Execute(sessCtx context.Context, req *OAuth2CompleteAuthorizationRequestIDO) (*OAuth2CompleteAuthorizationResponseIDO, error)
+}
+
+// Implementation of the complete authorization service
+type oauth2CompleteAuthorizationServiceImpl struct {
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	cache                   mongodbcache.Cacher
+	userGetByEmailUseCase   uc_user.FederatedUserGetByEmailUseCase
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewOAuth2CompleteAuthorizationService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) OAuth2CompleteAuthorizationService {
+	return &oauth2CompleteAuthorizationServiceImpl{
+		config:                  config,
+		logger:                  logger,
+		cache:                   cache,
+		userGetByEmailUseCase:   userGetByEmailUseCase,
+		auditEventCreateUseCase: auditEventCreateUseCase,
+	}
+}
+
+func (s *oauth2CompleteAuthorizationServiceImpl) Execute(sessCtx context.Context, req *OAuth2CompleteAuthorizationRequestIDO) (*OAuth2CompleteAuthorizationResponseIDO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "Oauth2CompleteAuthorizationService.Execute")
+	defer span.End()
+
+	// Validate input
+	e := make(map[string]string)
+	if req.RequestID == "" {
+		e["requestId"] = "Request ID is required"
+	}
+	if req.Consent {
+		if req.Email == "" {
+			e["email"] = "Email address is required"
+		}
+		if req.ChallengeID == "" {
+			e["challengeId"] = "Challenge ID is required"
+		}
+		if req.DecryptedData == "" {
+			e["decryptedData"] = "Decrypted data is required"
+		}
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	// Sanitize input
+	req.Email = strings.ToLower(req.Email)
+	req.Email = strings.ReplaceAll(req.Email, " ", "")
+
+	// Retrieve the pending authorization request
+	requestCacheKey := authorizationRequestCacheKey(req.RequestID)
+	var authReq AuthorizationRequestData
+	found, err := getCachedJSON(sessCtx, s.cache, requestCacheKey, &authReq)
+	if err != nil {
+		s.logger.Error("Failed to retrieve authorization request", zap.Error(err))
+	}
+	if !found || time.Now().After(authReq.ExpiresAt) {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("requestId", "Authorization request does not exist or has expired"), httperror.CodeAuthorizationRequestNotFound)
+	}
+
+	if !req.Consent {
+		// The request is single use whether it was approved or not.
+		s.deleteCacheKey(sessCtx, requestCacheKey)
+		return &OAuth2CompleteAuthorizationResponseIDO{
+			RedirectURI: redirectWithParams(authReq.RedirectURI, url.Values{
+				"error":             {ErrorAccessDenied},
+				"error_description": {"The user denied the request"},
+				"state":             {authReq.State},
+			}),
+		}, nil
+	}
+
+	// Verify the login challenge issued by `verify-ott`
+	challengeCacheKey := fmt.Sprintf("login_challenge:%s", req.ChallengeID)
+	var challengeData sv_gateway.ChallengeData
+	found, err = getCachedJSON(sessCtx, s.cache, challengeCacheKey, &challengeData)
+	if err != nil {
+		s.logger.Error("Failed to retrieve challenge data", zap.Error(err))
+	}
+	if !found {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Invalid or expired challenge"), httperror.CodeInvalidChallenge)
+	}
+	if challengeData.Email != req.Email {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "Email address does not match challenge"), httperror.CodeInvalidChallenge)
+	}
+	if time.Now().After(challengeData.ExpiresAt) {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Challenge has expired"), httperror.CodeChallengeExpired)
+	}
+	if challengeData.IsVerified {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("challengeId", "Challenge has already been used"), httperror.CodeChallengeUsed)
+	}
+	if challengeData.Challenge != req.DecryptedData {
+		s.logger.Warn("OAuth2 challenge verification failed",
+			zap.String("client_id", authReq.ClientID))
+		failedUserID, _ := primitive.ObjectIDFromHex(challengeData.FederatedUserID)
+		s.recordAuditEvent(sessCtx, &dom_auditevent.AuditEvent{
+			Type:          dom_auditevent.AuditEventTypeLoginChallengeFailed,
+			Outcome:       dom_auditevent.AuditEventOutcomeFailure,
+			ActorUserID:   failedUserID,
+			SubjectUserID: failedUserID,
+			Details:       map[string]string{"flow": "oauth2", "client_id": authReq.ClientID},
+		})
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("decryptedData", "Invalid challenge response"), httperror.CodeInvalidChallengeResponse)
+	}
+
+	// Both the challenge and the request are single use
+	s.deleteCacheKey(sessCtx, challengeCacheKey)
+	s.deleteCacheKey(sessCtx, requestCacheKey)
+
+	user, err := s.userGetByEmailUseCase.Execute(sessCtx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "Email address does not exist"), httperror.CodeEmailNotFound)
+	}
+	if isUserDisabled(user) {
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("email", "Account is disabled"), httperror.CodeAccountDisabled)
+	}
+
+	// Issue the authorization code
+	code, err := generateToken()
+	if err != nil {
+		s.logger.Error("Failed to generate authorization code", zap.Error(err))
+		return nil, err
+	}
+	codeData := AuthorizationCodeData{
+		ClientID:            authReq.ClientID,
+		RedirectURI:         authReq.RedirectURI,
+		FederatedUserID:     user.ID.Hex(),
+		Scopes:              authReq.Scopes,
+		Nonce:               authReq.Nonce,
+		CodeChallenge:       authReq.CodeChallenge,
+		CodeChallengeMethod: authReq.CodeChallengeMethod,
+		AuthTime:            time.Now(),
+		ExpiresAt:           time.Now().Add(authorizationCodeExpiry),
+	}
+	if err := setCachedJSON(sessCtx, s.cache, authorizationCodeCacheKey(code), codeData, authorizationCodeExpiry); err != nil {
+		s.logger.Error("Failed to store authorization code in cache", zap.Error(err))
+		return nil, err
+	}
+
+	s.recordAuditEvent(sessCtx, &dom_auditevent.AuditEvent{
+		Type:          dom_auditevent.AuditEventTypeOAuth2Authorized,
+		Outcome:       dom_auditevent.AuditEventOutcomeSuccess,
+		ActorUserID:   user.ID,
+		SubjectUserID: user.ID,
+		Details:       map[string]string{"client_id": authReq.ClientID, "scope": strings.Join(authReq.Scopes, " ")},
+	})
+
+	return &OAuth2CompleteAuthorizationResponseIDO{
+		RedirectURI: redirectWithParams(authReq.RedirectURI, url.Values{
+			"code":  {code},
+			"state": {authReq.State},
+		}),
+	}, nil
+}
+
+func (s *oauth2CompleteAuthorizationServiceImpl) deleteCacheKey(ctx context.Context, key string) {
+	if err := s.cache.Delete(ctx, key); err != nil {
+		s.logger.Warn("Failed to delete from cache", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// recordAuditEvent appends the event to the audit log. The failures are
+// recorded outside of the transaction, which the error returned next aborts.
+func (s *oauth2CompleteAuthorizationServiceImpl) recordAuditEvent(ctx context.Context, event *dom_auditevent.AuditEvent) {
+	if event.Outcome == dom_auditevent.AuditEventOutcomeFailure {
+		ctx = mongodb.WithoutSession(ctx)
+	}
+	if err := s.auditEventCreateUseCase.Execute(ctx, event); err != nil {
+		s.logger.Error("Failed to record audit event",
+			zap.String("type", event.Type),
+			zap.Error(err))
+	}
+}
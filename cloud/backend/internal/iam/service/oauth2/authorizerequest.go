@@ -0,0 +1,69 @@
+package oauth2
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// OAuth2AuthorizationRequestResponseIDO is what the frontend needs to show
+// the consent screen.
+type OAuth2AuthorizationRequestResponseIDO struct {
+	RequestID  string    `json:"request_id"`
+	ClientID   string    `json:"client_id"`
+	ClientName string    `json:"client_name"`
+	Scopes     []string  `json:"scopes"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// Service interface for looking up a pending authorization request
+type OAuth2GetAuthorizationRequestService interface {
+	Execute(ctx context.Context, requestID string) (*OAuth2AuthorizationRequestResponseIDO, error)
+}
+
+// Implementation of the pending authorization request lookup service
+type oauth2GetAuthorizationRequestServiceImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	cache  mongodbcache.Cacher
+}
+
+func NewOAuth2GetAuthorizationRequestService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+) OAuth2GetAuthorizationRequestService {
+	return &oauth2GetAuthorizationRequestServiceImpl{
+		config: config,
+		logger: logger,
+		cache:  cache,
+	}
+}
+
+func (s *oauth2GetAuthorizationRequestServiceImpl) Execute(ctx context.Context, requestID string) (*OAuth2AuthorizationRequestResponseIDO, error) {
+	ctx, span := tracing.Start(ctx, "Oauth2GetAuthorizationRequestService.Execute")
+	defer span.End()
+
+	var data AuthorizationRequestData
+	found, err := getCachedJSON(ctx, s.cache, authorizationRequestCacheKey(requestID), &data)
+	if err != nil {
+		s.logger.Error("Failed to retrieve authorization request", zap.Error(err))
+	}
+	if !found || time.Now().After(data.ExpiresAt) {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("request_id", "Authorization request does not exist or has expired"), httperror.CodeAuthorizationRequestNotFound)
+	}
+
+	return &OAuth2AuthorizationRequestResponseIDO{
+		RequestID:  data.RequestID,
+		ClientID:   data.ClientID,
+		ClientName: data.ClientName,
+		Scopes:     data.Scopes,
+		ExpiresAt:  data.ExpiresAt,
+	}, nil
+}
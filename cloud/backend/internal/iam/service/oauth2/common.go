@@ -0,0 +1,191 @@
+package oauth2
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+const (
+	authorizationRequestExpiry = 10 * time.Minute
+	authorizationCodeExpiry    = 1 * time.Minute
+	accessTokenExpiry          = 1 * time.Hour
+	idTokenExpiry              = 1 * time.Hour
+)
+
+// Error codes defined by RFC 6749 section 4.1.2.1 and 5.2 and RFC 6750
+// section 3.1.
+const (
+	ErrorInvalidRequest          = "invalid_request"
+	ErrorInvalidClient           = "invalid_client"
+	ErrorInvalidGrant            = "invalid_grant"
+	ErrorInvalidScope            = "invalid_scope"
+	ErrorInvalidToken            = "invalid_token"
+	ErrorAccessDenied            = "access_denied"
+	ErrorUnsupportedGrantType    = "unsupported_grant_type"
+	ErrorUnsupportedResponseType = "unsupported_response_type"
+)
+
+// OAuthError is returned by the services when the error must be reported in
+// the format OAuth 2.0 clients expect instead of our usual error map.
+type OAuthError struct {
+	StatusCode  int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *OAuthError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
+
+func newOAuthError(statusCode int, code, description string) *OAuthError {
+	return &OAuthError{StatusCode: statusCode, Code: code, Description: description}
+}
+
+// AuthorizationRequestData is the validated authorization request kept in
+// the cache under `oauth2_authorize_request:<id>` while the user logs in and
+// gives consent on the frontend.
+type AuthorizationRequestData struct {
+	RequestID           string    `json:"request_id"`
+	ClientID            string    `json:"client_id"`
+	ClientName          string    `json:"client_name"`
+	RedirectURI         string    `json:"redirect_uri"`
+	Scopes              []string  `json:"scopes"`
+	State               string    `json:"state"`
+	Nonce               string    `json:"nonce"`
+	CodeChallenge       string    `json:"code_challenge"`
+	CodeChallengeMethod string    `json:"code_challenge_method"`
+	CreatedAt           time.Time `json:"created_at"`
+	ExpiresAt           time.Time `json:"expires_at"`
+}
+
+// AuthorizationCodeData is kept in the cache under the hash of the issued
+// code until the client exchanges it at the token endpoint.
+type AuthorizationCodeData struct {
+	ClientID            string    `json:"client_id"`
+	RedirectURI         string    `json:"redirect_uri"`
+	FederatedUserID     string    `json:"federated_user_id"`
+	Scopes              []string  `json:"scopes"`
+	Nonce               string    `json:"nonce"`
+	CodeChallenge       string    `json:"code_challenge"`
+	CodeChallengeMethod string    `json:"code_challenge_method"`
+	AuthTime            time.Time `json:"auth_time"`
+	ExpiresAt           time.Time `json:"expires_at"`
+}
+
+// AccessTokenData is kept in the cache under the hash of the issued access
+// token. The cache key is indexed as one of the user's sessions so revoking
+// the user's sessions also revokes the tokens given to relying parties.
+type AccessTokenData struct {
+	ClientID        string    `json:"client_id"`
+	FederatedUserID string    `json:"federated_user_id"`
+	Scopes          []string  `json:"scopes"`
+	ExpiresAt       time.Time `json:"expires_at"`
+}
+
+func authorizationRequestCacheKey(requestID string) string {
+	return fmt.Sprintf("oauth2_authorize_request:%s", requestID)
+}
+
+func authorizationCodeCacheKey(code string) string {
+	return fmt.Sprintf("oauth2_code:%s", hashToken(code))
+}
+
+func accessTokenCacheKey(accessToken string) string {
+	return fmt.Sprintf("oauth2_access_token:%s", hashToken(accessToken))
+}
+
+// hashToken is used so the cache never holds usable codes or tokens.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func getCachedJSON(ctx context.Context, cache mongodbcache.Cacher, key string, v interface{}) (bool, error) {
+	data, err := cache.Get(ctx, key)
+	if err != nil || data == nil {
+		return false, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func setCachedJSON(ctx context.Context, cache mongodbcache.Cacher, key string, v interface{}, expiry time.Duration) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return cache.SetWithExpiry(ctx, key, data, expiry)
+}
+
+// redirectWithParams appends `params` to the client's redirect URI.
+func redirectWithParams(redirectURI string, params url.Values) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+	q := u.Query()
+	for k, v := range params {
+		for _, vv := range v {
+			if vv != "" {
+				q.Add(k, vv)
+			}
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// userClaims returns the standard claims about `user` which were granted by
+// `scopes`, see OpenID Connect Core 1.0 section 5.4.
+func userClaims(user *dom_user.FederatedUser, scopes []string) map[string]interface{} {
+	claims := map[string]interface{}{
+		"sub": user.ID.Hex(),
+	}
+	if hasScope(scopes, dom_oauthclient.ScopeProfile) {
+		claims["name"] = user.Name
+		claims["given_name"] = user.FirstName
+		claims["family_name"] = user.LastName
+		claims["zoneinfo"] = user.Timezone
+		claims["updated_at"] = user.ModifiedAt.Unix()
+	}
+	if hasScope(scopes, dom_oauthclient.ScopeEmail) {
+		claims["email"] = user.Email
+		claims["email_verified"] = user.WasEmailVerified
+	}
+	return claims
+}
+
+// isUserDisabled returns true if the user may not sign in to other apps.
+func isUserDisabled(user *dom_user.FederatedUser) bool {
+	return user.Status == dom_user.FederatedUserStatusLocked || user.Status == dom_user.FederatedUserStatusArchived
+}
@@ -0,0 +1,75 @@
+package oauth2
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/oidc"
+)
+
+// OAuth2DiscoveryResponseIDO is the provider metadata document, see OpenID
+// Connect Discovery 1.0 section 3.
+type OAuth2DiscoveryResponseIDO struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+// Service interface for the discovery and JWKS documents
+type OAuth2DiscoveryService interface {
+	Configuration() *OAuth2DiscoveryResponseIDO
+	JWKS() *oidc.JSONWebKeySet
+}
+
+// Implementation of the discovery service
+type oauth2DiscoveryServiceImpl struct {
+	config   *config.Configuration
+	logger   *zap.Logger
+	provider oidc.Provider
+}
+
+func NewOAuth2DiscoveryService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	provider oidc.Provider,
+) OAuth2DiscoveryService {
+	return &oauth2DiscoveryServiceImpl{
+		config:   config,
+		logger:   logger,
+		provider: provider,
+	}
+}
+
+func (s *oauth2DiscoveryServiceImpl) Configuration() *OAuth2DiscoveryResponseIDO {
+	issuer := s.provider.Issuer()
+	return &OAuth2DiscoveryResponseIDO{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/iam/api/v1/oauth2/authorize",
+		TokenEndpoint:                     issuer + "/iam/api/v1/oauth2/token",
+		UserInfoEndpoint:                  issuer + "/iam/api/v1/oauth2/userinfo",
+		JWKSURI:                           issuer + "/iam/api/v1/oauth2/jwks",
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{GrantTypeAuthorizationCode},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{"RS256"},
+		ScopesSupported:                   []string{dom_oauthclient.ScopeOpenID, dom_oauthclient.ScopeProfile, dom_oauthclient.ScopeEmail},
+		ClaimsSupported:                   []string{"sub", "name", "given_name", "family_name", "zoneinfo", "updated_at", "email", "email_verified"},
+		CodeChallengeMethodsSupported:     []string{oidc.CodeChallengeMethodS256},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+	}
+}
+
+func (s *oauth2DiscoveryServiceImpl) JWKS() *oidc.JSONWebKeySet {
+	return s.provider.JWKS()
+}
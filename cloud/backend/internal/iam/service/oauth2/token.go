@@ -0,0 +1,206 @@
+package oauth2
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	uc_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/oidc"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+const GrantTypeAuthorizationCode = "authorization_code"
+
+// OAuth2TokenRequestIDO holds the form parameters of the token endpoint, see
+// RFC 6749 section 4.1.3 and RFC 7636 section 4.5. The client credentials
+// come either from HTTP Basic authentication or from the form.
+type OAuth2TokenRequestIDO struct {
+	GrantType    string
+	Code         string
+	RedirectURI  string
+	ClientID     string
+	ClientSecret string
+	CodeVerifier string
+}
+
+type OAuth2TokenResponseIDO struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	IDToken     string `json:"id_token"`
+	Scope       string `json:"scope"`
+}
+
+// Service interface for the token endpoint
+type OAuth2TokenService interface {
+	Execute(ctx context.Context, req *OAuth2TokenRequestIDO) (*OAuth2TokenResponseIDO, error)
+}
+
+// Implementation of the token endpoint service
+type oauth2TokenServiceImpl struct {
+	config                          *config.Configuration
+	logger                          *zap.Logger
+	cache                           mongodbcache.Cacher
+	provider                        oidc.Provider
+	oauthClientGetByClientIDUseCase uc_oauthclient.OAuthClientGetByClientIDUseCase
+	userGetByIDUseCase              uc_user.FederatedUserGetByIDUseCase
+	userAddSessionUseCase           uc_user.FederatedUserAddSessionUseCase
+}
+
+func NewOAuth2TokenService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	provider oidc.Provider,
+	oauthClientGetByClientIDUseCase uc_oauthclient.OAuthClientGetByClientIDUseCase,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+	userAddSessionUseCase uc_user.FederatedUserAddSessionUseCase,
+) OAuth2TokenService {
+	return &oauth2TokenServiceImpl{
+		config:                          config,
+		logger:                          logger,
+		cache:                           cache,
+		provider:                        provider,
+		oauthClientGetByClientIDUseCase: oauthClientGetByClientIDUseCase,
+		userGetByIDUseCase:              userGetByIDUseCase,
+		userAddSessionUseCase:           userAddSessionUseCase,
+	}
+}
+
+func (s *oauth2TokenServiceImpl) Execute(ctx context.Context, req *OAuth2TokenRequestIDO) (*OAuth2TokenResponseIDO, error) {
+	ctx, span := tracing.Start(ctx, "Oauth2TokenService.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Authenticate the client.
+	//
+
+	if req.ClientID == "" {
+		return nil, newOAuthError(http.StatusUnauthorized, ErrorInvalidClient, "Client authentication failed")
+	}
+	client, err := s.oauthClientGetByClientIDUseCase.Execute(ctx, req.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, newOAuthError(http.StatusUnauthorized, ErrorInvalidClient, "Client authentication failed")
+	}
+	if !client.IsPublic && !uc_oauthclient.CompareClientSecret(client.ClientSecretHash, req.ClientSecret) {
+		s.logger.Warn("OAuth2 client authentication failed", zap.String("client_id", req.ClientID))
+		return nil, newOAuthError(http.StatusUnauthorized, ErrorInvalidClient, "Client authentication failed")
+	}
+
+	//
+	// STEP 2: Validate the grant.
+	//
+
+	if req.GrantType != GrantTypeAuthorizationCode {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorUnsupportedGrantType, "Only the authorization_code grant type is supported")
+	}
+	if req.Code == "" {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidRequest, "code is required")
+	}
+
+	codeCacheKey := authorizationCodeCacheKey(req.Code)
+	var codeData AuthorizationCodeData
+	found, err := getCachedJSON(ctx, s.cache, codeCacheKey, &codeData)
+	if err != nil {
+		s.logger.Error("Failed to retrieve authorization code", zap.Error(err))
+	}
+	if !found {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidGrant, "Invalid or expired authorization code")
+	}
+
+	// Codes are single use; delete before any further checks so a failed
+	// attempt cannot be retried with different parameters.
+	if err := s.cache.Delete(ctx, codeCacheKey); err != nil {
+		s.logger.Error("Failed to delete authorization code", zap.Error(err))
+		return nil, err
+	}
+
+	if time.Now().After(codeData.ExpiresAt) {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidGrant, "Invalid or expired authorization code")
+	}
+	if codeData.ClientID != client.ClientID {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidGrant, "Authorization code was issued to another client")
+	}
+	if codeData.RedirectURI != req.RedirectURI {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidGrant, "redirect_uri does not match the authorization request")
+	}
+	if !oidc.VerifyPKCE(codeData.CodeChallengeMethod, codeData.CodeChallenge, req.CodeVerifier) {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidGrant, "PKCE verification failed")
+	}
+
+	userID, err := primitive.ObjectIDFromHex(codeData.FederatedUserID)
+	if err != nil {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidGrant, "Invalid authorization code")
+	}
+	user, err := s.userGetByIDUseCase.Execute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || isUserDisabled(user) {
+		return nil, newOAuthError(http.StatusBadRequest, ErrorInvalidGrant, "User is not allowed to sign in")
+	}
+
+	//
+	// STEP 3: Issue the tokens.
+	//
+
+	accessToken, err := generateToken()
+	if err != nil {
+		s.logger.Error("Failed to generate access token", zap.Error(err))
+		return nil, err
+	}
+	accessTokenKey := accessTokenCacheKey(accessToken)
+	accessTokenData := AccessTokenData{
+		ClientID:        client.ClientID,
+		FederatedUserID: user.ID.Hex(),
+		Scopes:          codeData.Scopes,
+		ExpiresAt:       time.Now().Add(accessTokenExpiry),
+	}
+	if err := setCachedJSON(ctx, s.cache, accessTokenKey, accessTokenData, accessTokenExpiry); err != nil {
+		s.logger.Error("Failed to store access token in cache", zap.Error(err))
+		return nil, err
+	}
+	if err := s.userAddSessionUseCase.Execute(ctx, user.ID, accessTokenKey, accessTokenExpiry); err != nil {
+		// Not fatal: the token still expires on its own.
+		s.logger.Error("Failed to index access token as session", zap.Error(err))
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"aud":       client.ClientID,
+		"iat":       now.Unix(),
+		"exp":       now.Add(idTokenExpiry).Unix(),
+		"auth_time": codeData.AuthTime.Unix(),
+	}
+	if codeData.Nonce != "" {
+		claims["nonce"] = codeData.Nonce
+	}
+	for k, v := range userClaims(user, codeData.Scopes) {
+		claims[k] = v
+	}
+	idToken, err := s.provider.SignIDToken(claims)
+	if err != nil {
+		s.logger.Error("Failed to sign ID token", zap.Error(err))
+		return nil, err
+	}
+
+	return &OAuth2TokenResponseIDO{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(accessTokenExpiry.Seconds()),
+		IDToken:     idToken,
+		Scope:       strings.Join(codeData.Scopes, " "),
+	}, nil
+}
@@ -0,0 +1,75 @@
+package oauth2
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// Service interface for the userinfo endpoint
+type OAuth2UserInfoService interface {
+	Execute(ctx context.Context, accessToken string) (map[string]interface{}, error)
+}
+
+// Implementation of the userinfo endpoint service
+type oauth2UserInfoServiceImpl struct {
+	config             *config.Configuration
+	logger             *zap.Logger
+	cache              mongodbcache.Cacher
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase
+}
+
+func NewOAuth2UserInfoService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	cache mongodbcache.Cacher,
+	userGetByIDUseCase uc_user.FederatedUserGetByIDUseCase,
+) OAuth2UserInfoService {
+	return &oauth2UserInfoServiceImpl{
+		config:             config,
+		logger:             logger,
+		cache:              cache,
+		userGetByIDUseCase: userGetByIDUseCase,
+	}
+}
+
+func (s *oauth2UserInfoServiceImpl) Execute(ctx context.Context, accessToken string) (map[string]interface{}, error) {
+	ctx, span := tracing.Start(ctx, "Oauth2UserInfoService.Execute")
+	defer span.End()
+
+	invalidToken := newOAuthError(http.StatusUnauthorized, ErrorInvalidToken, "The access token is invalid or has expired")
+	if accessToken == "" {
+		return nil, invalidToken
+	}
+
+	var tokenData AccessTokenData
+	found, err := getCachedJSON(ctx, s.cache, accessTokenCacheKey(accessToken), &tokenData)
+	if err != nil {
+		s.logger.Error("Failed to retrieve access token", zap.Error(err))
+	}
+	if !found || time.Now().After(tokenData.ExpiresAt) {
+		return nil, invalidToken
+	}
+
+	userID, err := primitive.ObjectIDFromHex(tokenData.FederatedUserID)
+	if err != nil {
+		return nil, invalidToken
+	}
+	user, err := s.userGetByIDUseCase.Execute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || isUserDisabled(user) {
+		return nil, invalidToken
+	}
+
+	return userClaims(user, tokenData.Scopes), nil
+}
@@ -0,0 +1,95 @@
+package organization
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	dom_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organization"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+)
+
+// invitationExpiry is how long an emailed invitation can be accepted for.
+const invitationExpiry = 7 * 24 * time.Hour
+
+var errUserNotInContext = errors.New("federateduser not found in context")
+
+// OrganizationResponseDTO is an organization as seen by one of its members.
+type OrganizationResponseDTO struct {
+	*dom_organization.Organization
+	Role int8 `json:"role"`
+}
+
+// sessionUser returns the authenticated user saved to the context by the
+// middleware.
+func sessionUser(ctx context.Context) (*dom_user.FederatedUser, error) {
+	user, ok := ctx.Value(constants.SessionFederatedUser).(*dom_user.FederatedUser)
+	if !ok || user == nil {
+		return nil, errUserNotInContext
+	}
+	return user, nil
+}
+
+// getMembership returns the membership of `userID` in `organizationID` or a
+// not found error so non-members cannot learn which organizations exist.
+func getMembership(ctx context.Context, uc uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase, organizationID, userID primitive.ObjectID) (*dom_member.OrganizationMember, error) {
+	member, err := uc.Execute(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "Organization does not exist"), httperror.CodeOrganizationNotFound)
+	}
+	return member, nil
+}
+
+// requireManager returns the membership of `userID` if they may manage the
+// members of `organizationID`.
+func requireManager(ctx context.Context, uc uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase, organizationID, userID primitive.ObjectID) (*dom_member.OrganizationMember, error) {
+	member, err := getMembership(ctx, uc, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !dom_member.CanManageMembers(member.Role) {
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "Only owners and admins can manage this organization"), httperror.CodePermissionDenied)
+	}
+	return member, nil
+}
+
+// newOrganizationAuditEvent creates the audit event for an action the user
+// in the session performed on `organizationID`; `subjectUserID` is the user
+// affected by the action.
+func newOrganizationAuditEvent(eventType string, organizationID, subjectUserID primitive.ObjectID, details map[string]string) *dom_auditevent.AuditEvent {
+	return &dom_auditevent.AuditEvent{
+		Type:          eventType,
+		Outcome:       dom_auditevent.AuditEventOutcomeSuccess,
+		SubjectUserID: subjectUserID,
+		ResourceType:  dom_auditevent.AuditEventResourceTypeOrganization,
+		ResourceID:    organizationID.Hex(),
+		Details:       details,
+	}
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateInvitationToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,114 @@
+package organization
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organization"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organization"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type CreateOrganizationRequestDTO struct {
+	Name string `json:"name"`
+}
+
+// CreateOrganizationService creates an organization with the authenticated
+// user as its first owner.
+type CreateOrganizationService interface {
+	Execute(sessCtx context.Context, req *CreateOrganizationRequestDTO) (*OrganizationResponseDTO, error)
+}
+
+type createOrganizationServiceImpl struct {
+	config                    *config.Configuration
+	logger                    *zap.Logger
+	organizationCreateUseCase uc_organization.OrganizationCreateUseCase
+	memberCreateUseCase       uc_member.OrganizationMemberCreateUseCase
+	auditEventCreateUseCase   uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewCreateOrganizationService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	organizationCreateUseCase uc_organization.OrganizationCreateUseCase,
+	memberCreateUseCase uc_member.OrganizationMemberCreateUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) CreateOrganizationService {
+	return &createOrganizationServiceImpl{
+		config:                    config,
+		logger:                    logger,
+		organizationCreateUseCase: organizationCreateUseCase,
+		memberCreateUseCase:       memberCreateUseCase,
+		auditEventCreateUseCase:   auditEventCreateUseCase,
+	}
+}
+
+func (svc *createOrganizationServiceImpl) Execute(sessCtx context.Context, req *CreateOrganizationRequestDTO) (*OrganizationResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "CreateOrganizationService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return nil, err
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	e := make(map[string]string)
+	if req.Name == "" {
+		e["name"] = "Name is required"
+	} else if len(req.Name) > 100 {
+		e["name"] = "Name is too long"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	now := time.Now()
+	org := &dom_organization.Organization{
+		ID:              primitive.NewObjectID(),
+		Name:            req.Name,
+		CreatedByUserID: user.ID,
+		CreatedAt:       now,
+		ModifiedAt:      now,
+	}
+	if err := svc.organizationCreateUseCase.Execute(sessCtx, org); err != nil {
+		return nil, err
+	}
+
+	member := &dom_member.OrganizationMember{
+		ID:              primitive.NewObjectID(),
+		OrganizationID:  org.ID,
+		FederatedUserID: user.ID,
+		Email:           user.Email,
+		Name:            user.Name,
+		PublicKey:       user.PublicKey,
+		Role:            dom_member.OrganizationMemberRoleOwner,
+		CreatedAt:       now,
+		ModifiedAt:      now,
+	}
+	if err := svc.memberCreateUseCase.Execute(sessCtx, member); err != nil {
+		return nil, err
+	}
+
+	event := newOrganizationAuditEvent(dom_auditevent.AuditEventTypeOrganizationCreated, org.ID, user.ID, map[string]string{
+		"name": org.Name,
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("organization created",
+		zap.String("organization_id", org.ID.Hex()))
+	return &OrganizationResponseDTO{Organization: org, Role: member.Role}, nil
+}
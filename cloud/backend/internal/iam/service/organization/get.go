@@ -0,0 +1,64 @@
+package organization
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	uc_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organization"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type GetOrganizationService interface {
+	Execute(sessCtx context.Context, id primitive.ObjectID) (*OrganizationResponseDTO, error)
+}
+
+type getOrganizationServiceImpl struct {
+	config                     *config.Configuration
+	logger                     *zap.Logger
+	memberGetUseCase           uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase
+	organizationGetByIDUseCase uc_organization.OrganizationGetByIDUseCase
+}
+
+func NewGetOrganizationService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	memberGetUseCase uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase,
+	organizationGetByIDUseCase uc_organization.OrganizationGetByIDUseCase,
+) GetOrganizationService {
+	return &getOrganizationServiceImpl{
+		config:                     config,
+		logger:                     logger,
+		memberGetUseCase:           memberGetUseCase,
+		organizationGetByIDUseCase: organizationGetByIDUseCase,
+	}
+}
+
+func (svc *getOrganizationServiceImpl) Execute(sessCtx context.Context, id primitive.ObjectID) (*OrganizationResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GetOrganizationService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return nil, err
+	}
+
+	member, err := getMembership(sessCtx, svc.memberGetUseCase, id, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := svc.organizationGetByIDUseCase.Execute(sessCtx, id)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "Organization does not exist"), httperror.CodeOrganizationNotFound)
+	}
+	return &OrganizationResponseDTO{Organization: org, Role: member.Role}, nil
+}
@@ -0,0 +1,130 @@
+package organization
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organization"
+	uc_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationinvitation"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type AcceptOrganizationInvitationRequestDTO struct {
+	Token string `json:"token"`
+}
+
+// AcceptOrganizationInvitationService makes the authenticated user a member
+// of the organization they were invited to. The invitation can only be
+// accepted by the account registered with the invited email address.
+type AcceptOrganizationInvitationService interface {
+	Execute(sessCtx context.Context, req *AcceptOrganizationInvitationRequestDTO) (*OrganizationResponseDTO, error)
+}
+
+type acceptOrganizationInvitationServiceImpl struct {
+	config                          *config.Configuration
+	logger                          *zap.Logger
+	invitationGetByTokenHashUseCase uc_invitation.OrganizationInvitationGetByTokenHashUseCase
+	invitationDeleteByIDUseCase     uc_invitation.OrganizationInvitationDeleteByIDUseCase
+	organizationGetByIDUseCase      uc_organization.OrganizationGetByIDUseCase
+	memberCreateUseCase             uc_member.OrganizationMemberCreateUseCase
+	auditEventCreateUseCase         uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewAcceptOrganizationInvitationService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	invitationGetByTokenHashUseCase uc_invitation.OrganizationInvitationGetByTokenHashUseCase,
+	invitationDeleteByIDUseCase uc_invitation.OrganizationInvitationDeleteByIDUseCase,
+	organizationGetByIDUseCase uc_organization.OrganizationGetByIDUseCase,
+	memberCreateUseCase uc_member.OrganizationMemberCreateUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) AcceptOrganizationInvitationService {
+	return &acceptOrganizationInvitationServiceImpl{
+		config:                          config,
+		logger:                          logger,
+		invitationGetByTokenHashUseCase: invitationGetByTokenHashUseCase,
+		invitationDeleteByIDUseCase:     invitationDeleteByIDUseCase,
+		organizationGetByIDUseCase:      organizationGetByIDUseCase,
+		memberCreateUseCase:             memberCreateUseCase,
+		auditEventCreateUseCase:         auditEventCreateUseCase,
+	}
+}
+
+func (svc *acceptOrganizationInvitationServiceImpl) Execute(sessCtx context.Context, req *AcceptOrganizationInvitationRequestDTO) (*OrganizationResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "AcceptOrganizationInvitationService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return nil, err
+	}
+
+	if req.Token == "" {
+		return nil, httperror.NewForBadRequestWithSingleField("token", "Token is required")
+	}
+
+	invitation, err := svc.invitationGetByTokenHashUseCase.Execute(sessCtx, hashInvitationToken(req.Token))
+	if err != nil {
+		return nil, err
+	}
+	// MongoDB removes expired invitations lazily so check the expiry too.
+	if invitation == nil || time.Now().After(invitation.ExpiresAt) {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invitation does not exist or has expired"), httperror.CodeInvitationNotFound)
+	}
+	if invitation.Email != user.Email {
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("token", "This invitation was sent to a different email address"), httperror.CodeInvitationEmailMismatch)
+	}
+
+	org, err := svc.organizationGetByIDUseCase.Execute(sessCtx, invitation.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("token", "Invitation does not exist or has expired"), httperror.CodeInvitationNotFound)
+	}
+
+	now := time.Now()
+	member := &dom_member.OrganizationMember{
+		ID:              primitive.NewObjectID(),
+		OrganizationID:  org.ID,
+		FederatedUserID: user.ID,
+		Email:           user.Email,
+		Name:            user.Name,
+		PublicKey:       user.PublicKey,
+		Role:            invitation.Role,
+		InvitedByUserID: invitation.InvitedByUserID,
+		CreatedAt:       now,
+		ModifiedAt:      now,
+	}
+	if err := svc.memberCreateUseCase.Execute(sessCtx, member); err != nil {
+		return nil, err
+	}
+
+	if err := svc.invitationDeleteByIDUseCase.Execute(sessCtx, invitation.ID); err != nil {
+		return nil, err
+	}
+
+	event := newOrganizationAuditEvent(dom_auditevent.AuditEventTypeOrganizationMemberJoined, org.ID, user.ID, map[string]string{
+		"role":               strconv.Itoa(int(member.Role)),
+		"invited_by_user_id": invitation.InvitedByUserID.Hex(),
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("organization invitation accepted",
+		zap.String("organization_id", org.ID.Hex()),
+		zap.String("user_id", user.ID.Hex()))
+	return &OrganizationResponseDTO{Organization: org, Role: member.Role}, nil
+}
@@ -0,0 +1,173 @@
+package organization
+
+import (
+	"context"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_emailer "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/emailer"
+	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	uc_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organization"
+	uc_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationinvitation"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type CreateOrganizationInvitationRequestDTO struct {
+	Email string `json:"email"`
+	Role  int8   `json:"role"`
+}
+
+// CreateOrganizationInvitationService emails an invitation to join an
+// organization. The person invited does not need to have an account yet.
+type CreateOrganizationInvitationService interface {
+	Execute(sessCtx context.Context, organizationID primitive.ObjectID, req *CreateOrganizationInvitationRequestDTO) (*dom_invitation.OrganizationInvitation, error)
+}
+
+type createOrganizationInvitationServiceImpl struct {
+	config                     *config.Configuration
+	logger                     *zap.Logger
+	memberGetUseCase           uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase
+	organizationGetByIDUseCase uc_organization.OrganizationGetByIDUseCase
+	userGetByEmailUseCase      uc_user.FederatedUserGetByEmailUseCase
+	invitationCreateUseCase    uc_invitation.OrganizationInvitationCreateUseCase
+	sendInvitationEmailUseCase uc_emailer.SendOrganizationInvitationEmailUseCase
+	auditEventCreateUseCase    uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewCreateOrganizationInvitationService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	memberGetUseCase uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase,
+	organizationGetByIDUseCase uc_organization.OrganizationGetByIDUseCase,
+	userGetByEmailUseCase uc_user.FederatedUserGetByEmailUseCase,
+	invitationCreateUseCase uc_invitation.OrganizationInvitationCreateUseCase,
+	sendInvitationEmailUseCase uc_emailer.SendOrganizationInvitationEmailUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) CreateOrganizationInvitationService {
+	return &createOrganizationInvitationServiceImpl{
+		config:                     config,
+		logger:                     logger,
+		memberGetUseCase:           memberGetUseCase,
+		organizationGetByIDUseCase: organizationGetByIDUseCase,
+		userGetByEmailUseCase:      userGetByEmailUseCase,
+		invitationCreateUseCase:    invitationCreateUseCase,
+		sendInvitationEmailUseCase: sendInvitationEmailUseCase,
+		auditEventCreateUseCase:    auditEventCreateUseCase,
+	}
+}
+
+func (svc *createOrganizationInvitationServiceImpl) Execute(sessCtx context.Context, organizationID primitive.ObjectID, req *CreateOrganizationInvitationRequestDTO) (*dom_invitation.OrganizationInvitation, error) {
+	sessCtx, span := tracing.Start(sessCtx, "CreateOrganizationInvitationService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return nil, err
+	}
+
+	actor, err := requireManager(sessCtx, svc.memberGetUseCase, organizationID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sanitize input
+	req.Email = strings.ToLower(req.Email)
+	req.Email = strings.ReplaceAll(req.Email, " ", "")
+	if req.Role == 0 {
+		req.Role = dom_member.OrganizationMemberRoleMember
+	}
+
+	e := make(map[string]string)
+	if req.Email == "" {
+		e["email"] = "Email is required"
+	} else if _, err := mail.ParseAddress(req.Email); err != nil {
+		e["email"] = "Email is invalid"
+	}
+	if !dom_member.IsValidRole(req.Role) {
+		e["role"] = "Role is invalid"
+	} else if req.Role == dom_member.OrganizationMemberRoleOwner && actor.Role != dom_member.OrganizationMemberRoleOwner {
+		e["role"] = "Only owners can invite other owners"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	// Do not invite people who already belong to the organization.
+	invitee, err := svc.userGetByEmailUseCase.Execute(sessCtx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if invitee != nil {
+		existing, err := svc.memberGetUseCase.Execute(sessCtx, organizationID, invitee.ID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("email", "User is already a member of this organization"), httperror.CodeAlreadyMember)
+		}
+	}
+
+	org, err := svc.organizationGetByIDUseCase.Execute(sessCtx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "Organization does not exist"), httperror.CodeOrganizationNotFound)
+	}
+
+	token, err := generateInvitationToken()
+	if err != nil {
+		svc.logger.Error("failed generating invitation token", zap.Any("error", err))
+		return nil, err
+	}
+
+	now := time.Now()
+	invitation := &dom_invitation.OrganizationInvitation{
+		ID:               primitive.NewObjectID(),
+		OrganizationID:   org.ID,
+		OrganizationName: org.Name,
+		Email:            req.Email,
+		Role:             req.Role,
+		TokenHash:        hashInvitationToken(token),
+		InvitedByUserID:  user.ID,
+		InvitedByName:    user.Name,
+		ExpiresAt:        now.Add(invitationExpiry),
+		CreatedAt:        now,
+	}
+	if err := svc.invitationCreateUseCase.Execute(sessCtx, invitation); err != nil {
+		return nil, err
+	}
+
+	expiresInDays := int(invitationExpiry.Hours() / 24)
+	if err := svc.sendInvitationEmailUseCase.Execute(sessCtx, int(constants.MonolithModulePaperCloudPropertyEvaluator), invitation.Email, org.Name, user.Name, token, expiresInDays); err != nil {
+		svc.logger.Error("failed sending organization invitation", zap.Any("error", err))
+		return nil, err
+	}
+
+	event := newOrganizationAuditEvent(dom_auditevent.AuditEventTypeOrganizationMemberInvited, org.ID, user.ID, map[string]string{
+		"email": invitation.Email,
+		"role":  strconv.Itoa(int(invitation.Role)),
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return nil, err
+	}
+
+	svc.logger.Info("organization invitation sent",
+		zap.String("organization_id", org.ID.Hex()),
+		zap.String("invitation_id", invitation.ID.Hex()))
+	return invitation, nil
+}
@@ -0,0 +1,63 @@
+package organization
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	uc_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationinvitation"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// ListOrganizationInvitationsService lists the pending invitations of an
+// organization to its owners and admins.
+type ListOrganizationInvitationsService interface {
+	Execute(sessCtx context.Context, organizationID primitive.ObjectID) ([]*dom_invitation.OrganizationInvitation, error)
+}
+
+type listOrganizationInvitationsServiceImpl struct {
+	config                                *config.Configuration
+	logger                                *zap.Logger
+	memberGetUseCase                      uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase
+	invitationListByOrganizationIDUseCase uc_invitation.OrganizationInvitationListByOrganizationIDUseCase
+}
+
+func NewListOrganizationInvitationsService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	memberGetUseCase uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase,
+	invitationListByOrganizationIDUseCase uc_invitation.OrganizationInvitationListByOrganizationIDUseCase,
+) ListOrganizationInvitationsService {
+	return &listOrganizationInvitationsServiceImpl{
+		config:                                config,
+		logger:                                logger,
+		memberGetUseCase:                      memberGetUseCase,
+		invitationListByOrganizationIDUseCase: invitationListByOrganizationIDUseCase,
+	}
+}
+
+func (svc *listOrganizationInvitationsServiceImpl) Execute(sessCtx context.Context, organizationID primitive.ObjectID) ([]*dom_invitation.OrganizationInvitation, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ListOrganizationInvitationsService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return nil, err
+	}
+
+	if _, err := requireManager(sessCtx, svc.memberGetUseCase, organizationID, user.ID); err != nil {
+		return nil, err
+	}
+
+	res, err := svc.invitationListByOrganizationIDUseCase.Execute(sessCtx, organizationID)
+	if err != nil {
+		svc.logger.Error("failed listing organization invitations", zap.Any("error", err))
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,79 @@
+package organization
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationinvitation"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type RevokeOrganizationInvitationService interface {
+	Execute(sessCtx context.Context, organizationID, invitationID primitive.ObjectID) error
+}
+
+type revokeOrganizationInvitationServiceImpl struct {
+	config                      *config.Configuration
+	logger                      *zap.Logger
+	memberGetUseCase            uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase
+	invitationGetByIDUseCase    uc_invitation.OrganizationInvitationGetByIDUseCase
+	invitationDeleteByIDUseCase uc_invitation.OrganizationInvitationDeleteByIDUseCase
+	auditEventCreateUseCase     uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewRevokeOrganizationInvitationService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	memberGetUseCase uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase,
+	invitationGetByIDUseCase uc_invitation.OrganizationInvitationGetByIDUseCase,
+	invitationDeleteByIDUseCase uc_invitation.OrganizationInvitationDeleteByIDUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) RevokeOrganizationInvitationService {
+	return &revokeOrganizationInvitationServiceImpl{
+		config:                      config,
+		logger:                      logger,
+		memberGetUseCase:            memberGetUseCase,
+		invitationGetByIDUseCase:    invitationGetByIDUseCase,
+		invitationDeleteByIDUseCase: invitationDeleteByIDUseCase,
+		auditEventCreateUseCase:     auditEventCreateUseCase,
+	}
+}
+
+func (svc *revokeOrganizationInvitationServiceImpl) Execute(sessCtx context.Context, organizationID, invitationID primitive.ObjectID) error {
+	sessCtx, span := tracing.Start(sessCtx, "RevokeOrganizationInvitationService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return err
+	}
+
+	if _, err := requireManager(sessCtx, svc.memberGetUseCase, organizationID, user.ID); err != nil {
+		return err
+	}
+
+	invitation, err := svc.invitationGetByIDUseCase.Execute(sessCtx, invitationID)
+	if err != nil {
+		return err
+	}
+	if invitation == nil || invitation.OrganizationID != organizationID {
+		return httperror.WithCode(httperror.NewForNotFoundWithSingleField("invitation_id", "Invitation does not exist"), httperror.CodeInvitationNotFound)
+	}
+
+	if err := svc.invitationDeleteByIDUseCase.Execute(sessCtx, invitation.ID); err != nil {
+		return err
+	}
+
+	event := newOrganizationAuditEvent(dom_auditevent.AuditEventTypeOrganizationInviteRevoked, organizationID, user.ID, map[string]string{
+		"email": invitation.Email,
+	})
+	return svc.auditEventCreateUseCase.Execute(sessCtx, event)
+}
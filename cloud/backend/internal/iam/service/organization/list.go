@@ -0,0 +1,76 @@
+package organization
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	uc_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organization"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// ListMyOrganizationsService lists the organizations the authenticated user
+// is a member of together with their role in each.
+type ListMyOrganizationsService interface {
+	Execute(sessCtx context.Context) ([]*OrganizationResponseDTO, error)
+}
+
+type listMyOrganizationsServiceImpl struct {
+	config                       *config.Configuration
+	logger                       *zap.Logger
+	memberListByUserIDUseCase    uc_member.OrganizationMemberListByUserIDUseCase
+	organizationListByIDsUseCase uc_organization.OrganizationListByIDsUseCase
+}
+
+func NewListMyOrganizationsService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	memberListByUserIDUseCase uc_member.OrganizationMemberListByUserIDUseCase,
+	organizationListByIDsUseCase uc_organization.OrganizationListByIDsUseCase,
+) ListMyOrganizationsService {
+	return &listMyOrganizationsServiceImpl{
+		config:                       config,
+		logger:                       logger,
+		memberListByUserIDUseCase:    memberListByUserIDUseCase,
+		organizationListByIDsUseCase: organizationListByIDsUseCase,
+	}
+}
+
+func (svc *listMyOrganizationsServiceImpl) Execute(sessCtx context.Context) ([]*OrganizationResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ListMyOrganizationsService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return nil, err
+	}
+
+	members, err := svc.memberListByUserIDUseCase.Execute(sessCtx, user.ID)
+	if err != nil {
+		svc.logger.Error("failed listing memberships", zap.Any("error", err))
+		return nil, err
+	}
+
+	roles := make(map[primitive.ObjectID]int8, len(members))
+	ids := make([]primitive.ObjectID, 0, len(members))
+	for _, m := range members {
+		roles[m.OrganizationID] = m.Role
+		ids = append(ids, m.OrganizationID)
+	}
+
+	orgs, err := svc.organizationListByIDsUseCase.Execute(sessCtx, ids)
+	if err != nil {
+		svc.logger.Error("failed listing organizations", zap.Any("error", err))
+		return nil, err
+	}
+
+	res := make([]*OrganizationResponseDTO, 0, len(orgs))
+	for _, org := range orgs {
+		res = append(res, &OrganizationResponseDTO{Organization: org, Role: roles[org.ID]})
+	}
+	return res, nil
+}
@@ -0,0 +1,62 @@
+package organization
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// ListOrganizationMembersService lists the members of an organization to any
+// of its members.
+type ListOrganizationMembersService interface {
+	Execute(sessCtx context.Context, organizationID primitive.ObjectID) ([]*dom_member.OrganizationMember, error)
+}
+
+type listOrganizationMembersServiceImpl struct {
+	config                            *config.Configuration
+	logger                            *zap.Logger
+	memberGetUseCase                  uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase
+	memberListByOrganizationIDUseCase uc_member.OrganizationMemberListByOrganizationIDUseCase
+}
+
+func NewListOrganizationMembersService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	memberGetUseCase uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase,
+	memberListByOrganizationIDUseCase uc_member.OrganizationMemberListByOrganizationIDUseCase,
+) ListOrganizationMembersService {
+	return &listOrganizationMembersServiceImpl{
+		config:                            config,
+		logger:                            logger,
+		memberGetUseCase:                  memberGetUseCase,
+		memberListByOrganizationIDUseCase: memberListByOrganizationIDUseCase,
+	}
+}
+
+func (svc *listOrganizationMembersServiceImpl) Execute(sessCtx context.Context, organizationID primitive.ObjectID) ([]*dom_member.OrganizationMember, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ListOrganizationMembersService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return nil, err
+	}
+
+	if _, err := getMembership(sessCtx, svc.memberGetUseCase, organizationID, user.ID); err != nil {
+		return nil, err
+	}
+
+	res, err := svc.memberListByOrganizationIDUseCase.Execute(sessCtx, organizationID)
+	if err != nil {
+		svc.logger.Error("failed listing organization members", zap.Any("error", err))
+		return nil, err
+	}
+	return res, nil
+}
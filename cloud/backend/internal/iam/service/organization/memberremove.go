@@ -0,0 +1,104 @@
+package organization
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// RemoveOrganizationMemberService removes a member from an organization.
+// Members may always leave on their own; removing somebody else requires
+// the owner or admin role and only owners may remove other owners.
+type RemoveOrganizationMemberService interface {
+	Execute(sessCtx context.Context, organizationID, userID primitive.ObjectID) error
+}
+
+type removeOrganizationMemberServiceImpl struct {
+	config                   *config.Configuration
+	logger                   *zap.Logger
+	memberGetUseCase         uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase
+	memberCountByRoleUseCase uc_member.OrganizationMemberCountByRoleUseCase
+	memberDeleteByIDUseCase  uc_member.OrganizationMemberDeleteByIDUseCase
+	auditEventCreateUseCase  uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewRemoveOrganizationMemberService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	memberGetUseCase uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase,
+	memberCountByRoleUseCase uc_member.OrganizationMemberCountByRoleUseCase,
+	memberDeleteByIDUseCase uc_member.OrganizationMemberDeleteByIDUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) RemoveOrganizationMemberService {
+	return &removeOrganizationMemberServiceImpl{
+		config:                   config,
+		logger:                   logger,
+		memberGetUseCase:         memberGetUseCase,
+		memberCountByRoleUseCase: memberCountByRoleUseCase,
+		memberDeleteByIDUseCase:  memberDeleteByIDUseCase,
+		auditEventCreateUseCase:  auditEventCreateUseCase,
+	}
+}
+
+func (svc *removeOrganizationMemberServiceImpl) Execute(sessCtx context.Context, organizationID, userID primitive.ObjectID) error {
+	sessCtx, span := tracing.Start(sessCtx, "RemoveOrganizationMemberService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return err
+	}
+
+	actor, err := getMembership(sessCtx, svc.memberGetUseCase, organizationID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	member := actor
+	if userID != user.ID {
+		if !dom_member.CanManageMembers(actor.Role) {
+			return httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "Only owners and admins can manage this organization"), httperror.CodePermissionDenied)
+		}
+		member, err = svc.memberGetUseCase.Execute(sessCtx, organizationID, userID)
+		if err != nil {
+			return err
+		}
+		if member == nil {
+			return httperror.WithCode(httperror.NewForNotFoundWithSingleField("user_id", "Member does not exist"), httperror.CodeMemberNotFound)
+		}
+		if member.Role == dom_member.OrganizationMemberRoleOwner && actor.Role != dom_member.OrganizationMemberRoleOwner {
+			return httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "Only owners can remove other owners"), httperror.CodePermissionDenied)
+		}
+	}
+	if member.Role == dom_member.OrganizationMemberRoleOwner {
+		if err := ensureAnotherOwner(sessCtx, svc.memberCountByRoleUseCase, organizationID); err != nil {
+			return err
+		}
+	}
+
+	if err := svc.memberDeleteByIDUseCase.Execute(sessCtx, member.ID); err != nil {
+		return err
+	}
+
+	event := newOrganizationAuditEvent(dom_auditevent.AuditEventTypeOrganizationMemberRemoved, organizationID, member.FederatedUserID, map[string]string{
+		"email": member.Email,
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return err
+	}
+
+	svc.logger.Info("organization member removed",
+		zap.String("organization_id", organizationID.Hex()),
+		zap.String("user_id", member.FederatedUserID.Hex()))
+	return nil
+}
@@ -0,0 +1,125 @@
+package organization
+
+import (
+	"context"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type ChangeOrganizationMemberRoleRequestDTO struct {
+	Role int8 `json:"role"`
+}
+
+// ChangeOrganizationMemberRoleService changes the role of a member. Only
+// owners may grant or take away the owner role and an organization always
+// keeps at least one owner.
+type ChangeOrganizationMemberRoleService interface {
+	Execute(sessCtx context.Context, organizationID, userID primitive.ObjectID, req *ChangeOrganizationMemberRoleRequestDTO) (*dom_member.OrganizationMember, error)
+}
+
+type changeOrganizationMemberRoleServiceImpl struct {
+	config                   *config.Configuration
+	logger                   *zap.Logger
+	memberGetUseCase         uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase
+	memberCountByRoleUseCase uc_member.OrganizationMemberCountByRoleUseCase
+	memberUpdateRoleUseCase  uc_member.OrganizationMemberUpdateRoleUseCase
+	auditEventCreateUseCase  uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewChangeOrganizationMemberRoleService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	memberGetUseCase uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase,
+	memberCountByRoleUseCase uc_member.OrganizationMemberCountByRoleUseCase,
+	memberUpdateRoleUseCase uc_member.OrganizationMemberUpdateRoleUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) ChangeOrganizationMemberRoleService {
+	return &changeOrganizationMemberRoleServiceImpl{
+		config:                   config,
+		logger:                   logger,
+		memberGetUseCase:         memberGetUseCase,
+		memberCountByRoleUseCase: memberCountByRoleUseCase,
+		memberUpdateRoleUseCase:  memberUpdateRoleUseCase,
+		auditEventCreateUseCase:  auditEventCreateUseCase,
+	}
+}
+
+func (svc *changeOrganizationMemberRoleServiceImpl) Execute(sessCtx context.Context, organizationID, userID primitive.ObjectID, req *ChangeOrganizationMemberRoleRequestDTO) (*dom_member.OrganizationMember, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ChangeOrganizationMemberRoleService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return nil, err
+	}
+
+	if !dom_member.IsValidRole(req.Role) {
+		return nil, httperror.NewForBadRequestWithSingleField("role", "Role is invalid")
+	}
+
+	actor, err := requireManager(sessCtx, svc.memberGetUseCase, organizationID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := svc.memberGetUseCase.Execute(sessCtx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("user_id", "Member does not exist"), httperror.CodeMemberNotFound)
+	}
+	if member.Role == req.Role {
+		return member, nil
+	}
+
+	isOwnerChange := member.Role == dom_member.OrganizationMemberRoleOwner || req.Role == dom_member.OrganizationMemberRoleOwner
+	if isOwnerChange && actor.Role != dom_member.OrganizationMemberRoleOwner {
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("role", "Only owners can grant or revoke the owner role"), httperror.CodePermissionDenied)
+	}
+	if member.Role == dom_member.OrganizationMemberRoleOwner {
+		if err := ensureAnotherOwner(sessCtx, svc.memberCountByRoleUseCase, organizationID); err != nil {
+			return nil, err
+		}
+	}
+
+	oldRole := member.Role
+	if err := svc.memberUpdateRoleUseCase.Execute(sessCtx, member.ID, req.Role); err != nil {
+		return nil, err
+	}
+	member.Role = req.Role
+
+	event := newOrganizationAuditEvent(dom_auditevent.AuditEventTypeOrganizationMemberRole, organizationID, member.FederatedUserID, map[string]string{
+		"old_role": strconv.Itoa(int(oldRole)),
+		"new_role": strconv.Itoa(int(req.Role)),
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// ensureAnotherOwner returns an error if removing an owner would leave the
+// organization without one.
+func ensureAnotherOwner(ctx context.Context, uc uc_member.OrganizationMemberCountByRoleUseCase, organizationID primitive.ObjectID) error {
+	count, err := uc.Execute(ctx, organizationID, dom_member.OrganizationMemberRoleOwner)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("role", "An organization must keep at least one owner"), httperror.CodeLastOwner)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package organization
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organization"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type UpdateOrganizationRequestDTO struct {
+	Name string `json:"name"`
+}
+
+type UpdateOrganizationService interface {
+	Execute(sessCtx context.Context, id primitive.ObjectID, req *UpdateOrganizationRequestDTO) (*OrganizationResponseDTO, error)
+}
+
+type updateOrganizationServiceImpl struct {
+	config                     *config.Configuration
+	logger                     *zap.Logger
+	memberGetUseCase           uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase
+	organizationGetByIDUseCase uc_organization.OrganizationGetByIDUseCase
+	organizationUpdateUseCase  uc_organization.OrganizationUpdateUseCase
+	auditEventCreateUseCase    uc_auditevent.AuditEventCreateUseCase
+}
+
+func NewUpdateOrganizationService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	memberGetUseCase uc_member.OrganizationMemberGetByOrganizationIDAndUserIDUseCase,
+	organizationGetByIDUseCase uc_organization.OrganizationGetByIDUseCase,
+	organizationUpdateUseCase uc_organization.OrganizationUpdateUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) UpdateOrganizationService {
+	return &updateOrganizationServiceImpl{
+		config:                     config,
+		logger:                     logger,
+		memberGetUseCase:           memberGetUseCase,
+		organizationGetByIDUseCase: organizationGetByIDUseCase,
+		organizationUpdateUseCase:  organizationUpdateUseCase,
+		auditEventCreateUseCase:    auditEventCreateUseCase,
+	}
+}
+
+func (svc *updateOrganizationServiceImpl) Execute(sessCtx context.Context, id primitive.ObjectID, req *UpdateOrganizationRequestDTO) (*OrganizationResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "UpdateOrganizationService.Execute")
+	defer span.End()
+
+	user, err := sessionUser(sessCtx)
+	if err != nil {
+		svc.logger.Error("Failed getting local federateduser", zap.Any("error", err))
+		return nil, err
+	}
+
+	member, err := requireManager(sessCtx, svc.memberGetUseCase, id, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	e := make(map[string]string)
+	if req.Name == "" {
+		e["name"] = "Name is required"
+	} else if len(req.Name) > 100 {
+		e["name"] = "Name is too long"
+	}
+	if len(e) != 0 {
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	org, err := svc.organizationGetByIDUseCase.Execute(sessCtx, id)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, httperror.WithCode(httperror.NewForNotFoundWithSingleField("id", "Organization does not exist"), httperror.CodeOrganizationNotFound)
+	}
+
+	oldName := org.Name
+	org.Name = req.Name
+	org.ModifiedAt = time.Now()
+	if err := svc.organizationUpdateUseCase.Execute(sessCtx, org); err != nil {
+		return nil, err
+	}
+
+	event := newOrganizationAuditEvent(dom_auditevent.AuditEventTypeOrganizationUpdated, org.ID, user.ID, map[string]string{
+		"old_name": oldName,
+		"new_name": org.Name,
+	})
+	if err := svc.auditEventCreateUseCase.Execute(sessCtx, event); err != nil {
+		return nil, err
+	}
+
+	return &OrganizationResponseDTO{Organization: org, Role: member.Role}, nil
+}
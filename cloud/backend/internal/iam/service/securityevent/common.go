@@ -0,0 +1,25 @@
+package securityevent
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+)
+
+type ListSecurityEventsResponseDTO struct {
+	Events        []*dom_auditevent.AuditEvent `json:"events"`
+	HasMore       bool                         `json:"has_more"`
+	LastID        primitive.ObjectID           `json:"last_id,omitempty"`
+	LastCreatedAt time.Time                    `json:"last_created_at"`
+}
+
+func newListSecurityEventsResponseDTO(res *dom_auditevent.AuditEventFilterResult) *ListSecurityEventsResponseDTO {
+	return &ListSecurityEventsResponseDTO{
+		Events:        res.Events,
+		HasMore:       res.HasMore,
+		LastID:        res.LastID,
+		LastCreatedAt: res.LastCreatedAt,
+	}
+}
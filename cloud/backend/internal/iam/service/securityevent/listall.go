@@ -0,0 +1,48 @@
+package securityevent
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// ListSecurityEventsService lists the security events of every account and
+// is meant for administrators.
+type ListSecurityEventsService interface {
+	Execute(sessCtx context.Context, filter *dom_auditevent.AuditEventFilter) (*ListSecurityEventsResponseDTO, error)
+}
+
+type listSecurityEventsServiceImpl struct {
+	config                        *config.Configuration
+	logger                        *zap.Logger
+	auditEventListByFilterUseCase uc_auditevent.AuditEventListByFilterUseCase
+}
+
+func NewListSecurityEventsService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	auditEventListByFilterUseCase uc_auditevent.AuditEventListByFilterUseCase,
+) ListSecurityEventsService {
+	return &listSecurityEventsServiceImpl{
+		config:                        config,
+		logger:                        logger,
+		auditEventListByFilterUseCase: auditEventListByFilterUseCase,
+	}
+}
+
+func (svc *listSecurityEventsServiceImpl) Execute(sessCtx context.Context, filter *dom_auditevent.AuditEventFilter) (*ListSecurityEventsResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ListSecurityEventsService.Execute")
+	defer span.End()
+
+	res, err := svc.auditEventListByFilterUseCase.Execute(sessCtx, filter)
+	if err != nil {
+		svc.logger.Error("failed listing security events", zap.Any("error", err))
+		return nil, err
+	}
+	return newListSecurityEventsResponseDTO(res), nil
+}
@@ -0,0 +1,62 @@
+package securityevent
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// ListMySecurityEventsService lists the security events concerning the
+// authenticated user's account.
+type ListMySecurityEventsService interface {
+	Execute(sessCtx context.Context, filter *dom_auditevent.AuditEventFilter) (*ListSecurityEventsResponseDTO, error)
+}
+
+type listMySecurityEventsServiceImpl struct {
+	config                        *config.Configuration
+	logger                        *zap.Logger
+	auditEventListByFilterUseCase uc_auditevent.AuditEventListByFilterUseCase
+}
+
+func NewListMySecurityEventsService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	auditEventListByFilterUseCase uc_auditevent.AuditEventListByFilterUseCase,
+) ListMySecurityEventsService {
+	return &listMySecurityEventsServiceImpl{
+		config:                        config,
+		logger:                        logger,
+		auditEventListByFilterUseCase: auditEventListByFilterUseCase,
+	}
+}
+
+func (svc *listMySecurityEventsServiceImpl) Execute(sessCtx context.Context, filter *dom_auditevent.AuditEventFilter) (*ListSecurityEventsResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "ListMySecurityEventsService.Execute")
+	defer span.End()
+
+	userID, ok := sessCtx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok {
+		svc.logger.Error("Failed getting local federateduser id",
+			zap.Any("error", "Not found in context: user_id"))
+		return nil, errors.New("federateduser id not found in context")
+	}
+
+	// Users may only ever see the events concerning their own account.
+	filter.SubjectUserID = &userID
+	filter.ActorUserID = nil
+
+	res, err := svc.auditEventListByFilterUseCase.Execute(sessCtx, filter)
+	if err != nil {
+		svc.logger.Error("failed listing security events", zap.Any("error", err))
+		return nil, err
+	}
+	return newListSecurityEventsResponseDTO(res), nil
+}
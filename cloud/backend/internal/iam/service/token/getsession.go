@@ -10,6 +10,7 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/password"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
@@ -51,6 +52,9 @@ type TokenGetSessionResponseIDO struct {
 }
 
 func (impl *tokenGetSessionServiceImpl) Execute(ctx context.Context, sessionID string) (*dom_user.FederatedUser, error) {
+	ctx, span := tracing.Start(ctx, "TokenGetSessionService.Execute")
+	defer span.End()
+
 	// Lookup our user profile in the session or return 500 error.
 	user, err := impl.userGetBySessionIDUseCase.Execute(ctx, sessionID)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/password"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
@@ -34,6 +35,9 @@ func NewTokenVerifyService(
 }
 
 func (impl *tokenVerifyServiceImpl) Execute(ctx context.Context, rawToken string) (string, error) {
+	ctx, span := tracing.Start(ctx, "TokenVerifyService.Execute")
+	defer span.End()
+
 	// For debugging purposes, using the logger from mid.
 	impl.logger.Debug("Authorization header received",
 		zap.String("Authorization", rawToken))
@@ -71,7 +75,7 @@ func (impl *tokenVerifyServiceImpl) Execute(ctx context.Context, rawToken string
 		impl.logger.Error("failed to process JWT token",
 			zap.Error(err))
 		// Return a generic error message to the client.
-		return "", httperror.NewForUnauthorizedWithSingleField("message", "Invalid or expired token")
+		return "", httperror.WithCode(httperror.NewForUnauthorizedWithSingleField("message", "Invalid or expired token"), httperror.CodeInvalidToken)
 	}
 
 	return sessionID, nil
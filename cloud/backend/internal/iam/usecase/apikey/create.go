@@ -0,0 +1,58 @@
+package apikey
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type APIKeyCreateUseCase interface {
+	Execute(ctx context.Context, apiKey *dom_apikey.APIKey) error
+}
+
+type apiKeyCreateUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_apikey.Repository
+}
+
+func NewAPIKeyCreateUseCase(config *config.Configuration, logger *zap.Logger, repo dom_apikey.Repository) APIKeyCreateUseCase {
+	return &apiKeyCreateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *apiKeyCreateUseCaseImpl) Execute(ctx context.Context, apiKey *dom_apikey.APIKey) error {
+	ctx, span := tracing.Start(ctx, "ApiKeyCreateUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if apiKey == nil {
+		e["api_key"] = "API key is required"
+	} else {
+		if apiKey.FederatedUserID.IsZero() {
+			e["federated_user_id"] = "Federated user ID is required"
+		}
+		if apiKey.KeyHash == "" {
+			e["key_hash"] = "Key hash is required"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Create(ctx, apiKey)
+}
@@ -0,0 +1,53 @@
+package apikey
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type APIKeyDeleteByIDUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID) error
+}
+
+type apiKeyDeleteByIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_apikey.Repository
+}
+
+func NewAPIKeyDeleteByIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_apikey.Repository) APIKeyDeleteByIDUseCase {
+	return &apiKeyDeleteByIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *apiKeyDeleteByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) error {
+	ctx, span := tracing.Start(ctx, "ApiKeyDeleteByIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Delete from database.
+	//
+
+	return uc.repo.DeleteByID(ctx, id)
+}
@@ -0,0 +1,53 @@
+package apikey
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type APIKeyGetByIDUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID) (*dom_apikey.APIKey, error)
+}
+
+type apiKeyGetByIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_apikey.Repository
+}
+
+func NewAPIKeyGetByIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_apikey.Repository) APIKeyGetByIDUseCase {
+	return &apiKeyGetByIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *apiKeyGetByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) (*dom_apikey.APIKey, error) {
+	ctx, span := tracing.Start(ctx, "ApiKeyGetByIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByID(ctx, id)
+}
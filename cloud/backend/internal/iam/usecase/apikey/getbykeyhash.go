@@ -0,0 +1,51 @@
+package apikey
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type APIKeyGetByKeyHashUseCase interface {
+	Execute(ctx context.Context, keyHash string) (*dom_apikey.APIKey, error)
+}
+
+type apiKeyGetByKeyHashUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_apikey.Repository
+}
+
+func NewAPIKeyGetByKeyHashUseCase(config *config.Configuration, logger *zap.Logger, repo dom_apikey.Repository) APIKeyGetByKeyHashUseCase {
+	return &apiKeyGetByKeyHashUseCaseImpl{config, logger, repo}
+}
+
+func (uc *apiKeyGetByKeyHashUseCaseImpl) Execute(ctx context.Context, keyHash string) (*dom_apikey.APIKey, error) {
+	ctx, span := tracing.Start(ctx, "ApiKeyGetByKeyHashUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if keyHash == "" {
+		e["key_hash"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByKeyHash(ctx, keyHash)
+}
@@ -0,0 +1,14 @@
+package apikey
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashAPIKey returns the value we persist for a plaintext API key. API keys are
+// long random strings so a single fast hash is sufficient; unlike passwords
+// they cannot be brute forced from a dictionary.
+func HashAPIKey(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,53 @@
+package apikey
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type APIKeyListByFederatedUserIDUseCase interface {
+	Execute(ctx context.Context, federatedUserID primitive.ObjectID) ([]*dom_apikey.APIKey, error)
+}
+
+type apiKeyListByFederatedUserIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_apikey.Repository
+}
+
+func NewAPIKeyListByFederatedUserIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_apikey.Repository) APIKeyListByFederatedUserIDUseCase {
+	return &apiKeyListByFederatedUserIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *apiKeyListByFederatedUserIDUseCaseImpl) Execute(ctx context.Context, federatedUserID primitive.ObjectID) ([]*dom_apikey.APIKey, error) {
+	ctx, span := tracing.Start(ctx, "ApiKeyListByFederatedUserIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if federatedUserID.IsZero() {
+		e["federated_user_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: List from database.
+	//
+
+	return uc.repo.ListByFederatedUserID(ctx, federatedUserID)
+}
@@ -0,0 +1,54 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_apikey "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type APIKeyUpdateLastUsedUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID, lastUsedAt time.Time, ipAddress string) error
+}
+
+type apiKeyUpdateLastUsedUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_apikey.Repository
+}
+
+func NewAPIKeyUpdateLastUsedUseCase(config *config.Configuration, logger *zap.Logger, repo dom_apikey.Repository) APIKeyUpdateLastUsedUseCase {
+	return &apiKeyUpdateLastUsedUseCaseImpl{config, logger, repo}
+}
+
+func (uc *apiKeyUpdateLastUsedUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID, lastUsedAt time.Time, ipAddress string) error {
+	ctx, span := tracing.Start(ctx, "ApiKeyUpdateLastUsedUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Update in database.
+	//
+
+	return uc.repo.UpdateLastUsed(ctx, id, lastUsedAt, ipAddress)
+}
@@ -0,0 +1,85 @@
+package auditevent
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// AuditEventCreateUseCase appends the event to the audit log. Any actor, IP
+// address and user agent not set on the event are taken from the request
+// context.
+type AuditEventCreateUseCase interface {
+	Execute(ctx context.Context, event *dom_auditevent.AuditEvent) error
+}
+
+type auditEventCreateUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_auditevent.Repository
+}
+
+func NewAuditEventCreateUseCase(config *config.Configuration, logger *zap.Logger, repo dom_auditevent.Repository) AuditEventCreateUseCase {
+	return &auditEventCreateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *auditEventCreateUseCaseImpl) Execute(ctx context.Context, event *dom_auditevent.AuditEvent) error {
+	ctx, span := tracing.Start(ctx, "AuditEventCreateUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if event == nil {
+		e["audit_event"] = "Audit event is required"
+	} else if event.Type == "" {
+		e["type"] = "Type is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Fill in the blanks from our context.
+	//
+
+	if event.ActorUserID.IsZero() {
+		event.ActorUserID, _ = ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+		if event.ActorName == "" {
+			event.ActorName, _ = ctx.Value(constants.SessionFederatedUserName).(string)
+		}
+	}
+	if event.SubjectUserID.IsZero() {
+		event.SubjectUserID = event.ActorUserID
+	}
+	if event.IPAddress == "" {
+		event.IPAddress, _ = ctx.Value(constants.SessionIPAddress).(string)
+	}
+	if event.UserAgent == "" {
+		event.UserAgent, _ = ctx.Value(constants.SessionUserAgent).(string)
+	}
+	if event.Outcome == "" {
+		event.Outcome = dom_auditevent.AuditEventOutcomeSuccess
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	//
+	// STEP 3: Insert into database.
+	//
+
+	return uc.repo.Create(ctx, event)
+}
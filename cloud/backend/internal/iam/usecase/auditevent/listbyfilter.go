@@ -0,0 +1,61 @@
+package auditevent
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type AuditEventListByFilterUseCase interface {
+	Execute(ctx context.Context, filter *dom_auditevent.AuditEventFilter) (*dom_auditevent.AuditEventFilterResult, error)
+}
+
+type auditEventListByFilterUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_auditevent.Repository
+}
+
+func NewAuditEventListByFilterUseCase(config *config.Configuration, logger *zap.Logger, repo dom_auditevent.Repository) AuditEventListByFilterUseCase {
+	return &auditEventListByFilterUseCaseImpl{config, logger, repo}
+}
+
+func (uc *auditEventListByFilterUseCaseImpl) Execute(ctx context.Context, filter *dom_auditevent.AuditEventFilter) (*dom_auditevent.AuditEventFilterResult, error) {
+	ctx, span := tracing.Start(ctx, "AuditEventListByFilterUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if filter == nil {
+		e["filter"] = "Audit event filter is required"
+	} else {
+		// Validate limit to prevent excessive data loads
+		if filter.Limit > 1000 {
+			filter.Limit = 1000
+		}
+		if filter.Outcome != "" &&
+			filter.Outcome != dom_auditevent.AuditEventOutcomeSuccess &&
+			filter.Outcome != dom_auditevent.AuditEventOutcomeFailure {
+			e["outcome"] = "Outcome is invalid"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating audit event list by filter",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: List from database.
+	//
+
+	return uc.repo.ListByFilter(ctx, filter)
+}
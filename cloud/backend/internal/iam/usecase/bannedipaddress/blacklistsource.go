@@ -0,0 +1,38 @@
+package bannedipaddress
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/blacklist"
+)
+
+type blacklistSourceImpl struct {
+	logger *zap.Logger
+	repo   dom_banip.Repository
+}
+
+// NewBlacklistSource returns the banned IP addresses of the database as a
+// source of the blacklist so they are enforced together with the files.
+func NewBlacklistSource(logger *zap.Logger, repo dom_banip.Repository) blacklist.Source {
+	return &blacklistSourceImpl{logger, repo}
+}
+
+func (s *blacklistSourceImpl) ListBannedIPAddresses(ctx context.Context) ([]blacklist.Entry, error) {
+	ctx, span := tracing.Start(ctx, "BlacklistSource.ListBannedIPAddresses")
+	defer span.End()
+
+	bans, err := s.repo.ListAllActive(ctx)
+	if err != nil {
+		s.logger.Error("failed listing active banned ip addresses", zap.Any("error", err))
+		return nil, err
+	}
+	entries := make([]blacklist.Entry, 0, len(bans))
+	for _, ban := range bans {
+		entries = append(entries, blacklist.Entry{Value: ban.Value, ExpiresAt: ban.ExpiresAt})
+	}
+	return entries, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type CreateBannedIPAddressUseCase interface {
@@ -29,6 +30,9 @@ func NewCreateBannedIPAddressUseCase(
 }
 
 func (uc *createBannedIPAddressUseCaseImpl) Execute(ctx context.Context, bannedIPAddress *dom_banip.BannedIPAddress) error {
+	ctx, span := tracing.Start(ctx, "CreateBannedIPAddressUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
@@ -37,7 +41,9 @@ func (uc *createBannedIPAddressUseCaseImpl) Execute(ctx context.Context, bannedI
 	if bannedIPAddress == nil {
 		e["banned_ip_address"] = "Banned IP address is required"
 	} else {
-
+		if bannedIPAddress.Value == "" {
+			e["value"] = "Value is required"
+		}
 	}
 	if len(e) != 0 {
 		uc.logger.Warn("Failed validating",
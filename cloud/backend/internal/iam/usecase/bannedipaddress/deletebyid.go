@@ -0,0 +1,52 @@
+package bannedipaddress
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type BannedIPAddressDeleteByIDUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID) error
+}
+
+type bannedIPAddressDeleteByIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_banip.Repository
+}
+
+func NewBannedIPAddressDeleteByIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_banip.Repository) BannedIPAddressDeleteByIDUseCase {
+	return &bannedIPAddressDeleteByIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *bannedIPAddressDeleteByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) error {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressDeleteByIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Delete from database.
+	//
+
+	return uc.repo.DeleteByID(ctx, id)
+}
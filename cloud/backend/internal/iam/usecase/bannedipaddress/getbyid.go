@@ -0,0 +1,52 @@
+package bannedipaddress
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type BannedIPAddressGetByIDUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID) (*dom_banip.BannedIPAddress, error)
+}
+
+type bannedIPAddressGetByIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_banip.Repository
+}
+
+func NewBannedIPAddressGetByIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_banip.Repository) BannedIPAddressGetByIDUseCase {
+	return &bannedIPAddressGetByIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *bannedIPAddressGetByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) (*dom_banip.BannedIPAddress, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressGetByIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByID(ctx, id)
+}
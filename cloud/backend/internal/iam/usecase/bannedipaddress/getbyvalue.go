@@ -0,0 +1,51 @@
+package bannedipaddress
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type BannedIPAddressGetByValueUseCase interface {
+	Execute(ctx context.Context, value string) (*dom_banip.BannedIPAddress, error)
+}
+
+type bannedIPAddressGetByValueUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_banip.Repository
+}
+
+func NewBannedIPAddressGetByValueUseCase(config *config.Configuration, logger *zap.Logger, repo dom_banip.Repository) BannedIPAddressGetByValueUseCase {
+	return &bannedIPAddressGetByValueUseCaseImpl{config, logger, repo}
+}
+
+func (uc *bannedIPAddressGetByValueUseCaseImpl) Execute(ctx context.Context, value string) (*dom_banip.BannedIPAddress, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressGetByValueUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if value == "" {
+		e["value"] = "Value is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByValue(ctx, value)
+}
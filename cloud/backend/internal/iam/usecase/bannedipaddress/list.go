@@ -7,6 +7,7 @@ import (
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type BannedIPAddressListAllValuesUseCase interface {
@@ -28,5 +29,8 @@ func NewBannedIPAddressListAllValuesUseCase(
 }
 
 func (uc *bannedIPAddressListAllValuesUseCaseImpl) Execute(ctx context.Context) ([]string, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressListAllValuesUseCase.Execute")
+	defer span.End()
+
 	return uc.repo.ListAllValues(ctx)
 }
@@ -0,0 +1,56 @@
+package bannedipaddress
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type BannedIPAddressListByFilterUseCase interface {
+	Execute(ctx context.Context, filter *dom_banip.BannedIPAddressFilter) (*dom_banip.BannedIPAddressFilterResult, error)
+}
+
+type bannedIPAddressListByFilterUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_banip.Repository
+}
+
+func NewBannedIPAddressListByFilterUseCase(config *config.Configuration, logger *zap.Logger, repo dom_banip.Repository) BannedIPAddressListByFilterUseCase {
+	return &bannedIPAddressListByFilterUseCaseImpl{config, logger, repo}
+}
+
+func (uc *bannedIPAddressListByFilterUseCaseImpl) Execute(ctx context.Context, filter *dom_banip.BannedIPAddressFilter) (*dom_banip.BannedIPAddressFilterResult, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressListByFilterUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if filter == nil {
+		e["filter"] = "Banned IP address filter is required"
+	} else {
+		// Validate limit to prevent excessive data loads
+		if filter.Limit > 1000 {
+			filter.Limit = 1000
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: List from database.
+	//
+
+	return uc.repo.ListByFilter(ctx, filter)
+}
@@ -0,0 +1,79 @@
+package consentrecord
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/consentrecord"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// ConsentRecordCreateUseCase appends the record to the consent ledger. The
+// IP address and user agent not set on the record are taken from the request
+// context.
+type ConsentRecordCreateUseCase interface {
+	Execute(ctx context.Context, record *dom_consentrecord.ConsentRecord) error
+}
+
+type consentRecordCreateUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_consentrecord.Repository
+}
+
+func NewConsentRecordCreateUseCase(config *config.Configuration, logger *zap.Logger, repo dom_consentrecord.Repository) ConsentRecordCreateUseCase {
+	return &consentRecordCreateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *consentRecordCreateUseCaseImpl) Execute(ctx context.Context, record *dom_consentrecord.ConsentRecord) error {
+	ctx, span := tracing.Start(ctx, "ConsentRecordCreateUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if record == nil {
+		e["consent_record"] = "Consent record is required"
+	} else {
+		if record.FederatedUserID.IsZero() {
+			e["federated_user_id"] = "missing value"
+		}
+		switch record.Type {
+		case dom_consentrecord.ConsentTypeTermsOfService, dom_consentrecord.ConsentTypePromotions, dom_consentrecord.ConsentTypeTracking:
+		default:
+			e["type"] = "Type is not supported"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Fill in the blanks from our context.
+	//
+
+	if record.IPAddress == "" {
+		record.IPAddress, _ = ctx.Value(constants.SessionIPAddress).(string)
+	}
+	if record.UserAgent == "" {
+		record.UserAgent, _ = ctx.Value(constants.SessionUserAgent).(string)
+	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now()
+	}
+
+	//
+	// STEP 3: Insert into database.
+	//
+
+	return uc.repo.Create(ctx, record)
+}
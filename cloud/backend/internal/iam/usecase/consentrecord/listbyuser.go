@@ -0,0 +1,53 @@
+package consentrecord
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_consentrecord "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/consentrecord"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type ConsentRecordListByFederatedUserIDUseCase interface {
+	Execute(ctx context.Context, federatedUserID primitive.ObjectID) ([]*dom_consentrecord.ConsentRecord, error)
+}
+
+type consentRecordListByFederatedUserIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_consentrecord.Repository
+}
+
+func NewConsentRecordListByFederatedUserIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_consentrecord.Repository) ConsentRecordListByFederatedUserIDUseCase {
+	return &consentRecordListByFederatedUserIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *consentRecordListByFederatedUserIDUseCaseImpl) Execute(ctx context.Context, federatedUserID primitive.ObjectID) ([]*dom_consentrecord.ConsentRecord, error) {
+	ctx, span := tracing.Start(ctx, "ConsentRecordListByFederatedUserIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if federatedUserID.IsZero() {
+		e["federated_user_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: List from database.
+	//
+
+	return uc.repo.ListByFederatedUserID(ctx, federatedUserID)
+}
@@ -0,0 +1,115 @@
+package emailer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/templatedemailer"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// SendEmailChangeCodeEmailUseCase sends the confirmation code to the address
+// the user wants to switch to.
+type SendEmailChangeCodeEmailUseCase interface {
+	Execute(ctx context.Context, monolithModule int, newEmail, verificationCode, firstName string) error
+}
+
+type sendEmailChangeCodeEmailUseCaseImpl struct {
+	config  *config.Configuration
+	logger  *zap.Logger
+	emailer templatedemailer.TemplatedEmailer
+}
+
+func NewSendEmailChangeCodeEmailUseCase(
+	config *config.Configuration,
+	logger *zap.Logger,
+	emailer templatedemailer.TemplatedEmailer,
+) SendEmailChangeCodeEmailUseCase {
+	return &sendEmailChangeCodeEmailUseCaseImpl{config, logger, emailer}
+}
+
+func (uc *sendEmailChangeCodeEmailUseCaseImpl) Execute(ctx context.Context, monolithModule int, newEmail, verificationCode, firstName string) error {
+	ctx, span := tracing.Start(ctx, "SendEmailChangeCodeEmailUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if firstName == "" {
+		e["first_name"] = "First name is required"
+	}
+	if newEmail == "" {
+		e["new_email"] = "New email is required"
+	}
+	if verificationCode == "" {
+		e["verification_code"] = "Verification code is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for email change code email", zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Send email
+	//
+
+	return uc.emailer.SendUserEmailChangeCodeEmail(ctx, monolithModule, newEmail, verificationCode, firstName)
+}
+
+// SendEmailChangeNoticeEmailUseCase warns the current address that an email
+// change is pending and gives its owner a link to cancel it.
+type SendEmailChangeNoticeEmailUseCase interface {
+	Execute(ctx context.Context, monolithModule int, oldEmail, newEmail, cancelToken, firstName string) error
+}
+
+type sendEmailChangeNoticeEmailUseCaseImpl struct {
+	config  *config.Configuration
+	logger  *zap.Logger
+	emailer templatedemailer.TemplatedEmailer
+}
+
+func NewSendEmailChangeNoticeEmailUseCase(
+	config *config.Configuration,
+	logger *zap.Logger,
+	emailer templatedemailer.TemplatedEmailer,
+) SendEmailChangeNoticeEmailUseCase {
+	return &sendEmailChangeNoticeEmailUseCaseImpl{config, logger, emailer}
+}
+
+func (uc *sendEmailChangeNoticeEmailUseCaseImpl) Execute(ctx context.Context, monolithModule int, oldEmail, newEmail, cancelToken, firstName string) error {
+	ctx, span := tracing.Start(ctx, "SendEmailChangeNoticeEmailUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if firstName == "" {
+		e["first_name"] = "First name is required"
+	}
+	if oldEmail == "" {
+		e["old_email"] = "Old email is required"
+	}
+	if newEmail == "" {
+		e["new_email"] = "New email is required"
+	}
+	if cancelToken == "" {
+		e["cancel_token"] = "Cancel token is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for email change notice email", zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Send email
+	//
+
+	return uc.emailer.SendUserEmailChangeNoticeEmail(ctx, monolithModule, oldEmail, newEmail, cancelToken, firstName)
+}
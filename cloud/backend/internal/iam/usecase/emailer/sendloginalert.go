@@ -0,0 +1,64 @@
+// cloud/backend/internal/iam/usecase/emailer/sendloginalert.go
+package emailer
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/templatedemailer"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// SendNewLoginAlertEmailUseCase warns the user about a login from a new device
+// or country and gives them a link to disown it.
+type SendNewLoginAlertEmailUseCase interface {
+	Execute(ctx context.Context, monolithModule int, email, device, country, disownToken, firstName string, loggedInAt time.Time) error
+}
+
+type sendNewLoginAlertEmailUseCaseImpl struct {
+	config  *config.Configuration
+	logger  *zap.Logger
+	emailer templatedemailer.TemplatedEmailer
+}
+
+func NewSendNewLoginAlertEmailUseCase(
+	config *config.Configuration,
+	logger *zap.Logger,
+	emailer templatedemailer.TemplatedEmailer,
+) SendNewLoginAlertEmailUseCase {
+	return &sendNewLoginAlertEmailUseCaseImpl{config, logger, emailer}
+}
+
+func (uc *sendNewLoginAlertEmailUseCaseImpl) Execute(ctx context.Context, monolithModule int, email, device, country, disownToken, firstName string, loggedInAt time.Time) error {
+	ctx, span := tracing.Start(ctx, "SendNewLoginAlertEmailUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if firstName == "" {
+		e["first_name"] = "First name is required"
+	}
+	if email == "" {
+		e["email"] = "Email is required"
+	}
+	if disownToken == "" {
+		e["disown_token"] = "Disown token is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for new login alert email", zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Send email
+	//
+
+	return uc.emailer.SendUserNewLoginAlertEmail(ctx, monolithModule, email, device, country, disownToken, firstName, loggedInAt)
+}
@@ -9,10 +9,11 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/templatedemailer"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type SendLoginOTTEmailUseCase interface {
-	Execute(ctx context.Context, monolithModule int, email, oneTimeToken, firstName string) error
+	Execute(ctx context.Context, monolithModule int, email, oneTimeToken, magicLinkToken, firstName string) error
 }
 
 type sendLoginOTTEmailUseCaseImpl struct {
@@ -29,7 +30,10 @@ func NewSendLoginOTTEmailUseCase(
 	return &sendLoginOTTEmailUseCaseImpl{config, logger, emailer}
 }
 
-func (uc *sendLoginOTTEmailUseCaseImpl) Execute(ctx context.Context, monolithModule int, email, ott, firstName string) error {
+func (uc *sendLoginOTTEmailUseCaseImpl) Execute(ctx context.Context, monolithModule int, email, ott, magicLinkToken, firstName string) error {
+	ctx, span := tracing.Start(ctx, "SendLoginOTTEmailUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
@@ -53,5 +57,5 @@ func (uc *sendLoginOTTEmailUseCaseImpl) Execute(ctx context.Context, monolithMod
 	// STEP 2: Send email
 	//
 
-	return uc.emailer.SendUserLoginOneTimeTokenEmail(ctx, monolithModule, email, ott, firstName)
+	return uc.emailer.SendUserLoginOneTimeTokenEmail(ctx, monolithModule, email, ott, magicLinkToken, firstName)
 }
@@ -0,0 +1,65 @@
+package emailer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/templatedemailer"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// SendOrganizationInvitationEmailUseCase sends the link to accept an
+// invitation to join an organization.
+type SendOrganizationInvitationEmailUseCase interface {
+	Execute(ctx context.Context, monolithModule int, email, organizationName, invitedByName, token string, expiresInDays int) error
+}
+
+type sendOrganizationInvitationEmailUseCaseImpl struct {
+	config  *config.Configuration
+	logger  *zap.Logger
+	emailer templatedemailer.TemplatedEmailer
+}
+
+func NewSendOrganizationInvitationEmailUseCase(
+	config *config.Configuration,
+	logger *zap.Logger,
+	emailer templatedemailer.TemplatedEmailer,
+) SendOrganizationInvitationEmailUseCase {
+	return &sendOrganizationInvitationEmailUseCaseImpl{config, logger, emailer}
+}
+
+func (uc *sendOrganizationInvitationEmailUseCaseImpl) Execute(ctx context.Context, monolithModule int, email, organizationName, invitedByName, token string, expiresInDays int) error {
+	ctx, span := tracing.Start(ctx, "SendOrganizationInvitationEmailUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if email == "" {
+		e["email"] = "Email is required"
+	}
+	if organizationName == "" {
+		e["organization_name"] = "Organization name is required"
+	}
+	if invitedByName == "" {
+		e["invited_by_name"] = "Invited by name is required"
+	}
+	if token == "" {
+		e["token"] = "Token is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for organization invitation email", zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Send email
+	//
+
+	return uc.emailer.SendOrganizationInvitationEmail(ctx, monolithModule, email, organizationName, invitedByName, token, expiresInDays)
+}
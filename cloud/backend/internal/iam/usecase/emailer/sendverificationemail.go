@@ -9,6 +9,7 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/repo/templatedemailer"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type SendFederatedUserVerificationEmailUseCase interface {
@@ -25,6 +26,9 @@ func NewSendFederatedUserVerificationEmailUseCase(config *config.Configuration,
 }
 
 func (uc *sendFederatedUserVerificationEmailUseCaseImpl) Execute(ctx context.Context, monolithModule int, user *domain.FederatedUser) error {
+	ctx, span := tracing.Start(ctx, "SendFederatedUserVerificationEmailUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
@@ -0,0 +1,150 @@
+// github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser/addsession.go
+package federateduser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/distributedmutex"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// sessionIndexExpiry is the lifetime given to the indexes saved before they
+// recorded their own expiry; it matches the lifetime of the refresh token.
+const sessionIndexExpiry = 14 * 24 * time.Hour
+
+// sessionIndexCacheKey returns the cache key which holds the list of session
+// IDs that were issued to the federated user.
+func sessionIndexCacheKey(userID primitive.ObjectID) string {
+	return fmt.Sprintf("federated_user_sessions:%s", userID.Hex())
+}
+
+// lockSessionIndex serialises the updates of the index of the user, which are
+// read-modify-writes: concurrent logins would otherwise lose sessions, which
+// could then never be revoked. The returned function releases the lock.
+func lockSessionIndex(ctx context.Context, dmutex distributedmutex.Adapter, userID primitive.ObjectID) func() {
+	dmutex.Acquiref(ctx, "federated_user_sessions_lock:%s", userID.Hex())
+	return func() {
+		dmutex.Releasef(context.WithoutCancel(ctx), "federated_user_sessions_lock:%s", userID.Hex())
+	}
+}
+
+// sessionIndex is the list of sessions issued to a federated user. It lives
+// as long as its longest session so every session can be found to be revoked.
+type sessionIndex struct {
+	SessionIDs []string  `json:"session_ids"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// loadSessionIndex returns the index of the user, empty if there is none.
+func loadSessionIndex(ctx context.Context, cache mongodbcache.Cacher, logger *zap.Logger, userID primitive.ObjectID) *sessionIndex {
+	index := &sessionIndex{SessionIDs: make([]string, 0)}
+	indexBytes, err := cache.Get(ctx, sessionIndexCacheKey(userID))
+	if err != nil || len(indexBytes) == 0 {
+		return index
+	}
+	if err := json.Unmarshal(indexBytes, index); err == nil {
+		return index
+	}
+
+	// Indexes saved before they recorded their expiry are plain lists.
+	var sessionIDs []string
+	if err := json.Unmarshal(indexBytes, &sessionIDs); err != nil {
+		logger.Warn("Failed unmarshalling session index, resetting it",
+			zap.String("user_id", userID.Hex()),
+			zap.Any("error", err))
+		return &sessionIndex{SessionIDs: make([]string, 0)}
+	}
+	return &sessionIndex{SessionIDs: sessionIDs, ExpiresAt: time.Now().Add(sessionIndexExpiry)}
+}
+
+// save saves the index of the user until it expires, or deletes it if it is
+// empty or has expired.
+func (index *sessionIndex) save(ctx context.Context, cache mongodbcache.Cacher, userID primitive.ObjectID) error {
+	cacheKey := sessionIndexCacheKey(userID)
+	expiry := time.Until(index.ExpiresAt)
+	if len(index.SessionIDs) == 0 || expiry <= 0 {
+		return cache.Delete(ctx, cacheKey)
+	}
+	indexBytes, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	return cache.SetWithExpiry(ctx, cacheKey, indexBytes, expiry)
+}
+
+// FederatedUserAddSessionUseCase records that `sessionID` belongs to the
+// federated user so the session can later be revoked.
+type FederatedUserAddSessionUseCase interface {
+	Execute(ctx context.Context, userID primitive.ObjectID, sessionID string, expiry time.Duration) error
+}
+
+type userAddSessionUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	cache  mongodbcache.Cacher
+	dmutex distributedmutex.Adapter
+}
+
+func NewFederatedUserAddSessionUseCase(config *config.Configuration, logger *zap.Logger, ca mongodbcache.Cacher, dmutex distributedmutex.Adapter) FederatedUserAddSessionUseCase {
+	return &userAddSessionUseCaseImpl{config, logger, ca, dmutex}
+}
+
+func (uc *userAddSessionUseCaseImpl) Execute(ctx context.Context, userID primitive.ObjectID, sessionID string, expiry time.Duration) error {
+	ctx, span := tracing.Start(ctx, "UserAddSessionUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if userID.IsZero() {
+		e["user_id"] = "missing value"
+	}
+	if sessionID == "" {
+		e["session_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for add session",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Load the existing index (if any) and drop the sessions which
+	// expired, otherwise the index grows with every token refresh.
+	//
+
+	unlock := lockSessionIndex(ctx, uc.dmutex, userID)
+	defer unlock()
+
+	index := loadSessionIndex(ctx, uc.cache, uc.logger, userID)
+	active := make([]string, 0, len(index.SessionIDs)+1)
+	for _, id := range index.SessionIDs {
+		if val, err := uc.cache.Get(ctx, id); err == nil && len(val) > 0 {
+			active = append(active, id)
+		}
+	}
+	index.SessionIDs = active
+
+	//
+	// STEP 3: Append and save. The index lives as long as its longest
+	// session, a short-lived session must never shorten it.
+	//
+
+	index.SessionIDs = append(index.SessionIDs, sessionID)
+	if expiresAt := time.Now().Add(expiry); expiresAt.After(index.ExpiresAt) {
+		index.ExpiresAt = expiresAt
+	}
+	return index.save(ctx, uc.cache, userID)
+}
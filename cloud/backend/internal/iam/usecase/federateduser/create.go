@@ -9,6 +9,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type FederatedUserCreateUseCase interface {
@@ -26,6 +27,9 @@ func NewFederatedUserCreateUseCase(config *config.Configuration, logger *zap.Log
 }
 
 func (uc *userCreateUseCaseImpl) Execute(ctx context.Context, user *dom_user.FederatedUser) error {
+	ctx, span := tracing.Start(ctx, "UserCreateUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
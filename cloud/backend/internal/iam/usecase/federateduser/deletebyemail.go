@@ -9,6 +9,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type FederatedUserDeleteFederatedUserByEmailUseCase interface {
@@ -26,6 +27,9 @@ func NewFederatedUserDeleteFederatedUserByEmailUseCase(config *config.Configurat
 }
 
 func (uc *userDeleteFederatedUserByEmailImpl) Execute(ctx context.Context, email string) error {
+	ctx, span := tracing.Start(ctx, "UserDeleteFederatedUserByEmail.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
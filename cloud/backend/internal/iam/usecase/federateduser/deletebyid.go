@@ -11,6 +11,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type FederatedUserDeleteByIDUseCase interface {
@@ -28,6 +29,9 @@ func NewFederatedUserDeleteByIDUseCase(config *config.Configuration, logger *zap
 }
 
 func (uc *userDeleteByIDImpl) Execute(ctx context.Context, id primitive.ObjectID) error {
+	ctx, span := tracing.Start(ctx, "UserDeleteByID.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
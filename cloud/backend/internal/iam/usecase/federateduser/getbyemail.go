@@ -9,6 +9,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type FederatedUserGetByEmailUseCase interface {
@@ -26,6 +27,9 @@ func NewFederatedUserGetByEmailUseCase(config *config.Configuration, logger *zap
 }
 
 func (uc *userGetByEmailUseCaseImpl) Execute(ctx context.Context, email string) (*dom_user.FederatedUser, error) {
+	ctx, span := tracing.Start(ctx, "UserGetByEmailUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
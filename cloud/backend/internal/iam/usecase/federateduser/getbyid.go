@@ -11,6 +11,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type FederatedUserGetByIDUseCase interface {
@@ -28,6 +29,9 @@ func NewFederatedUserGetByIDUseCase(config *config.Configuration, logger *zap.Lo
 }
 
 func (uc *userGetByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) (*dom_user.FederatedUser, error) {
+	ctx, span := tracing.Start(ctx, "UserGetByIDUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
@@ -10,6 +10,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
 )
 
@@ -28,6 +29,9 @@ func NewFederatedUserGetBySessionIDUseCase(config *config.Configuration, logger
 }
 
 func (uc *userGetBySessionIDUseCaseImpl) Execute(ctx context.Context, sessionID string) (*dom_user.FederatedUser, error) {
+	ctx, span := tracing.Start(ctx, "UserGetBySessionIDUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
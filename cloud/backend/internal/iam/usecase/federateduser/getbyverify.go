@@ -8,6 +8,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type FederatedUserGetByVerificationCodeUseCase interface {
@@ -25,6 +26,9 @@ func NewFederatedUserGetByVerificationCodeUseCase(config *config.Configuration,
 }
 
 func (uc *userGetByVerificationCodeUseCaseImpl) Execute(ctx context.Context, verificationCode string) (*dom_user.FederatedUser, error) {
+	ctx, span := tracing.Start(ctx, "UserGetByVerificationCodeUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
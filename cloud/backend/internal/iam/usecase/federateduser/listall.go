@@ -7,6 +7,7 @@ import (
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type FederatedUserListAllUseCase interface {
@@ -32,6 +33,9 @@ func NewFederatedUserListAllUseCase(
 }
 
 func (uc *userListAllUseCaseImpl) Execute(ctx context.Context) ([]*dom_user.FederatedUser, error) {
+	ctx, span := tracing.Start(ctx, "UserListAllUseCase.Execute")
+	defer span.End()
+
 	uc.logger.Debug("executing list all users use case")
 
 	users, err := uc.repo.ListAll(ctx)
@@ -9,6 +9,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type FederatedUserListByFilterUseCase interface {
@@ -30,6 +31,9 @@ func NewFederatedUserListByFilterUseCase(
 }
 
 func (uc *userListByFilterUseCaseImpl) Execute(ctx context.Context, filter *dom_user.FederatedUserFilter) (*dom_user.FederatedUserFilterResult, error) {
+	ctx, span := tracing.Start(ctx, "UserListByFilterUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
@@ -0,0 +1,86 @@
+// github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser/revokesessions.go
+package federateduser
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/distributedmutex"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+)
+
+// FederatedUserRevokeSessionsUseCase deletes every session issued to the
+// federated user except `exceptSessionID`; pass an empty string to revoke all
+// sessions.
+type FederatedUserRevokeSessionsUseCase interface {
+	Execute(ctx context.Context, userID primitive.ObjectID, exceptSessionID string) error
+}
+
+type userRevokeSessionsUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	cache  mongodbcache.Cacher
+	dmutex distributedmutex.Adapter
+}
+
+func NewFederatedUserRevokeSessionsUseCase(config *config.Configuration, logger *zap.Logger, ca mongodbcache.Cacher, dmutex distributedmutex.Adapter) FederatedUserRevokeSessionsUseCase {
+	return &userRevokeSessionsUseCaseImpl{config, logger, ca, dmutex}
+}
+
+func (uc *userRevokeSessionsUseCaseImpl) Execute(ctx context.Context, userID primitive.ObjectID, exceptSessionID string) error {
+	ctx, span := tracing.Start(ctx, "UserRevokeSessionsUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if userID.IsZero() {
+		e["user_id"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for revoke sessions",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Load the index. No index means no active sessions.
+	//
+
+	unlock := lockSessionIndex(ctx, uc.dmutex, userID)
+	defer unlock()
+
+	index := loadSessionIndex(ctx, uc.cache, uc.logger, userID)
+	if len(index.SessionIDs) == 0 {
+		return nil
+	}
+
+	//
+	// STEP 3: Delete the sessions and keep only the excepted session.
+	//
+
+	remaining := make([]string, 0, 1)
+	for _, sessionID := range index.SessionIDs {
+		if exceptSessionID != "" && sessionID == exceptSessionID {
+			remaining = append(remaining, sessionID)
+			continue
+		}
+		if err := uc.cache.Delete(ctx, sessionID); err != nil {
+			uc.logger.Warn("Failed deleting session",
+				zap.String("user_id", userID.Hex()),
+				zap.Any("error", err))
+		}
+	}
+
+	// The index keeps its expiry, the excepted session may be the longest.
+	index.SessionIDs = remaining
+	return index.save(ctx, uc.cache, userID)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type FederatedUserUpdateUseCase interface {
@@ -25,6 +26,9 @@ func NewFederatedUserUpdateUseCase(config *config.Configuration, logger *zap.Log
 }
 
 func (uc *userUpdateUseCaseImpl) Execute(ctx context.Context, user *dom_user.FederatedUser) error {
+	ctx, span := tracing.Start(ctx, "UserUpdateUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
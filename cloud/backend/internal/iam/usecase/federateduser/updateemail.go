@@ -0,0 +1,70 @@
+package federateduser
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// FederatedUserUpdateEmailUseCase atomically changes the federated user's
+// email, failing when the new address belongs to another account.
+type FederatedUserUpdateEmailUseCase interface {
+	Execute(ctx context.Context, userID primitive.ObjectID, oldEmail, newEmail string) error
+}
+
+type userUpdateEmailUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_user.Repository
+}
+
+func NewFederatedUserUpdateEmailUseCase(config *config.Configuration, logger *zap.Logger, repo dom_user.Repository) FederatedUserUpdateEmailUseCase {
+	return &userUpdateEmailUseCaseImpl{config, logger, repo}
+}
+
+func (uc *userUpdateEmailUseCaseImpl) Execute(ctx context.Context, userID primitive.ObjectID, oldEmail, newEmail string) error {
+	ctx, span := tracing.Start(ctx, "UserUpdateEmailUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if userID.IsZero() {
+		e["user_id"] = "missing value"
+	}
+	if oldEmail == "" {
+		e["old_email"] = "missing value"
+	}
+	if newEmail == "" {
+		e["new_email"] = "missing value"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Validation failed for update email",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Update in database.
+	//
+
+	switch err := uc.repo.UpdateEmailByID(ctx, userID, oldEmail, newEmail); err {
+	case nil:
+		return nil
+	case dom_user.ErrEmailAlreadyExists:
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("newEmail", "Email address already exists"), httperror.CodeEmailAlreadyExists)
+	case dom_user.ErrEmailModified:
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("newEmail", "Email address was changed by another request"), httperror.CodeConflict)
+	default:
+		return err
+	}
+}
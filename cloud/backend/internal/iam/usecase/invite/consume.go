@@ -0,0 +1,65 @@
+package invite
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// InviteConsumeUseCase takes one use of the invite matching `code` for
+// `module`, returning a `400 Bad Request` error if the invite does not exist,
+// is for another module, has expired or has been used up.
+type InviteConsumeUseCase interface {
+	Execute(ctx context.Context, code string, module int) (*dom_invite.Invite, error)
+}
+
+type inviteConsumeUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_invite.Repository
+}
+
+func NewInviteConsumeUseCase(config *config.Configuration, logger *zap.Logger, repo dom_invite.Repository) InviteConsumeUseCase {
+	return &inviteConsumeUseCaseImpl{config, logger, repo}
+}
+
+func (uc *inviteConsumeUseCaseImpl) Execute(ctx context.Context, code string, module int) (*dom_invite.Invite, error) {
+	ctx, span := tracing.Start(ctx, "InviteConsumeUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	code = NormalizeCode(code)
+	if code == "" {
+		return nil, httperror.NewForBadRequestWithSingleField("beta_access_code", "Beta access code is required")
+	}
+
+	//
+	// STEP 2: Consume from database.
+	//
+
+	invite, err := uc.repo.ConsumeByCode(ctx, code, module, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if invite == nil {
+		uc.logger.Warn("Invite could not be consumed", zap.Int("module", module))
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("beta_access_code", "Invalid or expired beta access code"), httperror.CodeInvalidBetaAccessCode)
+	}
+	return invite, nil
+}
+
+// NormalizeCode returns the canonical form in which invite codes are stored
+// so codes are matched regardless of case or surrounding whitespace.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,64 @@
+package invite
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type InviteCreateUseCase interface {
+	Execute(ctx context.Context, invite *dom_invite.Invite) error
+}
+
+type inviteCreateUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_invite.Repository
+}
+
+func NewInviteCreateUseCase(config *config.Configuration, logger *zap.Logger, repo dom_invite.Repository) InviteCreateUseCase {
+	return &inviteCreateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *inviteCreateUseCaseImpl) Execute(ctx context.Context, invite *dom_invite.Invite) error {
+	ctx, span := tracing.Start(ctx, "InviteCreateUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if invite == nil {
+		e["invite"] = "Invite is required"
+	} else {
+		if invite.Code == "" {
+			e["code"] = "Code is required"
+		}
+		if invite.Module == 0 {
+			e["module"] = "Module is required"
+		}
+		if invite.MaxUses <= 0 {
+			e["max_uses"] = "Max uses must be greater than zero"
+		}
+		if invite.CreatedByUserID.IsZero() {
+			e["created_by_user_id"] = "Created by user ID is required"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Create(ctx, invite)
+}
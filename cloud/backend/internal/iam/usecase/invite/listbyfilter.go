@@ -0,0 +1,56 @@
+package invite
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invite "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type InviteListByFilterUseCase interface {
+	Execute(ctx context.Context, filter *dom_invite.InviteFilter) (*dom_invite.InviteFilterResult, error)
+}
+
+type inviteListByFilterUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_invite.Repository
+}
+
+func NewInviteListByFilterUseCase(config *config.Configuration, logger *zap.Logger, repo dom_invite.Repository) InviteListByFilterUseCase {
+	return &inviteListByFilterUseCaseImpl{config, logger, repo}
+}
+
+func (uc *inviteListByFilterUseCaseImpl) Execute(ctx context.Context, filter *dom_invite.InviteFilter) (*dom_invite.InviteFilterResult, error) {
+	ctx, span := tracing.Start(ctx, "InviteListByFilterUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if filter == nil {
+		e["filter"] = "Invite filter is required"
+	} else {
+		// Validate limit to prevent excessive data loads
+		if filter.Limit > 1000 {
+			filter.Limit = 1000
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating invite list by filter",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: List from database.
+	//
+
+	return uc.repo.ListByFilter(ctx, filter)
+}
@@ -4,19 +4,47 @@ package usecase
 import (
 	"go.uber.org/fx"
 
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/apikey"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/consentrecord"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/emailer"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/invite"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/blacklist"
 )
 
 func Module() fx.Option {
 	return fx.Options(
 		fx.Provide(
+			apikey.NewAPIKeyCreateUseCase,
+			apikey.NewAPIKeyGetByIDUseCase,
+			apikey.NewAPIKeyGetByKeyHashUseCase,
+			apikey.NewAPIKeyListByFederatedUserIDUseCase,
+			apikey.NewAPIKeyUpdateLastUsedUseCase,
+			apikey.NewAPIKeyDeleteByIDUseCase,
+			auditevent.NewAuditEventCreateUseCase,
+			auditevent.NewAuditEventListByFilterUseCase,
+			consentrecord.NewConsentRecordCreateUseCase,
+			consentrecord.NewConsentRecordListByFederatedUserIDUseCase,
 			bannedipaddress.NewCreateBannedIPAddressUseCase,
 			bannedipaddress.NewBannedIPAddressListAllValuesUseCase,
+			bannedipaddress.NewBannedIPAddressGetByIDUseCase,
+			bannedipaddress.NewBannedIPAddressGetByValueUseCase,
+			bannedipaddress.NewBannedIPAddressListByFilterUseCase,
+			bannedipaddress.NewBannedIPAddressDeleteByIDUseCase,
+			blacklist.AsSource(bannedipaddress.NewBlacklistSource),
 			emailer.NewSendFederatedUserPasswordResetEmailUseCase,
 			emailer.NewSendFederatedUserVerificationEmailUseCase,
 			emailer.NewSendLoginOTTEmailUseCase,
+			emailer.NewSendEmailChangeCodeEmailUseCase,
+			emailer.NewSendEmailChangeNoticeEmailUseCase,
+			emailer.NewSendOrganizationInvitationEmailUseCase,
+			emailer.NewSendNewLoginAlertEmailUseCase,
 			federateduser.NewFederatedUserGetBySessionIDUseCase,
 			federateduser.NewFederatedUserCountByFilterUseCase,
 			federateduser.NewFederatedUserCreateUseCase,
@@ -28,6 +56,32 @@ func Module() fx.Option {
 			federateduser.NewFederatedUserListAllUseCase,
 			federateduser.NewFederatedUserListByFilterUseCase,
 			federateduser.NewFederatedUserUpdateUseCase,
+			federateduser.NewFederatedUserUpdateEmailUseCase,
+			federateduser.NewFederatedUserAddSessionUseCase,
+			federateduser.NewFederatedUserRevokeSessionsUseCase,
+			invite.NewInviteCreateUseCase,
+			invite.NewInviteListByFilterUseCase,
+			invite.NewInviteConsumeUseCase,
+			oauthclient.NewOAuthClientCreateUseCase,
+			oauthclient.NewOAuthClientGetByClientIDUseCase,
+			oauthclient.NewOAuthClientListAllUseCase,
+			oauthclient.NewOAuthClientDeleteByClientIDUseCase,
+			organization.NewOrganizationCreateUseCase,
+			organization.NewOrganizationGetByIDUseCase,
+			organization.NewOrganizationListByIDsUseCase,
+			organization.NewOrganizationUpdateUseCase,
+			organizationmember.NewOrganizationMemberCreateUseCase,
+			organizationmember.NewOrganizationMemberGetByOrganizationIDAndUserIDUseCase,
+			organizationmember.NewOrganizationMemberListByOrganizationIDUseCase,
+			organizationmember.NewOrganizationMemberListByUserIDUseCase,
+			organizationmember.NewOrganizationMemberCountByRoleUseCase,
+			organizationmember.NewOrganizationMemberUpdateRoleUseCase,
+			organizationmember.NewOrganizationMemberDeleteByIDUseCase,
+			organizationinvitation.NewOrganizationInvitationCreateUseCase,
+			organizationinvitation.NewOrganizationInvitationGetByIDUseCase,
+			organizationinvitation.NewOrganizationInvitationGetByTokenHashUseCase,
+			organizationinvitation.NewOrganizationInvitationListByOrganizationIDUseCase,
+			organizationinvitation.NewOrganizationInvitationDeleteByIDUseCase,
 		),
 	)
 }
@@ -0,0 +1,61 @@
+package oauthclient
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OAuthClientCreateUseCase interface {
+	Execute(ctx context.Context, client *dom_oauthclient.OAuthClient) error
+}
+
+type oauthClientCreateUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_oauthclient.Repository
+}
+
+func NewOAuthClientCreateUseCase(config *config.Configuration, logger *zap.Logger, repo dom_oauthclient.Repository) OAuthClientCreateUseCase {
+	return &oauthClientCreateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *oauthClientCreateUseCaseImpl) Execute(ctx context.Context, client *dom_oauthclient.OAuthClient) error {
+	ctx, span := tracing.Start(ctx, "OauthClientCreateUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if client == nil {
+		e["client"] = "OAuth client is required"
+	} else {
+		if client.ClientID == "" {
+			e["client_id"] = "Client ID is required"
+		}
+		if !client.IsPublic && client.ClientSecretHash == "" {
+			e["client_secret_hash"] = "Client secret hash is required for confidential clients"
+		}
+		if len(client.RedirectURIs) == 0 {
+			e["redirect_uris"] = "At least one redirect URI is required"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Create(ctx, client)
+}
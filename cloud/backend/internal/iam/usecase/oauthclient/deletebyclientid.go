@@ -0,0 +1,51 @@
+package oauthclient
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OAuthClientDeleteByClientIDUseCase interface {
+	Execute(ctx context.Context, clientID string) error
+}
+
+type oauthClientDeleteByClientIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_oauthclient.Repository
+}
+
+func NewOAuthClientDeleteByClientIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_oauthclient.Repository) OAuthClientDeleteByClientIDUseCase {
+	return &oauthClientDeleteByClientIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *oauthClientDeleteByClientIDUseCaseImpl) Execute(ctx context.Context, clientID string) error {
+	ctx, span := tracing.Start(ctx, "OauthClientDeleteByClientIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if clientID == "" {
+		e["client_id"] = "Client ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Delete from database.
+	//
+
+	return uc.repo.DeleteByClientID(ctx, clientID)
+}
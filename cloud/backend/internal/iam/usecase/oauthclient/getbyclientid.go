@@ -0,0 +1,51 @@
+package oauthclient
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OAuthClientGetByClientIDUseCase interface {
+	Execute(ctx context.Context, clientID string) (*dom_oauthclient.OAuthClient, error)
+}
+
+type oauthClientGetByClientIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_oauthclient.Repository
+}
+
+func NewOAuthClientGetByClientIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_oauthclient.Repository) OAuthClientGetByClientIDUseCase {
+	return &oauthClientGetByClientIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *oauthClientGetByClientIDUseCaseImpl) Execute(ctx context.Context, clientID string) (*dom_oauthclient.OAuthClient, error) {
+	ctx, span := tracing.Start(ctx, "OauthClientGetByClientIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if clientID == "" {
+		e["client_id"] = "Client ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByClientID(ctx, clientID)
+}
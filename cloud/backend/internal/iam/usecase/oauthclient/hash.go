@@ -0,0 +1,21 @@
+package oauthclient
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+)
+
+// HashClientSecret returns the value we persist for a plaintext client
+// secret. Secrets are long random strings so a single fast hash is
+// sufficient.
+func HashClientSecret(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+// CompareClientSecret returns true if `plaintext` hashes to `hash` using a
+// constant time comparison.
+func CompareClientSecret(hash, plaintext string) bool {
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(HashClientSecret(plaintext))) == 1
+}
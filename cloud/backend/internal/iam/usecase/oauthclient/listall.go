@@ -0,0 +1,32 @@
+package oauthclient
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_oauthclient "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/oauthclient"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OAuthClientListAllUseCase interface {
+	Execute(ctx context.Context) ([]*dom_oauthclient.OAuthClient, error)
+}
+
+type oauthClientListAllUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_oauthclient.Repository
+}
+
+func NewOAuthClientListAllUseCase(config *config.Configuration, logger *zap.Logger, repo dom_oauthclient.Repository) OAuthClientListAllUseCase {
+	return &oauthClientListAllUseCaseImpl{config, logger, repo}
+}
+
+func (uc *oauthClientListAllUseCaseImpl) Execute(ctx context.Context) ([]*dom_oauthclient.OAuthClient, error) {
+	ctx, span := tracing.Start(ctx, "OauthClientListAllUseCase.Execute")
+	defer span.End()
+
+	return uc.repo.ListAll(ctx)
+}
@@ -0,0 +1,58 @@
+package organization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationCreateUseCase interface {
+	Execute(ctx context.Context, organization *dom_organization.Organization) error
+}
+
+type organizationCreateUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_organization.Repository
+}
+
+func NewOrganizationCreateUseCase(config *config.Configuration, logger *zap.Logger, repo dom_organization.Repository) OrganizationCreateUseCase {
+	return &organizationCreateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationCreateUseCaseImpl) Execute(ctx context.Context, organization *dom_organization.Organization) error {
+	ctx, span := tracing.Start(ctx, "OrganizationCreateUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if organization == nil {
+		e["organization"] = "Organization is required"
+	} else {
+		if organization.Name == "" {
+			e["name"] = "Name is required"
+		}
+		if organization.CreatedByUserID.IsZero() {
+			e["created_by_user_id"] = "Created by user ID is required"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Create(ctx, organization)
+}
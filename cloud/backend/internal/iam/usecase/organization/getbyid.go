@@ -0,0 +1,53 @@
+package organization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationGetByIDUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID) (*dom_organization.Organization, error)
+}
+
+type organizationGetByIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_organization.Repository
+}
+
+func NewOrganizationGetByIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_organization.Repository) OrganizationGetByIDUseCase {
+	return &organizationGetByIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationGetByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) (*dom_organization.Organization, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationGetByIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "Organization ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByID(ctx, id)
+}
@@ -0,0 +1,53 @@
+package organization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationListByIDsUseCase interface {
+	Execute(ctx context.Context, ids []primitive.ObjectID) ([]*dom_organization.Organization, error)
+}
+
+type organizationListByIDsUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_organization.Repository
+}
+
+func NewOrganizationListByIDsUseCase(config *config.Configuration, logger *zap.Logger, repo dom_organization.Repository) OrganizationListByIDsUseCase {
+	return &organizationListByIDsUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationListByIDsUseCaseImpl) Execute(ctx context.Context, ids []primitive.ObjectID) ([]*dom_organization.Organization, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationListByIDsUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if ids == nil {
+		e["ids"] = "Organization IDs are required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.ListByIDs(ctx, ids)
+}
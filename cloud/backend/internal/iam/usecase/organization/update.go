@@ -0,0 +1,53 @@
+package organization
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_organization "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organization"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationUpdateUseCase interface {
+	Execute(ctx context.Context, organization *dom_organization.Organization) error
+}
+
+type organizationUpdateUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_organization.Repository
+}
+
+func NewOrganizationUpdateUseCase(config *config.Configuration, logger *zap.Logger, repo dom_organization.Repository) OrganizationUpdateUseCase {
+	return &organizationUpdateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationUpdateUseCaseImpl) Execute(ctx context.Context, organization *dom_organization.Organization) error {
+	ctx, span := tracing.Start(ctx, "OrganizationUpdateUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if organization == nil {
+		e["organization"] = "Organization is required"
+	} else if organization.Name == "" {
+		e["name"] = "Name is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Update database.
+	//
+
+	return uc.repo.UpdateByID(ctx, organization)
+}
@@ -0,0 +1,64 @@
+package organizationinvitation
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationInvitationCreateUseCase interface {
+	Execute(ctx context.Context, invitation *dom_invitation.OrganizationInvitation) error
+}
+
+type organizationInvitationCreateUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_invitation.Repository
+}
+
+func NewOrganizationInvitationCreateUseCase(config *config.Configuration, logger *zap.Logger, repo dom_invitation.Repository) OrganizationInvitationCreateUseCase {
+	return &organizationInvitationCreateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationInvitationCreateUseCaseImpl) Execute(ctx context.Context, invitation *dom_invitation.OrganizationInvitation) error {
+	ctx, span := tracing.Start(ctx, "OrganizationInvitationCreateUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if invitation == nil {
+		e["invitation"] = "Invitation is required"
+	} else {
+		if invitation.OrganizationID.IsZero() {
+			e["organization_id"] = "Organization ID is required"
+		}
+		if invitation.Email == "" {
+			e["email"] = "Email is required"
+		}
+		if invitation.TokenHash == "" {
+			e["token_hash"] = "Token hash is required"
+		}
+		if invitation.ExpiresAt.IsZero() {
+			e["expires_at"] = "Expiry is required"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Create(ctx, invitation)
+}
@@ -0,0 +1,53 @@
+package organizationinvitation
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationInvitationDeleteByIDUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID) error
+}
+
+type organizationInvitationDeleteByIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_invitation.Repository
+}
+
+func NewOrganizationInvitationDeleteByIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_invitation.Repository) OrganizationInvitationDeleteByIDUseCase {
+	return &organizationInvitationDeleteByIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationInvitationDeleteByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) error {
+	ctx, span := tracing.Start(ctx, "OrganizationInvitationDeleteByIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "Invitation ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Delete from database.
+	//
+
+	return uc.repo.DeleteByID(ctx, id)
+}
@@ -0,0 +1,53 @@
+package organizationinvitation
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationInvitationGetByIDUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID) (*dom_invitation.OrganizationInvitation, error)
+}
+
+type organizationInvitationGetByIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_invitation.Repository
+}
+
+func NewOrganizationInvitationGetByIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_invitation.Repository) OrganizationInvitationGetByIDUseCase {
+	return &organizationInvitationGetByIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationInvitationGetByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) (*dom_invitation.OrganizationInvitation, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationInvitationGetByIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "Invitation ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByID(ctx, id)
+}
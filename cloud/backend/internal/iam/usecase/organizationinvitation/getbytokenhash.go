@@ -0,0 +1,51 @@
+package organizationinvitation
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationInvitationGetByTokenHashUseCase interface {
+	Execute(ctx context.Context, tokenHash string) (*dom_invitation.OrganizationInvitation, error)
+}
+
+type organizationInvitationGetByTokenHashUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_invitation.Repository
+}
+
+func NewOrganizationInvitationGetByTokenHashUseCase(config *config.Configuration, logger *zap.Logger, repo dom_invitation.Repository) OrganizationInvitationGetByTokenHashUseCase {
+	return &organizationInvitationGetByTokenHashUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationInvitationGetByTokenHashUseCaseImpl) Execute(ctx context.Context, tokenHash string) (*dom_invitation.OrganizationInvitation, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationInvitationGetByTokenHashUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if tokenHash == "" {
+		e["token"] = "Token is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByTokenHash(ctx, tokenHash)
+}
@@ -0,0 +1,53 @@
+package organizationinvitation
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_invitation "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationinvitation"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationInvitationListByOrganizationIDUseCase interface {
+	Execute(ctx context.Context, organizationID primitive.ObjectID) ([]*dom_invitation.OrganizationInvitation, error)
+}
+
+type organizationInvitationListByOrganizationIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_invitation.Repository
+}
+
+func NewOrganizationInvitationListByOrganizationIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_invitation.Repository) OrganizationInvitationListByOrganizationIDUseCase {
+	return &organizationInvitationListByOrganizationIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationInvitationListByOrganizationIDUseCaseImpl) Execute(ctx context.Context, organizationID primitive.ObjectID) ([]*dom_invitation.OrganizationInvitation, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationInvitationListByOrganizationIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if organizationID.IsZero() {
+		e["organization_id"] = "Organization ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.ListByOrganizationID(ctx, organizationID)
+}
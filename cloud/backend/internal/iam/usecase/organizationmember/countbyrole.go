@@ -0,0 +1,56 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationMemberCountByRoleUseCase interface {
+	Execute(ctx context.Context, organizationID primitive.ObjectID, role int8) (int64, error)
+}
+
+type organizationMemberCountByRoleUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_member.Repository
+}
+
+func NewOrganizationMemberCountByRoleUseCase(config *config.Configuration, logger *zap.Logger, repo dom_member.Repository) OrganizationMemberCountByRoleUseCase {
+	return &organizationMemberCountByRoleUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationMemberCountByRoleUseCaseImpl) Execute(ctx context.Context, organizationID primitive.ObjectID, role int8) (int64, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberCountByRoleUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if organizationID.IsZero() {
+		e["organization_id"] = "Organization ID is required"
+	}
+	if !dom_member.IsValidRole(role) {
+		e["role"] = "Role is invalid"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return 0, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Count in database.
+	//
+
+	return uc.repo.CountByOrganizationIDAndRole(ctx, organizationID, role)
+}
@@ -0,0 +1,61 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationMemberCreateUseCase interface {
+	Execute(ctx context.Context, member *dom_member.OrganizationMember) error
+}
+
+type organizationMemberCreateUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_member.Repository
+}
+
+func NewOrganizationMemberCreateUseCase(config *config.Configuration, logger *zap.Logger, repo dom_member.Repository) OrganizationMemberCreateUseCase {
+	return &organizationMemberCreateUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationMemberCreateUseCaseImpl) Execute(ctx context.Context, member *dom_member.OrganizationMember) error {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberCreateUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if member == nil {
+		e["member"] = "Member is required"
+	} else {
+		if member.OrganizationID.IsZero() {
+			e["organization_id"] = "Organization ID is required"
+		}
+		if member.FederatedUserID.IsZero() {
+			e["federated_user_id"] = "Federated user ID is required"
+		}
+		if !dom_member.IsValidRole(member.Role) {
+			e["role"] = "Role is invalid"
+		}
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Insert into database.
+	//
+
+	return uc.repo.Create(ctx, member)
+}
@@ -0,0 +1,53 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationMemberDeleteByIDUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID) error
+}
+
+type organizationMemberDeleteByIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_member.Repository
+}
+
+func NewOrganizationMemberDeleteByIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_member.Repository) OrganizationMemberDeleteByIDUseCase {
+	return &organizationMemberDeleteByIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationMemberDeleteByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) error {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberDeleteByIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "Member ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Delete from database.
+	//
+
+	return uc.repo.DeleteByID(ctx, id)
+}
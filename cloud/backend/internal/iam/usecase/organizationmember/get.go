@@ -0,0 +1,56 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationMemberGetByOrganizationIDAndUserIDUseCase interface {
+	Execute(ctx context.Context, organizationID, userID primitive.ObjectID) (*dom_member.OrganizationMember, error)
+}
+
+type organizationMemberGetByOrganizationIDAndUserIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_member.Repository
+}
+
+func NewOrganizationMemberGetByOrganizationIDAndUserIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_member.Repository) OrganizationMemberGetByOrganizationIDAndUserIDUseCase {
+	return &organizationMemberGetByOrganizationIDAndUserIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationMemberGetByOrganizationIDAndUserIDUseCaseImpl) Execute(ctx context.Context, organizationID, userID primitive.ObjectID) (*dom_member.OrganizationMember, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberGetByOrganizationIDAndUserIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if organizationID.IsZero() {
+		e["organization_id"] = "Organization ID is required"
+	}
+	if userID.IsZero() {
+		e["federated_user_id"] = "Federated user ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.GetByOrganizationIDAndUserID(ctx, organizationID, userID)
+}
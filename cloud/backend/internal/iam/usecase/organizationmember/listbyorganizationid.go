@@ -0,0 +1,53 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationMemberListByOrganizationIDUseCase interface {
+	Execute(ctx context.Context, organizationID primitive.ObjectID) ([]*dom_member.OrganizationMember, error)
+}
+
+type organizationMemberListByOrganizationIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_member.Repository
+}
+
+func NewOrganizationMemberListByOrganizationIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_member.Repository) OrganizationMemberListByOrganizationIDUseCase {
+	return &organizationMemberListByOrganizationIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationMemberListByOrganizationIDUseCaseImpl) Execute(ctx context.Context, organizationID primitive.ObjectID) ([]*dom_member.OrganizationMember, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberListByOrganizationIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if organizationID.IsZero() {
+		e["organization_id"] = "Organization ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.ListByOrganizationID(ctx, organizationID)
+}
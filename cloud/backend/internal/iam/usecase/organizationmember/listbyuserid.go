@@ -0,0 +1,53 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationMemberListByUserIDUseCase interface {
+	Execute(ctx context.Context, userID primitive.ObjectID) ([]*dom_member.OrganizationMember, error)
+}
+
+type organizationMemberListByUserIDUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_member.Repository
+}
+
+func NewOrganizationMemberListByUserIDUseCase(config *config.Configuration, logger *zap.Logger, repo dom_member.Repository) OrganizationMemberListByUserIDUseCase {
+	return &organizationMemberListByUserIDUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationMemberListByUserIDUseCaseImpl) Execute(ctx context.Context, userID primitive.ObjectID) ([]*dom_member.OrganizationMember, error) {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberListByUserIDUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if userID.IsZero() {
+		e["federated_user_id"] = "Federated user ID is required"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return nil, httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Get from database.
+	//
+
+	return uc.repo.ListByUserID(ctx, userID)
+}
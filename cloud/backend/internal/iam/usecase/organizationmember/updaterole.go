@@ -0,0 +1,56 @@
+package organizationmember
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+type OrganizationMemberUpdateRoleUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID, role int8) error
+}
+
+type organizationMemberUpdateRoleUseCaseImpl struct {
+	config *config.Configuration
+	logger *zap.Logger
+	repo   dom_member.Repository
+}
+
+func NewOrganizationMemberUpdateRoleUseCase(config *config.Configuration, logger *zap.Logger, repo dom_member.Repository) OrganizationMemberUpdateRoleUseCase {
+	return &organizationMemberUpdateRoleUseCaseImpl{config, logger, repo}
+}
+
+func (uc *organizationMemberUpdateRoleUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID, role int8) error {
+	ctx, span := tracing.Start(ctx, "OrganizationMemberUpdateRoleUseCase.Execute")
+	defer span.End()
+
+	//
+	// STEP 1: Validation.
+	//
+
+	e := make(map[string]string)
+	if id.IsZero() {
+		e["id"] = "Member ID is required"
+	}
+	if !dom_member.IsValidRole(role) {
+		e["role"] = "Role is invalid"
+	}
+	if len(e) != 0 {
+		uc.logger.Warn("Failed validating",
+			zap.Any("error", e))
+		return httperror.NewForBadRequest(&e)
+	}
+
+	//
+	// STEP 2: Update database.
+	//
+
+	return uc.repo.UpdateRoleByID(ctx, id, role)
+}
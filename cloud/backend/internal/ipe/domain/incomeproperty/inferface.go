@@ -0,0 +1,26 @@
+package incomeproperty
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Scope restricts the lookups to the properties a user may access: the
+// personal ones they created and the ones owned by the organizations they
+// are a member of.
+type Scope struct {
+	UserID          primitive.ObjectID
+	OrganizationIDs []primitive.ObjectID
+}
+
+// Repository defines methods for income property storage operations
+type Repository interface {
+	FindByID(ctx context.Context, scope *Scope, id primitive.ObjectID) (*IncomeProperty, error)
+	FindAll(ctx context.Context, scope *Scope) ([]*IncomeProperty, error)
+	Create(ctx context.Context, property *IncomeProperty) (primitive.ObjectID, error)
+	Update(ctx context.Context, scope *Scope, property *IncomeProperty) error
+	Delete(ctx context.Context, scope *Scope, id primitive.ObjectID) error
+	FindByAddress(ctx context.Context, scope *Scope, address string) ([]*IncomeProperty, error)
+	FindByCity(ctx context.Context, scope *Scope, city string) ([]*IncomeProperty, error)
+}
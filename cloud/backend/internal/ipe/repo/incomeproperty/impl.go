@@ -0,0 +1,147 @@
+package incomeproperty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+
+	dom "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/ipe/domain/incomeproperty"
+)
+
+type mongoRepository struct {
+	db                 *mongo.Database
+	logger             *zap.Logger
+	propertyCollection *mongo.Collection
+}
+
+// NewMongoRepository creates a new MongoDB repository for the Income Property Evaluator
+func NewMongoRepository(db *mongo.Database, logger *zap.Logger) dom.Repository {
+	return &mongoRepository{
+		db:                 db,
+		logger:             logger,
+		propertyCollection: db.Collection("income_properties"),
+	}
+}
+
+// scoped restricts `filter` to the properties of `scope`: the personal ones
+// of the user and the ones owned by their organizations.
+func scoped(scope *dom.Scope, filter bson.M) bson.M {
+	owners := bson.A{bson.M{
+		"userId":         scope.UserID,
+		"organizationId": bson.M{"$exists": false},
+	}}
+	if len(scope.OrganizationIDs) > 0 {
+		owners = append(owners, bson.M{"organizationId": bson.M{"$in": scope.OrganizationIDs}})
+	}
+	filter["$or"] = owners
+	return filter
+}
+
+// Find a property by ID
+func (r *mongoRepository) FindByID(ctx context.Context, scope *dom.Scope, id primitive.ObjectID) (*dom.IncomeProperty, error) {
+	property := &dom.IncomeProperty{}
+	err := r.propertyCollection.FindOne(ctx, scoped(scope, bson.M{"_id": id})).Decode(property)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("property not found: %w", err)
+		}
+		return nil, fmt.Errorf("error finding property: %w", err)
+	}
+	return property, nil
+}
+
+// Find all properties
+func (r *mongoRepository) FindAll(ctx context.Context, scope *dom.Scope) ([]*dom.IncomeProperty, error) {
+	cursor, err := r.propertyCollection.Find(ctx, scoped(scope, bson.M{}))
+	if err != nil {
+		return nil, fmt.Errorf("error finding properties: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	properties := []*dom.IncomeProperty{}
+	if err := cursor.All(ctx, &properties); err != nil {
+		return nil, fmt.Errorf("error decoding properties: %w", err)
+	}
+	return properties, nil
+}
+
+// Create a property
+func (r *mongoRepository) Create(ctx context.Context, property *dom.IncomeProperty) (primitive.ObjectID, error) {
+	if property.ID.IsZero() {
+		property.ID = primitive.NewObjectID()
+	}
+
+	result, err := r.propertyCollection.InsertOne(ctx, property)
+	if err != nil {
+		return primitive.NilObjectID, fmt.Errorf("error saving property: %w", err)
+	}
+
+	return result.InsertedID.(primitive.ObjectID), nil
+}
+
+// Update a property
+func (r *mongoRepository) Update(ctx context.Context, scope *dom.Scope, property *dom.IncomeProperty) error {
+	if property.ID.IsZero() {
+		return errors.New("property ID is required for update")
+	}
+
+	result, err := r.propertyCollection.ReplaceOne(ctx, scoped(scope, bson.M{"_id": property.ID}), property)
+	if err != nil {
+		return fmt.Errorf("error updating property: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("property not found with ID: %s", property.ID.Hex())
+	}
+
+	return nil
+}
+
+// Delete a property
+func (r *mongoRepository) Delete(ctx context.Context, scope *dom.Scope, id primitive.ObjectID) error {
+	result, err := r.propertyCollection.DeleteOne(ctx, scoped(scope, bson.M{"_id": id}))
+	if err != nil {
+		return fmt.Errorf("error deleting property: %w", err)
+	}
+
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("property not found with ID: %s", id.Hex())
+	}
+
+	return nil
+}
+
+// Find properties by address
+func (r *mongoRepository) FindByAddress(ctx context.Context, scope *dom.Scope, address string) ([]*dom.IncomeProperty, error) {
+	cursor, err := r.propertyCollection.Find(ctx, scoped(scope, bson.M{"address": bson.M{"$regex": address, "$options": "i"}}))
+	if err != nil {
+		return nil, fmt.Errorf("error finding properties by address: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	properties := []*dom.IncomeProperty{}
+	if err := cursor.All(ctx, &properties); err != nil {
+		return nil, fmt.Errorf("error decoding properties: %w", err)
+	}
+	return properties, nil
+}
+
+// Find properties by city
+func (r *mongoRepository) FindByCity(ctx context.Context, scope *dom.Scope, city string) ([]*dom.IncomeProperty, error) {
+	cursor, err := r.propertyCollection.Find(ctx, scoped(scope, bson.M{"city": bson.M{"$regex": city, "$options": "i"}}))
+	if err != nil {
+		return nil, fmt.Errorf("error finding properties by city: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	properties := []*dom.IncomeProperty{}
+	if err := cursor.All(ctx, &properties); err != nil {
+		return nil, fmt.Errorf("error decoding properties: %w", err)
+	}
+	return properties, nil
+}
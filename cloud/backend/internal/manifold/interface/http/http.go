@@ -3,39 +3,139 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"net"
 	"net/http"
+	"sync/atomic"
+	"time"
 
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/manifold/interface/http/middleware"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/certreloader"
 )
 
+// inFlightHandler counts the requests being served so the drain on shutdown
+// can report them.
+type inFlightHandler struct {
+	next  http.Handler
+	count atomic.Int64
+}
+
+func (h *inFlightHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.count.Add(1)
+	defer h.count.Add(-1)
+	h.next.ServeHTTP(w, r)
+}
+
 func NewUnifiedHTTPServer(
 	lc fx.Lifecycle,
+	shutdowner fx.Shutdowner,
 	log *zap.Logger,
 	config *config.Configuration,
 	mux *http.ServeMux,
 	mw middleware.Middleware, // Add middleware dependency
-) *http.Server {
-	srv := &http.Server{Addr: ":8000", Handler: mux}
+) (*http.Server, error) {
+	handler := &inFlightHandler{next: mux}
+	srv := &http.Server{
+		Addr:              net.JoinHostPort(config.App.IP, config.App.Port),
+		Handler:           handler,
+		ReadHeaderTimeout: time.Duration(config.App.HTTPReadHeaderTimeoutSeconds) * time.Second,
+		ReadTimeout:       time.Duration(config.App.HTTPReadTimeoutSeconds) * time.Second,
+		WriteTimeout:      time.Duration(config.App.HTTPWriteTimeoutSeconds) * time.Second,
+		IdleTimeout:       time.Duration(config.App.HTTPIdleTimeoutSeconds) * time.Second,
+		MaxHeaderBytes:    config.App.HTTPMaxHeaderBytes,
+		ErrorLog:          zap.NewStdLog(log.Named("http")),
+	}
+
+	if config.App.HTTPH2CEnabled {
+		protocols := new(http.Protocols)
+		protocols.SetHTTP1(true)
+		protocols.SetHTTP2(true)
+		protocols.SetUnencryptedHTTP2(true)
+		srv.Protocols = protocols
+	}
+
+	// Serve TLS natively when a certificate is configured, the certificate is
+	// reloaded when renewed so no restart is needed.
+	var certs *certreloader.Reloader
+	if config.App.TLSCertFile != "" || config.App.TLSKeyFile != "" {
+		if config.App.TLSCertFile == "" || config.App.TLSKeyFile == "" {
+			return nil, errors.New("both the tls certificate and key files must be set")
+		}
+		var err error
+		certs, err = certreloader.New(config.App.TLSCertFile, config.App.TLSKeyFile, log)
+		if err != nil {
+			return nil, err
+		}
+		srv.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certs.GetCertificate,
+		}
+	}
+
+	drainTimeout := time.Duration(config.App.HTTPShutdownSeconds) * time.Second
+
 	lc.Append(fx.Hook{
 		OnStart: func(ctx context.Context) error {
 			ln, err := net.Listen("tcp", srv.Addr)
 			if err != nil {
 				return err
 			}
-			log.Info("Starting HTTP server", zap.String("addr", srv.Addr))
-			go srv.Serve(ln)
+			log.Info("Starting HTTP server",
+				zap.String("addr", srv.Addr),
+				zap.Bool("tls", certs != nil),
+				zap.Bool("h2c", config.App.HTTPH2CEnabled))
+
+			if certs != nil && config.App.TLSReloadSeconds > 0 {
+				go certs.Watch(time.Duration(config.App.TLSReloadSeconds) * time.Second)
+			}
+
+			go func() {
+				var err error
+				if certs != nil {
+					err = srv.ServeTLS(ln, "", "")
+				} else {
+					err = srv.Serve(ln)
+				}
+				if err != nil && !errors.Is(err, http.ErrServerClosed) {
+					// Without the server the application is useless, stop it
+					// so it gets restarted instead of running unreachable.
+					log.Error("HTTP server stopped unexpectedly", zap.Error(err))
+					shutdowner.Shutdown(fx.ExitCode(1))
+				}
+			}()
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
+			// Stop accepting connections and wait for the in-flight requests,
+			// up to the drain timeout, before releasing what they depend on.
+			log.Info("Draining HTTP server",
+				zap.Int64("in_flight_requests", handler.count.Load()),
+				zap.Duration("timeout", drainTimeout))
+
+			drainCtx, cancel := context.WithTimeout(ctx, drainTimeout)
+			defer cancel()
+			if err := srv.Shutdown(drainCtx); err != nil {
+				log.Warn("HTTP server drain timed out, closing remaining connections",
+					zap.Int64("in_flight_requests", handler.count.Load()),
+					zap.Error(err))
+				srv.Close()
+			} else {
+				log.Info("HTTP server drained")
+			}
+
+			if certs != nil {
+				certs.Close()
+			}
+
 			// Properly shutdown middleware
 			mw.Shutdown()
-			return srv.Shutdown(ctx)
+			return nil
 		},
 	})
-	return srv
+	return srv, nil
 }
@@ -0,0 +1,83 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+)
+
+// curl http://localhost:8000/metrics
+//
+// GetMetricsHTTPHandler exposes the metrics on the main server, it responds
+// `404 Not Found` when metrics are disabled or served on the admin listener.
+type GetMetricsHTTPHandler struct {
+	enabled bool
+	handler http.Handler
+}
+
+func NewGetMetricsHTTPHandler(
+	config *config.Configuration,
+) *GetMetricsHTTPHandler {
+	return &GetMetricsHTTPHandler{
+		enabled: config.App.MetricsEnabled && config.App.MetricsAddress == "",
+		handler: metrics.Handler(),
+	}
+}
+
+func (h *GetMetricsHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.enabled {
+		http.NotFound(w, r)
+		return
+	}
+	h.handler.ServeHTTP(w, r)
+}
+
+func (*GetMetricsHTTPHandler) Pattern() string {
+	return "GET /metrics"
+}
+
+// StartMetricsServer serves the metrics on the admin listener when one is
+// configured, keeping them off the public port.
+func StartMetricsServer(
+	lc fx.Lifecycle,
+	log *zap.Logger,
+	config *config.Configuration,
+) {
+	if !config.App.MetricsEnabled || config.App.MetricsAddress == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+	srv := &http.Server{
+		Addr:              config.App.MetricsAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			ln, err := net.Listen("tcp", srv.Addr)
+			if err != nil {
+				return err
+			}
+			log.Info("Starting metrics server", zap.String("addr", srv.Addr))
+			go func() {
+				if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					log.Error("Metrics server stopped", zap.Error(err))
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return srv.Shutdown(ctx)
+		},
+	})
+}
@@ -6,6 +6,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
 )
 
 // Note: This middleware must have `IPAddressMiddleware` executed first before running.
@@ -28,13 +31,14 @@ func (mid *middleware) EnforceBlacklistMiddleware(next http.HandlerFunc) http.Ha
 			// then don't bother printing to console. The purpose of this code
 			// is to not clog the console log with warnings.
 			if !mid.Blacklist.IsBannedURL(r.URL.Path) {
-				mid.Logger.Warn("rejected request by ip",
+				logging.Logger(ctx, mid.Logger).Warn("rejected request by ip",
 					zap.Any("url", r.URL.Path),
 					zap.String("ip_address", ipAddress),
 					zap.String("proxies", proxies),
 					zap.Any("middleware", "EnforceBlacklistMiddleware"))
 			}
-			http.Error(w, "forbidden at this time", http.StatusForbidden)
+			metrics.IncBlocked(metrics.BlockReasonBannedIP)
+			httperror.ResponseError(w, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "forbidden at this time"), httperror.CodeIPAddressBanned))
 			return
 		}
 
@@ -47,17 +51,22 @@ func (mid *middleware) EnforceBlacklistMiddleware(next http.HandlerFunc) http.Ha
 			// the offending client IP address has been banned before. The
 			// purpose of this code is to not clog the console log with warnings.
 			if !mid.Blacklist.IsBannedIPAddress(ipAddress) {
-				mid.Logger.Warn("rejected request by url",
+				logging.Logger(ctx, mid.Logger).Warn("rejected request by url",
 					zap.Any("url", r.URL.Path),
 					zap.String("ip_address", ipAddress),
 					zap.String("proxies", proxies),
 					zap.Any("middleware", "EnforceBlacklistMiddleware"))
+
+				// Clients probing for banned URLs are banned temporarily
+				// once they reach the configured threshold.
+				mid.Blacklist.ReportAbuse(ctx, ipAddress)
 			}
 
 			// DEVELOPERS NOTE:
 			// Simply return a 404, but in our console log we can see the IP
 			// address whom made this call.
-			http.Error(w, "does not exist at this time", http.StatusNotFound)
+			metrics.IncBlocked(metrics.BlockReasonBannedURL)
+			httperror.ResponseError(w, httperror.NewForNotFoundWithSingleField("message", "does not exist at this time"))
 			return
 		}
 
@@ -0,0 +1,17 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+)
+
+// ErrorFormatMiddleware negotiates the format of the errors returned by the
+// request from its `Accept` header. Clients asking for
+// `application/problem+json` receive RFC 7807 problem details while the
+// others keep receiving the legacy map of field errors.
+func (mid *middleware) ErrorFormatMiddleware(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fn(httperror.Negotiate(w, r), r)
+	}
+}
@@ -4,23 +4,30 @@ import (
 	"context"
 	"net/http"
 
+	"go.uber.org/zap"
+
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
 )
 
+// IPAddressMiddleware saves the client IP address to the context. Forwarding
+// headers are only honoured if the request came through a trusted proxy, so
+// every other middleware must use the IP address from the context instead of
+// `r.RemoteAddr`.
 func (mid *middleware) IPAddressMiddleware(fn http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Extract the IPAddress. Code taken from: https://stackoverflow.com/a/55738279
-		IPAddress := r.Header.Get("X-Real-Ip")
-		if IPAddress == "" {
-			IPAddress = r.Header.Get("X-Forwarded-For")
-		}
-		if IPAddress == "" {
-			IPAddress = r.RemoteAddr
-		}
+		IPAddress, proxies := mid.ClientIP.Resolve(r)
 
 		// Save our IP address to the context.
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, constants.SessionIPAddress, IPAddress)
+		ctx = context.WithValue(ctx, constants.SessionProxies, proxies)
+
+		// Save the user agent alongside so audit events can record the client.
+		ctx = context.WithValue(ctx, constants.SessionUserAgent, r.UserAgent())
+
+		// Tie the log lines of the request to the client.
+		logging.With(ctx, zap.String("ip_address", IPAddress))
 		fn(w, r.WithContext(ctx)) // Flow to the next middleware.
 	}
 }
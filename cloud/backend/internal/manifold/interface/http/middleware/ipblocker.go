@@ -3,31 +3,46 @@ package middleware
 import (
 	"net"
 	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
 )
 
+// EnforceRestrictCountryIPsMiddleware rejects clients from banned countries
+// and autonomous systems, or from outside the countries allowed by the policy
+// of the matched route pattern. Exempt routes, like health checks, skip it.
 func (mid *middleware) EnforceRestrictCountryIPsMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
-		// Extract IP address from request
-		ipStr, _, err := net.SplitHostPort(r.RemoteAddr)
-		if err != nil {
-			mid.Logger.Warn("failed splitting host port")
-			http.Error(w, "Invalid IP address", http.StatusBadRequest)
+		policy := mid.IPCountryBlocker.RoutePolicy(r.Pattern)
+		if policy.Exempt {
+			next(w, r)
 			return
 		}
 
+		// Extract the client IP address resolved by `IPAddressMiddleware`.
+		ipStr, _ := ctx.Value(constants.SessionIPAddress).(string)
+
 		ip := net.ParseIP(ipStr)
 		if ip == nil {
-			mid.Logger.Warn("failed parsing ip address")
-			http.Error(w, "Invalid IP address", http.StatusBadRequest)
+			logging.Logger(ctx, mid.Logger).Warn("failed parsing ip address")
+			httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("ip_address", "Invalid IP address"))
 			return
 		}
 
 		// Perform enforcement of country-wide blocking.
-		if mid.IPCountryBlocker.IsBlockedIP(ctx, ip) {
-			mid.Logger.Warn("rejected request by country ip address")
-			http.Error(w, "Access denied from your country", http.StatusForbidden)
+		if blocked, reason := mid.IPCountryBlocker.IsBlockedByPolicy(ctx, policy, ip); blocked {
+			logging.Logger(ctx, mid.Logger).Warn("rejected request by country ip address",
+				zap.String("pattern", r.Pattern),
+				zap.String("ip_address", ipStr),
+				zap.String("reason", reason))
+			metrics.IncBlocked(metrics.BlockReasonCountry)
+			httperror.ResponseError(w, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "Access denied from your country"), httperror.CodeCountryBlocked))
 			return
 		}
 
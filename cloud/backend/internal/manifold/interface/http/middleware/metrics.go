@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+)
+
+// statusRecorder captures the status code and the number of body bytes
+// written by the next handlers.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (rec *statusRecorder) WriteHeader(code int) {
+	if rec.status == 0 {
+		rec.status = code
+	}
+	rec.ResponseWriter.WriteHeader(code)
+}
+
+func (rec *statusRecorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	n, err := rec.ResponseWriter.Write(b)
+	rec.bytes += int64(n)
+	return n, err
+}
+
+// Unwrap lets `http.ResponseController` reach the original writer.
+func (rec *statusRecorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
+
+// MetricsMiddleware records the count, latency and status code of every
+// request by matched route pattern, including the ones rejected by the other
+// middleware, so it must be executed first.
+func (mid *middleware) MetricsMiddleware(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		done := metrics.TrackInFlight()
+		defer done()
+
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+		fn(rec, r)
+
+		status := rec.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		metrics.ObserveHTTPRequest(r.Pattern, status, time.Since(start))
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/blacklist"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/clientip"
 	ipcb "github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/ipcountryblocker"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/ratelimit"
 )
 
 type Middleware interface {
@@ -18,18 +20,24 @@ type Middleware interface {
 type middleware struct {
 	Logger           *zap.Logger
 	Blacklist        blacklist.Provider
+	ClientIP         clientip.Resolver
 	IPCountryBlocker ipcb.Provider
+	RateLimiter      ratelimit.Provider
 }
 
 func NewMiddleware(
 	loggerp *zap.Logger,
 	blp blacklist.Provider,
+	cipr clientip.Resolver,
 	ipcountryblocker ipcb.Provider,
+	rlp ratelimit.Provider,
 ) Middleware {
 	return &middleware{
 		Logger:           loggerp,
 		Blacklist:        blp,
+		ClientIP:         cipr,
 		IPCountryBlocker: ipcountryblocker,
+		RateLimiter:      rlp,
 	}
 }
 
@@ -37,13 +45,17 @@ func NewMiddleware(
 func (mid *middleware) Attach(fn http.HandlerFunc) http.HandlerFunc {
 	// Attach our middleware handlers here. Please note that all our middleware
 	// will start from the bottom and proceed upwards.
-	// Ex: `RateLimitMiddleware` will be executed first and
-	//     `ProtectedURLsMiddleware` will be executed last.
-	fn = mid.EnforceRestrictCountryIPsMiddleware(fn)
-	fn = mid.EnforceBlacklistMiddleware(fn)
-	fn = mid.IPAddressMiddleware(fn)
-	fn = mid.URLProcessorMiddleware(fn)
-	fn = mid.RateLimitMiddleware(fn)
+	// Ex: `MetricsMiddleware` will be executed first and
+	//     `EnforceRestrictCountryIPsMiddleware` will be executed last.
+	fn = traced("EnforceRestrictCountryIPsMiddleware", mid.EnforceRestrictCountryIPsMiddleware)(fn)
+	fn = traced("EnforceBlacklistMiddleware", mid.EnforceBlacklistMiddleware)(fn)
+	fn = traced("URLProcessorMiddleware", mid.URLProcessorMiddleware)(fn)
+	fn = traced("RateLimitMiddleware", mid.RateLimitMiddleware)(fn)
+	fn = traced("IPAddressMiddleware", mid.IPAddressMiddleware)(fn)
+	fn = mid.ErrorFormatMiddleware(fn)
+	fn = mid.RequestIDMiddleware(fn)
+	fn = mid.TracingMiddleware(fn)
+	fn = mid.MetricsMiddleware(fn)
 
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Flow to the next middleware.
@@ -56,4 +68,5 @@ func (mid *middleware) Shutdown() {
 	// Log a message to indicate that the HTTP server is shutting down.
 	mid.Logger.Info("Gracefully shutting down HTTP middleware")
 	mid.IPCountryBlocker.Close()
+	mid.RateLimiter.Close()
 }
@@ -1,46 +1,44 @@
 package middleware
 
 import (
-	"fmt"
-	"net"
 	"net/http"
-	"sync"
 
-	"go.uber.org/ratelimit"
 	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/ratelimit"
 )
 
+// RateLimitMiddleware rejects clients which exceed the rate limit policy of
+// the matched route pattern with `429 Too Many Requests`. Authenticated users
+// are additionally limited by the module middleware once they are known.
 func (mid *middleware) RateLimitMiddleware(fn http.HandlerFunc) http.HandlerFunc {
-	// Special thanks: https://ubogdan.com/2021/09/ip-based-rate-limit-middleware-using-go.uber.org/ratelimit/
-	var lmap sync.Map
-
 	return func(w http.ResponseWriter, r *http.Request) {
-		// Open our program's context based on the request and save the
-		// slash-seperated array from our URL path.
 		ctx := r.Context()
 
-		host, _, err := net.SplitHostPort(r.RemoteAddr)
-		if err != nil {
-			mid.Logger.Error("invalid RemoteAddr", zap.Any("err", err), zap.Any("middleware", "RateLimitMiddleware"))
-			http.Error(w, fmt.Sprintf("invalid RemoteAddr: %s", err), http.StatusInternalServerError)
+		policy := mid.RateLimiter.ClientPolicy(r.Pattern)
+		if policy.IsUnlimited() {
+			fn(w, r)
 			return
 		}
 
-		lif, ok := lmap.Load(host)
-		if !ok {
-			lif = ratelimit.New(50) // per second.
-		}
-
-		lm, ok := lif.(ratelimit.Limiter)
-		if !ok {
-			mid.Logger.Error("internal middleware error: typecast failed", zap.Any("middleware", "RateLimitMiddleware"))
-			http.Error(w, "internal middleware error: typecast failed", http.StatusInternalServerError)
+		// Note: This middleware must have `IPAddressMiddleware` executed first.
+		ipAddress, _ := ctx.Value(constants.SessionIPAddress).(string)
+
+		res := mid.RateLimiter.Allow(ctx, ratelimit.ClientKey(r.Pattern, ipAddress), policy)
+		ratelimit.WriteHeaders(w, policy, res)
+		if !res.Allowed {
+			logging.Logger(ctx, mid.Logger).Warn("client rate limit exceeded",
+				zap.String("pattern", r.Pattern),
+				zap.String("ip_address", ipAddress))
+			metrics.IncBlocked(metrics.BlockReasonClientRateLimit)
+			httperror.ResponseError(w, httperror.NewForTooManyRequestsWithSingleField("message", "Too many requests, please try again later"))
 			return
 		}
 
-		lm.Take()
-		lmap.Store(host, lm)
-
 		// Flow to the next middleware.
 		fn(w, r.WithContext(ctx))
 	}
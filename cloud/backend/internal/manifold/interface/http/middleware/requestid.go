@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// RequestIDMiddleware identifies the request with the ID sent by the client
+// in `X-Request-ID`, or a new one, which it returns in the response headers
+// and saves to the context for the request-scoped loggers. Once the request
+// is handled it writes its access log line, so it must be executed before
+// any middleware which may reject the request.
+func (mid *middleware) RequestIDMiddleware(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(logging.RequestIDHeader)
+		if !logging.IsValidRequestID(requestID) {
+			requestID = logging.NewRequestID()
+		}
+		w.Header().Set(logging.RequestIDHeader, requestID)
+
+		ctx, _ := logging.NewContext(r.Context(), requestID, tracing.LogFields(r.Context())...)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", requestID))
+
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+		fn(rec, r.WithContext(ctx))
+
+		status := rec.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		fields := []zap.Field{
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+			zap.String("pattern", r.Pattern),
+			zap.Int("status", status),
+			zap.Int64("bytes", rec.bytes),
+			zap.Duration("duration", time.Since(start)),
+		}
+		logger := logging.Logger(ctx, mid.Logger)
+		if status >= http.StatusInternalServerError {
+			logger.Error("request", fields...)
+		} else {
+			logger.Info("request", fields...)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// TracingMiddleware starts the server span of the request, continuing the
+// trace of the client when it sent a W3C `traceparent` header. The span is
+// named after the matched route pattern to keep the names bounded.
+func (mid *middleware) TracingMiddleware(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		name := r.Pattern
+		if name == "" {
+			name = r.Method
+		}
+		ctx, span := tracing.Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", r.Method),
+				attribute.String("http.route", r.Pattern),
+				attribute.String("url.path", r.URL.Path),
+				attribute.String("user_agent.original", r.UserAgent()),
+			))
+		defer span.End()
+
+		rec := &statusRecorder{ResponseWriter: w}
+		fn(rec, r.WithContext(ctx))
+
+		status := rec.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
+
+// traced wraps the middleware `m` in a span which ends once it hands the
+// request over to `next`, so each span only covers the time spent in its own
+// middleware. The following handlers continue under the parent span.
+func traced(name string, m func(http.HandlerFunc) http.HandlerFunc) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			parent := trace.SpanFromContext(r.Context())
+			ctx, span := tracing.Start(r.Context(), name)
+
+			passed := false
+			m(func(w http.ResponseWriter, r *http.Request) {
+				passed = true
+				span.End()
+				next(w, r.WithContext(trace.ContextWithSpan(r.Context(), parent)))
+			})(w, r.WithContext(ctx))
+
+			if !passed {
+				span.SetAttributes(attribute.Bool("request.rejected", true))
+				span.End()
+			}
+		}
+	}
+}
@@ -16,11 +16,26 @@ func Module() fx.Option {
 				NewServeMux,
 				fx.ParamTags(`group:"routes"`),
 			),
+			fx.Annotate(
+				NewOpenAPIDocument,
+				fx.ParamTags(`group:"routes"`),
+			),
+			NewGetOpenAPIHTTPHandler,
 		),
-		fx.Provide(
-			AsRoute(NewEchoHandler),
-			AsRoute(NewGetHealthCheckHTTPHandler),
-			// Add other routes here
-		),
+		fx.Provide(AsRoutes(Routes())...),
+		fx.Invoke(StartMetricsServer),
+		fx.Invoke(RegisterOpenAPIHandler),
 	)
 }
+
+// Routes returns the constructors of the routes of this module.
+func Routes() []any {
+	return []any{
+		NewEchoHandler,
+		NewGetHealthCheckHTTPHandler,
+		NewGetLivezHTTPHandler,
+		NewGetReadyzHTTPHandler,
+		NewGetMetricsHTTPHandler,
+		// Add other routes here
+	}
+}
@@ -0,0 +1,136 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"sort"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/manifold/interface/http/middleware"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+)
+
+// Metadata of the OpenAPI document, bump the version with every change of
+// the API surface.
+const (
+	openAPITitle   = "MOT Cloud Backend Services"
+	openAPIVersion = "1.1.0"
+)
+
+// NewOpenAPIDocument generates the OpenAPI document of the registered
+// routes, the routes implementing `DescribedRoute` include their DTOs.
+func NewOpenAPIDocument(routes []Route) *openapi.Document {
+	// Sorted so the names of the schemas do not depend on the fx order.
+	sorted := append([]Route(nil), routes...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Pattern() < sorted[j].Pattern()
+	})
+
+	doc := openapi.New(openAPITitle, openAPIVersion)
+	for _, route := range sorted {
+		var spec *openapi.Spec
+		if dr, ok := route.(DescribedRoute); ok {
+			spec = dr.Describe()
+		}
+		if routeRequirement(route) != nil {
+			if spec == nil {
+				spec = &openapi.Spec{}
+			}
+			spec.Authenticated = true
+		}
+		doc.AddRoute(route.Pattern(), spec)
+	}
+	doc.AddRoute(openAPIPattern, &openapi.Spec{Summary: "OpenAPI document of the API"})
+	return doc
+}
+
+// NewDescribedRoutes builds the routes of the given constructors without
+// their dependencies, for the tools which only need the patterns and the
+// descriptions, like the `openapi` command. The arguments of the types of
+// `values`, like a nop logger, receive them and the other ones the zero
+// value of their type, or a pointer to one for the structs like the
+// configuration, so the constructors must not use their dependencies.
+func NewDescribedRoutes(constructors []any, values ...any) (routes []Route, err error) {
+	supplied := make(map[reflect.Type]reflect.Value, len(values))
+	for _, v := range values {
+		supplied[reflect.TypeOf(v)] = reflect.ValueOf(v)
+	}
+	for _, f := range constructors {
+		fn := reflect.ValueOf(f)
+		name := runtime.FuncForPC(fn.Pointer()).Name()
+		route, err := newDescribedRoute(fn, supplied)
+		if err != nil {
+			return nil, fmt.Errorf("failed building route of %s: %w", name, err)
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+func newDescribedRoute(fn reflect.Value, supplied map[reflect.Type]reflect.Value) (route Route, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("constructor used its dependencies: %v", r)
+		}
+	}()
+
+	t := fn.Type()
+	args := make([]reflect.Value, t.NumIn())
+	for i := range args {
+		in := t.In(i)
+		if v, ok := supplied[in]; ok {
+			args[i] = v
+		} else if in.Kind() == reflect.Pointer && in.Elem().Kind() == reflect.Struct {
+			args[i] = reflect.New(in.Elem())
+		} else {
+			args[i] = reflect.Zero(in)
+		}
+	}
+
+	out := fn.Call(args)
+	if len(out) > 1 {
+		if err, ok := out[len(out)-1].Interface().(error); ok && err != nil {
+			return nil, err
+		}
+	}
+	route, ok := out[0].Interface().(Route)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a route", out[0].Type())
+	}
+	return route, nil
+}
+
+const openAPIPattern = "GET /openapi.json"
+
+// curl http://localhost:8000/openapi.json
+//
+// GetOpenAPIHTTPHandler serves the OpenAPI document generated at startup.
+// It is registered directly on the mux, instead of through `AsRoute`, as the
+// document depends on every route.
+type GetOpenAPIHTTPHandler struct {
+	body []byte
+}
+
+func NewGetOpenAPIHTTPHandler(doc *openapi.Document) (*GetOpenAPIHTTPHandler, error) {
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return &GetOpenAPIHTTPHandler{body}, nil
+}
+
+func (h *GetOpenAPIHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(h.body)
+}
+
+func (*GetOpenAPIHTTPHandler) Pattern() string {
+	return openAPIPattern
+}
+
+// RegisterOpenAPIHandler serves the OpenAPI document on the main server.
+func RegisterOpenAPIHandler(mux *http.ServeMux, mw middleware.Middleware, h *GetOpenAPIHTTPHandler) {
+	mux.Handle(h.Pattern(), http.HandlerFunc(mw.Attach(h.ServeHTTP)))
+}
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/health"
+)
+
+// curl http://localhost:8000/livez
+//
+// GetLivezHTTPHandler reports the process is able to serve requests, it
+// never checks dependencies so an outage of one does not restart every
+// instance.
+type GetLivezHTTPHandler struct{}
+
+func NewGetLivezHTTPHandler() *GetLivezHTTPHandler {
+	return &GetLivezHTTPHandler{}
+}
+
+func (h *GetLivezHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(HealthCheckResponseIDO{Status: health.StatusOK})
+}
+
+func (*GetLivezHTTPHandler) Pattern() string {
+	return "/livez"
+}
+
+// curl http://localhost:8000/readyz
+//
+// GetReadyzHTTPHandler reports whether every dependency is usable, with the
+// status and latency of each check, and responds `503 Service Unavailable`
+// otherwise so the instance is taken out of the load balancer.
+type GetReadyzHTTPHandler struct {
+	checker health.Checker
+}
+
+func NewGetReadyzHTTPHandler(
+	checker health.Checker,
+) *GetReadyzHTTPHandler {
+	return &GetReadyzHTTPHandler{checker}
+}
+
+func (h *GetReadyzHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	report := h.checker.Ready(r.Context())
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if !report.IsOK() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
+
+func (*GetReadyzHTTPHandler) Pattern() string {
+	return "/readyz"
+}
@@ -4,6 +4,9 @@ import (
 	"net/http"
 
 	"go.uber.org/fx"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 )
 
 // Route is an http.Handler that knows the mux pattern
@@ -24,3 +27,76 @@ func AsRoute(f any) any {
 		fx.ResultTags(`group:"routes"`),
 	)
 }
+
+// AsRoutes annotates every one of the given route constructors with
+// `AsRoute`.
+func AsRoutes(constructors []any) []any {
+	annotated := make([]any, len(constructors))
+	for i, f := range constructors {
+		annotated[i] = AsRoute(f)
+	}
+	return annotated
+}
+
+// RoleRestrictedRoute is an optional interface a Route may implement to
+// restrict access to authenticated users with one of the returned roles.
+type RoleRestrictedRoute interface {
+	Route
+
+	// RequiredRoles reports the roles allowed to access this route.
+	RequiredRoles() []int8
+}
+
+// PermissionRestrictedRoute is an optional interface a Route may implement
+// to restrict access to authenticated users whose role was granted every one
+// of the returned permissions.
+type PermissionRestrictedRoute interface {
+	Route
+
+	// RequiredPermissions reports the permissions needed to access this route.
+	RequiredPermissions() []string
+}
+
+// DescribedRoute is an optional interface a Route may implement to describe
+// its request and response DTOs in the OpenAPI document.
+type DescribedRoute interface {
+	Route
+
+	// Describe reports the summary and DTOs of this route.
+	Describe() *openapi.Spec
+}
+
+// IdempotentRoute is an optional interface a Route may implement to honour
+// the `Idempotency-Key` header, its responses are stored in the cache and
+// replayed to the retries. Routes whose responses carry credentials, like
+// tokens or secrets, must never implement it. The keys are honoured by the
+// IAM module middleware, the routes using another one ignore them.
+type IdempotentRoute interface {
+	Route
+
+	// Idempotent reports whether the retries replay the first response.
+	Idempotent() bool
+}
+
+// isIdempotent returns true if the route honours the `Idempotency-Key`
+// header.
+func isIdempotent(route Route) bool {
+	ir, ok := route.(IdempotentRoute)
+	return ok && ir.Idempotent()
+}
+
+// routeRequirement returns the authorization requirement declared by the
+// route or nil if the route is not restricted.
+func routeRequirement(route Route) *authorization.Requirement {
+	req := &authorization.Requirement{}
+	if rr, ok := route.(RoleRestrictedRoute); ok {
+		req.Roles = rr.RequiredRoles()
+	}
+	if pr, ok := route.(PermissionRestrictedRoute); ok {
+		req.Permissions = pr.RequiredPermissions()
+	}
+	if req.IsEmpty() {
+		return nil
+	}
+	return req
+}
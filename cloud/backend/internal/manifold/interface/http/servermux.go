@@ -2,17 +2,41 @@
 package http
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/manifold/interface/http/middleware"
 )
 
 func NewServeMux(routes []Route, mw middleware.Middleware) *http.ServeMux {
 	mux := http.NewServeMux()
 	for _, route := range routes {
+		handler := route.ServeHTTP
+
+		// Save the declared authorization requirement into the context so the
+		// module middleware can enforce it once the user has been looked up.
+		if req := routeRequirement(route); req != nil {
+			next := handler
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				ctx := context.WithValue(r.Context(), constants.SessionAuthorizationRequirement, req)
+				next(w, r.WithContext(ctx))
+			}
+		}
+
+		// Mark the idempotent routes so the module middleware replays the
+		// retries once the user has been authenticated.
+		if isIdempotent(route) {
+			next := handler
+			handler = func(w http.ResponseWriter, r *http.Request) {
+				ctx := context.WithValue(r.Context(), constants.SessionIdempotentRoute, true)
+				next(w, r.WithContext(ctx))
+			}
+		}
+
 		// Apply middleware to each route
-		wrappedHandler := http.HandlerFunc(mw.Attach(route.ServeHTTP))
-		mux.Handle(route.Pattern(), wrappedHandler)
+		wrappedHandler := mw.Attach(handler)
+		mux.Handle(route.Pattern(), http.HandlerFunc(wrappedHandler))
 	}
 	return mux
 }
@@ -6,9 +6,12 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam"
+	iamhttp "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http"
 	commonhttp "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/manifold/interface/http"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud"
+	papercloudhttp "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/interface/http"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault"
+	vaulthttp "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/interface/http"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg"
 )
 
@@ -22,3 +25,13 @@ func Module() fx.Option {
 		fx.Invoke(func(*http.Server) {}),
 	)
 }
+
+// Routes returns the constructors of every route registered by `Module`.
+func Routes() []any {
+	var routes []any
+	routes = append(routes, commonhttp.Routes()...)
+	routes = append(routes, iamhttp.Routes()...)
+	routes = append(routes, vaulthttp.Routes()...)
+	routes = append(routes, papercloudhttp.Routes()...)
+	return routes
+}
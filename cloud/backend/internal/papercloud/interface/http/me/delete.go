@@ -16,6 +16,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/interface/http/middleware"
 	svc_me "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/service/me"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type DeleteMeHTTPHandler struct {
@@ -46,6 +47,16 @@ func (*DeleteMeHTTPHandler) Pattern() string {
 	return "DELETE /papercloud/api/v1/me"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*DeleteMeHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Delete the account of the user",
+		Request:       svc_me.DeleteMeRequestDTO{},
+		Status:        http.StatusNoContent,
+		Authenticated: true,
+	}
+}
+
 func (r *DeleteMeHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply MaplesSend middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
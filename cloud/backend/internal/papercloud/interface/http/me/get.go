@@ -15,6 +15,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/interface/http/middleware"
 	svc_me "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/service/me"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type GetMeHTTPHandler struct {
@@ -45,6 +46,15 @@ func (*GetMeHTTPHandler) Pattern() string {
 	return "GET /papercloud/api/v1/me"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GetMeHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Get the profile of the user",
+		Response:      svc_me.MeResponseDTO{},
+		Authenticated: true,
+	}
+}
+
 func (r *GetMeHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply MaplesSend middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
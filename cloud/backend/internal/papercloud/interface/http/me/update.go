@@ -17,6 +17,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/interface/http/middleware"
 	svc_me "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/service/me"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
 )
 
 type PutUpdateMeHTTPHandler struct {
@@ -47,6 +48,16 @@ func (*PutUpdateMeHTTPHandler) Pattern() string {
 	return "PUT /papercloud/api/v1/me"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*PutUpdateMeHTTPHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:       "Update the profile of the user",
+		Request:       svc_me.UpdateMeRequestDTO{},
+		Response:      svc_me.MeResponseDTO{},
+		Authenticated: true,
+	}
+}
+
 func (r *PutUpdateMeHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	// Apply MaplesSend middleware before handling the request
 	r.middleware.Attach(r.Execute)(w, req)
@@ -4,7 +4,11 @@ import (
 	"context"
 	"net/http"
 
+	"go.uber.org/zap"
+
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
 )
 
 func (mid *middleware) PostJWTProcessorMiddleware(fn http.HandlerFunc) http.HandlerFunc {
@@ -19,14 +23,14 @@ func (mid *middleware) PostJWTProcessorMiddleware(fn http.HandlerFunc) http.Hand
 			// Lookup our user profile in the session or return 500 error.
 			user, err := mid.userGetBySessionIDUseCase.Execute(ctx, sessionID)
 			if err != nil {
-				http.Error(w, err.Error(), http.StatusInternalServerError)
+				httperror.ResponseError(w, err)
 				return
 			}
 
 			// If no user was found then that means our session expired and the
 			// user needs to login or use the refresh token.
 			if user == nil {
-				http.Error(w, "attempting to access a protected endpoint", http.StatusUnauthorized)
+				httperror.ResponseError(w, httperror.WithCode(httperror.NewForUnauthorizedWithSingleField("message", "Session has expired, please login again"), httperror.CodeSessionExpired))
 				return
 			}
 
@@ -54,6 +58,9 @@ func (mid *middleware) PostJWTProcessorMiddleware(fn http.HandlerFunc) http.Hand
 			// ctx = context.WithValue(ctx, constants.SessionFederatedUserStoreName, user.StoreName)
 			// ctx = context.WithValue(ctx, constants.SessionFederatedUserStoreLevel, user.StoreLevel)
 			// ctx = context.WithValue(ctx, constants.SessionFederatedUserStoreTimezone, user.StoreTimezone)
+
+			// Tie the log lines of the request to the user.
+			logging.With(ctx, zap.String("user_id", user.ID.Hex()))
 		}
 
 		fn(w, r.WithContext(ctx))
@@ -7,7 +7,9 @@ import (
 
 	uc_bannedipaddress "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/usecase/bannedipaddress"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/usecase/user"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/ratelimit"
 )
 
 type Middleware interface {
@@ -17,17 +19,23 @@ type Middleware interface {
 
 type middleware struct {
 	jwt                                 jwt.Provider
+	authorization                       authorization.Provider
+	rateLimiter                         ratelimit.Provider
 	userGetBySessionIDUseCase           uc_user.UserGetBySessionIDUseCase
 	bannedIPAddressListAllValuesUseCase uc_bannedipaddress.BannedIPAddressListAllValuesUseCase
 }
 
 func NewMiddleware(
 	jwtp jwt.Provider,
+	authp authorization.Provider,
+	rlp ratelimit.Provider,
 	uc1 uc_user.UserGetBySessionIDUseCase,
 	uc2 uc_bannedipaddress.BannedIPAddressListAllValuesUseCase,
 ) Middleware {
 	return &middleware{
 		jwt:                                 jwtp,
+		authorization:                       authp,
+		rateLimiter:                         rlp,
 		userGetBySessionIDUseCase:           uc1,
 		bannedIPAddressListAllValuesUseCase: uc2,
 	}
@@ -40,6 +48,14 @@ func (mid *middleware) Attach(fn http.HandlerFunc) http.HandlerFunc {
 		// Apply base middleware to all requests
 		handler := mid.applyBaseMiddleware(fn)
 
+		// Enforce the roles and permissions declared by the route, this runs
+		// after the JWT middleware so the user role is known.
+		handler = mid.AuthorizationMiddleware(handler)
+
+		// Limit the requests of the authenticated user, this also runs after
+		// the JWT middleware so the user is known.
+		handler = mid.UserRateLimitMiddleware(handler)
+
 		// Check if the path requires authentication
 		if isProtectedPath(r.URL.Path) {
 
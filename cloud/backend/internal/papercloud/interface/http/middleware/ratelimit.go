@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/ratelimit"
+)
+
+// UserRateLimitMiddleware rejects authenticated users which exceed the rate
+// limit policy of the matched route pattern with `429 Too Many Requests`, no
+// matter how many client IP addresses they spread their requests over. It
+// must run after `PostJWTProcessorMiddleware`.
+func (mid *middleware) UserRateLimitMiddleware(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		userID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+		if !ok {
+			fn(w, r)
+			return
+		}
+		policy := mid.rateLimiter.UserPolicy(r.Pattern)
+		if policy.IsUnlimited() {
+			fn(w, r)
+			return
+		}
+
+		res := mid.rateLimiter.Allow(ctx, ratelimit.UserKey(r.Pattern, userID.Hex()), policy)
+		ratelimit.WriteHeaders(w, policy, res)
+		if !res.Allowed {
+			metrics.IncBlocked(metrics.BlockReasonUserRateLimit)
+			httperror.ResponseError(w, httperror.NewForTooManyRequestsWithSingleField("message", "Too many requests, please try again later"))
+			return
+		}
+
+		fn(w, r)
+	}
+}
@@ -14,11 +14,16 @@ func Module() fx.Option {
 		fx.Provide(
 			middleware.NewMiddleware,
 		),
-		fx.Provide(
-			unifiedhttp.AsRoute(me.NewGetMeHTTPHandler),
-			unifiedhttp.AsRoute(me.NewPutUpdateMeHTTPHandler),
-			unifiedhttp.AsRoute(me.NewDeleteMeHTTPHandler),
-			unifiedhttp.AsRoute(commonhttp.NewGetIncomePropertyEvaluatorVersionHTTPHandler),
-		),
+		fx.Provide(unifiedhttp.AsRoutes(Routes())...),
 	)
 }
+
+// Routes returns the constructors of the routes of this module.
+func Routes() []any {
+	return []any{
+		me.NewGetMeHTTPHandler,
+		me.NewPutUpdateMeHTTPHandler,
+		me.NewDeleteMeHTTPHandler,
+		commonhttp.NewGetIncomePropertyEvaluatorVersionHTTPHandler,
+	}
+}
@@ -8,9 +8,13 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func (impl bannedIPAddressImpl) Create(ctx context.Context, u *dom_banip.BannedIPAddress) error {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.Create")
+	defer span.End()
+
 	// DEVELOPER NOTES:
 	// According to mongodb documentaiton:
 	//     Non-existent Databases and Collections
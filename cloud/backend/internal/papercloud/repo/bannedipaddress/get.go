@@ -10,9 +10,14 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func (impl bannedIPAddressImpl) GetByID(ctx context.Context, id primitive.ObjectID) (*dom_banip.BannedIPAddress, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.GetByID")
+	defer span.End()
+
 	filter := bson.M{"_id": id}
 
 	var result dom_banip.BannedIPAddress
@@ -29,6 +34,9 @@ func (impl bannedIPAddressImpl) GetByID(ctx context.Context, id primitive.Object
 }
 
 func (impl bannedIPAddressImpl) GetByNonce(ctx context.Context, nonce *big.Int) (*dom_banip.BannedIPAddress, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.GetByNonce")
+	defer span.End()
+
 	filter := bson.M{"transaction.nonce_bytes": nonce.Bytes()}
 
 	var result dom_banip.BannedIPAddress
@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
 	dom_banip "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/bannedipaddress"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // hasActiveFilters checks if any filters besides tenant_id are active
@@ -44,6 +45,9 @@ func (impl bannedIPAddressImpl) buildCountMatchStage(filter *dom_banip.BannedIPA
 }
 
 func (impl bannedIPAddressImpl) CountByFilter(ctx context.Context, filter *dom_banip.BannedIPAddressFilter) (uint64, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.CountByFilter")
+	defer span.End()
+
 	if filter == nil {
 		return 0, errors.New("filter cannot be nil")
 	}
@@ -132,6 +136,9 @@ func (impl bannedIPAddressImpl) buildMatchStage(filter *dom_banip.BannedIPAddres
 }
 
 func (impl bannedIPAddressImpl) ListByFilter(ctx context.Context, filter *dom_banip.BannedIPAddressFilter) (*dom_banip.BannedIPAddressFilterResult, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.ListByFilter")
+	defer span.End()
+
 	if filter == nil {
 		return nil, errors.New("filter cannot be nil")
 	}
@@ -204,6 +211,9 @@ func (impl bannedIPAddressImpl) ListByFilter(ctx context.Context, filter *dom_ba
 }
 
 func (impl bannedIPAddressImpl) DeleteByID(ctx context.Context, id primitive.ObjectID) error {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.DeleteByID")
+	defer span.End()
+
 	_, err := impl.Collection.DeleteOne(ctx, bson.M{"_id": id})
 	if err != nil {
 		impl.Logger.Error("database failed deletion error",
@@ -214,6 +224,9 @@ func (impl bannedIPAddressImpl) DeleteByID(ctx context.Context, id primitive.Obj
 }
 
 func (impl bannedIPAddressImpl) ListAllValues(ctx context.Context) ([]string, error) {
+	ctx, span := tracing.Start(ctx, "BannedIPAddressRepository.ListAllValues")
+	defer span.End()
+
 	// Create an empty collection to hold our results
 	var results []dom_banip.BannedIPAddress
 
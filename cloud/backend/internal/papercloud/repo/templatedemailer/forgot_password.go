@@ -2,9 +2,13 @@ package templatedemailer
 
 import (
 	"context"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func (impl *templatedEmailer) SendUserPasswordResetEmail(ctx context.Context, email, verificationCode, firstName string) error {
+	ctx, span := tracing.Start(ctx, "TemplatedEmailerRepository.SendUserPasswordResetEmail")
+	defer span.End()
 
 	return nil
 }
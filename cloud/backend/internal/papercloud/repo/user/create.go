@@ -9,9 +9,13 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func (impl userStorerImpl) Create(ctx context.Context, u *dom_user.User) error {
+	ctx, span := tracing.Start(ctx, "UserRepository.Create")
+	defer span.End()
+
 	// DEVELOPER NOTES:
 	// According to mongodb documentaiton:
 	//     Non-existent Databases and Collections
@@ -12,6 +12,7 @@ import (
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type userStorerImpl struct {
@@ -77,6 +78,9 @@ func NewRepository(appCfg *config.Configuration, loggerp *zap.Logger, client *mo
 
 // ListAll retrieves all users from the database
 func (impl userStorerImpl) ListAll(ctx context.Context) ([]*dom_user.User, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.ListAll")
+	defer span.End()
+
 	impl.Logger.Debug("listing all users")
 
 	cursor, err := impl.Collection.Find(ctx, bson.M{})
@@ -14,6 +14,7 @@ import (
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/usecase/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/password"
 	sstring "github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/securestring"
 )
@@ -51,6 +52,9 @@ func NewDeleteMeService(
 }
 
 func (svc *deleteMeServiceImpl) Execute(sessCtx context.Context, req *DeleteMeRequestDTO) error {
+	sessCtx, span := tracing.Start(sessCtx, "DeleteMeService.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation
 	//
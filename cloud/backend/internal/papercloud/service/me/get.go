@@ -16,6 +16,7 @@ import (
 	uc_federateduser "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/federateduser"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/usecase/user"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type MeResponseDTO struct {
@@ -101,6 +102,9 @@ func NewGetMeService(
 }
 
 func (svc *getMeServiceImpl) Execute(sessCtx context.Context) (*MeResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "GetMeService.Execute")
+	defer span.End()
+
 	//
 	// Get required from context.
 	//
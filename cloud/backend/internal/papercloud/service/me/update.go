@@ -16,6 +16,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/usecase/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type UpdateMeRequestDTO struct {
@@ -59,6 +60,9 @@ func NewUpdateMeService(
 }
 
 func (svc *updateMeServiceImpl) Execute(sessCtx context.Context, req *UpdateMeRequestDTO) (*MeResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "UpdateMeService.Execute")
+	defer span.End()
+
 	//
 	// Get required from context.
 	//
@@ -15,6 +15,7 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	uc_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/usecase/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type VerifyProfileRequestDTO struct {
@@ -100,6 +101,9 @@ func (s *verifyProfileServiceImpl) Execute(
 	sessCtx context.Context,
 	req *VerifyProfileRequestDTO,
 ) (*VerifyProfileResponseDTO, error) {
+	sessCtx, span := tracing.Start(sessCtx, "VerifyProfileService.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Get required from context.
 	//
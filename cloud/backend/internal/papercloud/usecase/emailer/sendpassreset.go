@@ -9,6 +9,7 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/repo/templatedemailer"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type SendUserPasswordResetEmailUseCase interface {
@@ -25,6 +26,9 @@ func NewSendUserPasswordResetEmailUseCase(config *config.Configuration, logger *
 }
 
 func (uc *sendUserPasswordResetEmailUseCaseImpl) Execute(ctx context.Context, user *domain.User) error {
+	ctx, span := tracing.Start(ctx, "SendUserPasswordResetEmailUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
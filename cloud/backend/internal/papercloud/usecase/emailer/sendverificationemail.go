@@ -9,6 +9,7 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/repo/templatedemailer"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type SendUserVerificationEmailUseCase interface {
@@ -25,6 +26,9 @@ func NewSendUserVerificationEmailUseCase(config *config.Configuration, logger *z
 }
 
 func (uc *sendUserVerificationEmailUseCaseImpl) Execute(ctx context.Context, user *domain.User) error {
+	ctx, span := tracing.Start(ctx, "SendUserVerificationEmailUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
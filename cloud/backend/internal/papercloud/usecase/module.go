@@ -6,6 +6,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/usecase/bannedipaddress"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/usecase/emailer"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/usecase/user"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/blacklist"
 )
 
 func Module() fx.Option {
@@ -13,6 +14,7 @@ func Module() fx.Option {
 		fx.Provide(
 			bannedipaddress.NewCreateBannedIPAddressUseCase,
 			bannedipaddress.NewBannedIPAddressListAllValuesUseCase,
+			blacklist.AsSource(bannedipaddress.NewBlacklistSource),
 			emailer.NewSendUserPasswordResetEmailUseCase,
 			emailer.NewSendUserVerificationEmailUseCase,
 			user.NewUserCountByFilterUseCase,
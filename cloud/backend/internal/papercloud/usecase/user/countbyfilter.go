@@ -9,6 +9,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type UserCountByFilterUseCase interface {
@@ -30,6 +31,9 @@ func NewUserCountByFilterUseCase(
 }
 
 func (uc *userCountByFilterUseCaseImpl) Execute(ctx context.Context, filter *dom_user.UserFilter) (uint64, error) {
+	ctx, span := tracing.Start(ctx, "UserCountByFilterUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
@@ -9,6 +9,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type UserCreateUseCase interface {
@@ -26,6 +27,9 @@ func NewUserCreateUseCase(config *config.Configuration, logger *zap.Logger, repo
 }
 
 func (uc *userCreateUseCaseImpl) Execute(ctx context.Context, user *dom_user.User) error {
+	ctx, span := tracing.Start(ctx, "UserCreateUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
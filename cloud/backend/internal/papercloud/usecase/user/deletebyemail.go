@@ -9,6 +9,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type UserDeleteUserByEmailUseCase interface {
@@ -26,6 +27,9 @@ func NewUserDeleteUserByEmailUseCase(config *config.Configuration, logger *zap.L
 }
 
 func (uc *userDeleteUserByEmailImpl) Execute(ctx context.Context, email string) error {
+	ctx, span := tracing.Start(ctx, "UserDeleteUserByEmail.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
@@ -11,6 +11,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type UserDeleteByIDUseCase interface {
@@ -28,6 +29,9 @@ func NewUserDeleteByIDUseCase(config *config.Configuration, logger *zap.Logger,
 }
 
 func (uc *userDeleteByIDImpl) Execute(ctx context.Context, id primitive.ObjectID) error {
+	ctx, span := tracing.Start(ctx, "UserDeleteByID.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
@@ -11,6 +11,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type UserGetByIDUseCase interface {
@@ -28,6 +29,9 @@ func NewUserGetByIDUseCase(config *config.Configuration, logger *zap.Logger, rep
 }
 
 func (uc *userGetByIDUseCaseImpl) Execute(ctx context.Context, id primitive.ObjectID) (*dom_user.User, error) {
+	ctx, span := tracing.Start(ctx, "UserGetByIDUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
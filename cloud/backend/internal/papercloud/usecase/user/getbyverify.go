@@ -8,6 +8,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type UserGetByVerificationCodeUseCase interface {
@@ -25,6 +26,9 @@ func NewUserGetByVerificationCodeUseCase(config *config.Configuration, logger *z
 }
 
 func (uc *userGetByVerificationCodeUseCaseImpl) Execute(ctx context.Context, verificationCode string) (*dom_user.User, error) {
+	ctx, span := tracing.Start(ctx, "UserGetByVerificationCodeUseCase.Execute")
+	defer span.End()
+
 	//
 	// STEP 1: Validation.
 	//
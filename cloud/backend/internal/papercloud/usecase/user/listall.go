@@ -7,6 +7,7 @@ import (
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	dom_user "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/papercloud/domain/user"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type UserListAllUseCase interface {
@@ -32,6 +33,9 @@ func NewUserListAllUseCase(
 }
 
 func (uc *userListAllUseCaseImpl) Execute(ctx context.Context) ([]*dom_user.User, error) {
+	ctx, span := tracing.Start(ctx, "UserListAllUseCase.Execute")
+	defer span.End()
+
 	uc.logger.Debug("executing list all users use case")
 
 	users, err := uc.repo.ListAll(ctx)
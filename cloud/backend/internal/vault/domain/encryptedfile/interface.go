@@ -14,9 +14,13 @@ type Repository interface {
 	Create(ctx context.Context, file *EncryptedFile, encryptedContent io.Reader) error
 	GetByID(ctx context.Context, id primitive.ObjectID) (*EncryptedFile, error)
 	GetByFileID(ctx context.Context, userID primitive.ObjectID, fileID string) (*EncryptedFile, error)
+	GetByOrganizationFileID(ctx context.Context, organizationID primitive.ObjectID, fileID string) (*EncryptedFile, error)
 	UpdateByID(ctx context.Context, file *EncryptedFile, encryptedContent io.Reader) error
 	DeleteByID(ctx context.Context, id primitive.ObjectID) error
 
-	// List files for a user
+	// List personal files for a user
 	ListByUserID(ctx context.Context, userID primitive.ObjectID) ([]*EncryptedFile, error)
+
+	// List files shared with an organization
+	ListByOrganizationID(ctx context.Context, organizationID primitive.ObjectID) ([]*EncryptedFile, error)
 }
@@ -16,6 +16,15 @@ type EncryptedFile struct {
 	// User who owns this file
 	UserID primitive.ObjectID `bson:"user_id" json:"user_id"`
 
+	// Organization which shares this file, if any. Personal files leave
+	// this empty and are only visible to the uploader.
+	OrganizationID primitive.ObjectID `bson:"organization_id,omitempty" json:"organization_id,omitempty"`
+
+	// Key of the file wrapped with the public key of each member of the
+	// organization allowed to decrypt it, by federated user ID. The client
+	// wraps the keys so the server never sees them in the clear.
+	EncryptedFileKeys map[string]string `bson:"encrypted_file_keys,omitempty" json:"encrypted_file_keys,omitempty"`
+
 	// Encrypted file identifier (client-generated)
 	// This would be a client-side generated id that is
 	// meaningful to the client but opaque to the server
@@ -13,6 +13,10 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	svc "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/service/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 )
 
 // CreateEncryptedFileHandler handles HTTP requests to create a new encrypted file
@@ -43,6 +47,27 @@ func (h *CreateEncryptedFileHandler) Pattern() string {
 	return "POST /vault/api/v1/encrypted-files"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*CreateEncryptedFileHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:            "Upload a new encrypted file",
+		RequestContentType: "multipart/form-data",
+		Response:           FileResponse{},
+		Status:             http.StatusCreated,
+	}
+}
+
+// Idempotent lets the retries sent with the same idempotency key replay
+// the first response
+func (*CreateEncryptedFileHandler) Idempotent() bool {
+	return true
+}
+
+// RequiredPermissions returns the permissions needed to access this handler
+func (h *CreateEncryptedFileHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionVaultFiles}
+}
+
 // ServeHTTP handles HTTP requests
 func (h *CreateEncryptedFileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Apply MaplesSend middleware before handling the request
@@ -55,7 +80,7 @@ func (h *CreateEncryptedFileHandler) Execute(w http.ResponseWriter, r *http.Requ
 	// Check authentication
 	userIDValue := ctx.Value(constants.SessionFederatedUserID)
 	if userIDValue == nil {
-		h.logger.Error("--> anonymous user detected")
+		logging.Logger(ctx, h.logger).Error("--> anonymous user detected")
 		httperror.ResponseError(w, httperror.NewForUnauthorizedWithSingleField("message", "Authentication required"))
 		return
 	}
@@ -66,8 +91,11 @@ func (h *CreateEncryptedFileHandler) Execute(w http.ResponseWriter, r *http.Requ
 	}
 
 	// Parse multipart form to get file and metadata
-	if err := r.ParseMultipartForm(32 << 20); err != nil { // 32MB max
-		h.logger.Error("Failed to parse multipart form", zap.Error(err))
+	_, parseSpan := tracing.Start(ctx, "ParseMultipartForm")
+	err := r.ParseMultipartForm(32 << 20) // 32MB max
+	parseSpan.End()
+	if err != nil {
+		logging.Logger(ctx, h.logger).Error("Failed to parse multipart form", zap.Error(err))
 		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("content", "Invalid multipart form"))
 		return
 	}
@@ -86,10 +114,20 @@ func (h *CreateEncryptedFileHandler) Execute(w http.ResponseWriter, r *http.Requ
 		encryptionVersion = "1.0" // Default version
 	}
 
+	// The file key wrapped for the members, a JSON object by federated user
+	// ID, is required for the files shared with an organization.
+	var encryptedFileKeys map[string]string
+	if v := r.FormValue("encrypted_file_keys"); v != "" {
+		if err := json.Unmarshal([]byte(v), &encryptedFileKeys); err != nil {
+			httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("encrypted_file_keys", "Invalid file keys"))
+			return
+		}
+	}
+
 	// Get file content
 	file, _, err := r.FormFile("encrypted_content")
 	if err != nil {
-		h.logger.Error("Failed to get file from form", zap.Error(err))
+		logging.Logger(ctx, h.logger).Error("Failed to get file from form", zap.Error(err))
 		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("encrypted_content", "File content is required"))
 		return
 	}
@@ -103,10 +141,11 @@ func (h *CreateEncryptedFileHandler) Execute(w http.ResponseWriter, r *http.Requ
 		encryptedMetadata,
 		encryptedHash,
 		encryptionVersion,
+		encryptedFileKeys,
 		file,
 	)
 	if err != nil {
-		h.logger.Error("Failed to create encrypted file", zap.Error(err))
+		logging.Logger(ctx, h.logger).Error("Failed to create encrypted file", zap.Error(err))
 		httperror.ResponseError(w, err)
 		return
 	}
@@ -118,15 +157,17 @@ func (h *CreateEncryptedFileHandler) Execute(w http.ResponseWriter, r *http.Requ
 	response := FileResponse{
 		ID:                result.ID,
 		UserID:            result.UserID,
+		OrganizationID:    result.OrganizationID,
 		FileID:            result.FileID,
 		EncryptedMetadata: result.EncryptedMetadata,
 		EncryptionVersion: result.EncryptionVersion,
 		EncryptedHash:     result.EncryptedHash,
+		EncryptedFileKey:  requesterFileKey(ctx, result),
 		CreatedAt:         result.CreatedAt,
 		ModifiedAt:        result.ModifiedAt,
 	}
 
 	if err := json.NewEncoder(w).Encode(response); err != nil {
-		h.logger.Error("Failed to encode response", zap.Error(err))
+		logging.Logger(ctx, h.logger).Error("Failed to encode response", zap.Error(err))
 	}
 }
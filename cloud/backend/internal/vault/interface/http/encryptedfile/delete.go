@@ -12,6 +12,8 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	svc "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/service/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 )
 
 // DeleteEncryptedFileHandler handles HTTP requests to delete an encrypted file
@@ -42,6 +44,19 @@ func (h *DeleteEncryptedFileHandler) Pattern() string {
 	return "DELETE /vault/api/v1/encrypted-files/{id}"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*DeleteEncryptedFileHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary: "Delete an encrypted file",
+		Status:  http.StatusNoContent,
+	}
+}
+
+// RequiredPermissions returns the permissions needed to access this handler
+func (h *DeleteEncryptedFileHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionVaultFiles}
+}
+
 // ServeHTTP handles HTTP requests
 func (h *DeleteEncryptedFileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Apply MaplesSend middleware before handling the request
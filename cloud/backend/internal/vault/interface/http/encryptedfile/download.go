@@ -13,6 +13,8 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	svc "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/service/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 )
 
 // DownloadEncryptedFileHandler handles HTTP requests to download an encrypted file
@@ -46,6 +48,18 @@ func (h *DownloadEncryptedFileHandler) Pattern() string {
 	return "GET /vault/api/v1/encrypted-files/{id}/download"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*DownloadEncryptedFileHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary: "Download the encrypted content of a file",
+	}
+}
+
+// RequiredPermissions returns the permissions needed to access this handler
+func (h *DownloadEncryptedFileHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionVaultFiles}
+}
+
 // ServeHTTP handles HTTP requests
 func (h *DownloadEncryptedFileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Apply MaplesSend middleware before handling the request
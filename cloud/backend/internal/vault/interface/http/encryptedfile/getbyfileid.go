@@ -13,6 +13,8 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	svc "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/service/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 )
 
 // GetEncryptedFileByFileIDHandler handles HTTP requests to get an encrypted file by file ID
@@ -43,6 +45,19 @@ func (h *GetEncryptedFileByFileIDHandler) Pattern() string {
 	return "GET /vault/api/v1/files-by-client-id/{fileId}"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GetEncryptedFileByFileIDHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Get the metadata of an encrypted file by its client ID",
+		Response: FileResponse{},
+	}
+}
+
+// RequiredPermissions returns the permissions needed to access this handler
+func (h *GetEncryptedFileByFileIDHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionVaultFiles}
+}
+
 // ServeHTTP handles HTTP requests
 func (h *GetEncryptedFileByFileIDHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.middleware.Attach(h.Execute)(w, r)
@@ -87,10 +102,12 @@ func (h *GetEncryptedFileByFileIDHandler) Execute(w http.ResponseWriter, r *http
 	response := FileResponse{
 		ID:                file.ID,
 		UserID:            file.UserID,
+		OrganizationID:    file.OrganizationID,
 		FileID:            file.FileID,
 		EncryptedMetadata: file.EncryptedMetadata,
 		EncryptionVersion: file.EncryptionVersion,
 		EncryptedHash:     file.EncryptedHash,
+		EncryptedFileKey:  requesterFileKey(ctx, file),
 		CreatedAt:         file.CreatedAt,
 		ModifiedAt:        file.ModifiedAt,
 	}
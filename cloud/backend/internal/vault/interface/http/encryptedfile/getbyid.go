@@ -13,6 +13,8 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	svc "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/service/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 )
 
 // GetEncryptedFileByIDHandler handles HTTP requests to get an encrypted file by ID
@@ -43,6 +45,19 @@ func (h *GetEncryptedFileByIDHandler) Pattern() string {
 	return "GET /vault/api/v1/encrypted-files/{id}"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GetEncryptedFileByIDHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Get the metadata of an encrypted file",
+		Response: FileResponse{},
+	}
+}
+
+// RequiredPermissions returns the permissions needed to access this handler
+func (h *GetEncryptedFileByIDHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionVaultFiles}
+}
+
 // ServeHTTP handles HTTP requests
 func (h *GetEncryptedFileByIDHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) { // Apply MaplesSend middleware before handling the request
 	h.middleware.Attach(h.Execute)(w, r)
@@ -81,10 +96,12 @@ func (h *GetEncryptedFileByIDHandler) Execute(w http.ResponseWriter, r *http.Req
 	response := FileResponse{
 		ID:                file.ID,
 		UserID:            file.UserID,
+		OrganizationID:    file.OrganizationID,
 		FileID:            file.FileID,
 		EncryptedMetadata: file.EncryptedMetadata,
 		EncryptionVersion: file.EncryptionVersion,
 		EncryptedHash:     file.EncryptedHash,
+		EncryptedFileKey:  requesterFileKey(ctx, file),
 		CreatedAt:         file.CreatedAt,
 		ModifiedAt:        file.ModifiedAt,
 	}
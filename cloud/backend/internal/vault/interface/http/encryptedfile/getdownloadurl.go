@@ -15,6 +15,8 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	svc "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/service/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 )
 
 // GetEncryptedFileDownloadURLHandler handles HTTP requests to get a download URL for an encrypted file
@@ -45,6 +47,19 @@ func (h *GetEncryptedFileDownloadURLHandler) Pattern() string {
 	return "GET /vault/api/v1/encrypted-files/{id}/url"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*GetEncryptedFileDownloadURLHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Get a presigned download URL of an encrypted file",
+		Response: FileURLResponse{},
+	}
+}
+
+// RequiredPermissions returns the permissions needed to access this handler
+func (h *GetEncryptedFileDownloadURLHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionVaultFiles}
+}
+
 // ServeHTTP handles HTTP requests
 func (h *GetEncryptedFileDownloadURLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Apply MaplesSend middleware before handling the request
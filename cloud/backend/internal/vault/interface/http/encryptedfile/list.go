@@ -13,6 +13,8 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	svc "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/service/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 )
 
 // ListEncryptedFilesHandler handles HTTP requests to list encrypted files
@@ -43,6 +45,19 @@ func (h *ListEncryptedFilesHandler) Pattern() string {
 	return "GET /vault/api/v1/encrypted-files"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ListEncryptedFilesHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "List the encrypted files of the user",
+		Response: FilesListResponse{},
+	}
+}
+
+// RequiredPermissions returns the permissions needed to access this handler
+func (h *ListEncryptedFilesHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionVaultFiles}
+}
+
 // ServeHTTP handles HTTP requests
 func (h *ListEncryptedFilesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Apply MaplesSend middleware before handling the request
@@ -82,10 +97,12 @@ func (h *ListEncryptedFilesHandler) Execute(w http.ResponseWriter, r *http.Reque
 		filesResponse[i] = FileResponse{
 			ID:                file.ID,
 			UserID:            file.UserID,
+			OrganizationID:    file.OrganizationID,
 			FileID:            file.FileID,
 			EncryptedMetadata: file.EncryptedMetadata,
 			EncryptionVersion: file.EncryptionVersion,
 			EncryptedHash:     file.EncryptedHash,
+			EncryptedFileKey:  requesterFileKey(ctx, file),
 			CreatedAt:         file.CreatedAt,
 			ModifiedAt:        file.ModifiedAt,
 		}
@@ -2,23 +2,44 @@
 package encryptedfile
 
 import (
+	"context"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
 )
 
-// FileResponse represents file metadata returned in HTTP responses
+// FileResponse represents file metadata returned in HTTP responses. The
+// EncryptedFileKey is the key of an organization file wrapped with the
+// public key of the requester, empty for the personal files.
 type FileResponse struct {
 	ID                primitive.ObjectID `json:"id"`
 	UserID            primitive.ObjectID `json:"user_id"`
+	OrganizationID    primitive.ObjectID `json:"organization_id,omitempty"`
 	FileID            string             `json:"file_id"`
 	EncryptedMetadata string             `json:"encrypted_metadata"`
 	EncryptionVersion string             `json:"encryption_version"`
 	EncryptedHash     string             `json:"encrypted_hash"`
+	EncryptedFileKey  string             `json:"encrypted_file_key,omitempty"`
 	CreatedAt         time.Time          `json:"created_at"`
 	ModifiedAt        time.Time          `json:"modified_at"`
 }
 
+// ShareFileRequest carries the key of a file wrapped with the public key of
+// each member it is shared with, by federated user ID
+type ShareFileRequest struct {
+	EncryptedFileKeys map[string]string `json:"encrypted_file_keys"`
+}
+
+// requesterFileKey returns the key of `file` wrapped for the authenticated
+// user, if any.
+func requesterFileKey(ctx context.Context, file *domain.EncryptedFile) string {
+	userID, _ := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	return file.EncryptedFileKeys[userID.Hex()]
+}
+
 // FilesListResponse represents a list of file metadata
 type FilesListResponse struct {
 	Files []FileResponse `json:"files"`
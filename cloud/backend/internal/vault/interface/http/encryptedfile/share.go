@@ -0,0 +1,111 @@
+// cloud/backend/internal/vault/interface/http/encryptedfile/share.go
+package encryptedfile
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
+	svc "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/service/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
+)
+
+// ShareEncryptedFileHandler handles HTTP requests to share the key of an
+// organization file with more members
+type ShareEncryptedFileHandler struct {
+	config       *config.Configuration
+	logger       *zap.Logger
+	shareService svc.ShareEncryptedFileService
+	middleware   middleware.Middleware
+}
+
+// NewShareEncryptedFileHandler creates a new handler for file sharing
+func NewShareEncryptedFileHandler(
+	config *config.Configuration,
+	logger *zap.Logger,
+	shareService svc.ShareEncryptedFileService,
+	middleware middleware.Middleware,
+) *ShareEncryptedFileHandler {
+	return &ShareEncryptedFileHandler{
+		config:       config,
+		logger:       logger.With(zap.String("handler", "share-encrypted-file")),
+		shareService: shareService,
+		middleware:   middleware,
+	}
+}
+
+// Pattern returns the URL pattern for this handler
+func (h *ShareEncryptedFileHandler) Pattern() string {
+	return "PUT /vault/api/v1/encrypted-files/{id}/keys"
+}
+
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*ShareEncryptedFileHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:  "Share the key of an organization file with more members",
+		Request:  ShareFileRequest{},
+		Response: FileResponse{},
+	}
+}
+
+// RequiredPermissions returns the permissions needed to access this handler
+func (h *ShareEncryptedFileHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionVaultFiles}
+}
+
+// ServeHTTP handles HTTP requests
+func (h *ShareEncryptedFileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Apply MaplesSend middleware before handling the request
+	h.middleware.Attach(h.Execute)(w, r)
+}
+
+func (h *ShareEncryptedFileHandler) Execute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := primitive.ObjectIDFromHex(r.PathValue("id"))
+	if err != nil {
+		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("id", "Invalid file ID format"))
+		return
+	}
+
+	var req ShareFileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperror.ResponseError(w, httperror.WithCode(httperror.NewForBadRequestWithSingleField("non_field_error", "payload structure is wrong"), httperror.CodeMalformedRequest))
+		return
+	}
+
+	result, err := h.shareService.Execute(ctx, id, req.EncryptedFileKeys)
+	if err != nil {
+		logging.Logger(ctx, h.logger).Error("Failed to share encrypted file", zap.Error(err))
+		httperror.ResponseError(w, err)
+		return
+	}
+
+	// Return success response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	response := FileResponse{
+		ID:                result.ID,
+		UserID:            result.UserID,
+		OrganizationID:    result.OrganizationID,
+		FileID:            result.FileID,
+		EncryptedMetadata: result.EncryptedMetadata,
+		EncryptionVersion: result.EncryptionVersion,
+		EncryptedHash:     result.EncryptedHash,
+		EncryptedFileKey:  requesterFileKey(ctx, result),
+		CreatedAt:         result.CreatedAt,
+		ModifiedAt:        result.ModifiedAt,
+	}
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logging.Logger(ctx, h.logger).Error("Failed to encode response", zap.Error(err))
+	}
+}
@@ -14,6 +14,10 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/interface/http/middleware"
 	svc "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/service/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/openapi"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 )
 
 // UpdateEncryptedFileHandler handles HTTP requests to update an encrypted file
@@ -44,6 +48,20 @@ func (h *UpdateEncryptedFileHandler) Pattern() string {
 	return "PUT /vault/api/v1/encrypted-files/{id}"
 }
 
+// Describe returns the DTOs of this handler for the OpenAPI document
+func (*UpdateEncryptedFileHandler) Describe() *openapi.Spec {
+	return &openapi.Spec{
+		Summary:            "Replace the metadata or content of an encrypted file",
+		RequestContentType: "multipart/form-data",
+		Response:           FileResponse{},
+	}
+}
+
+// RequiredPermissions returns the permissions needed to access this handler
+func (h *UpdateEncryptedFileHandler) RequiredPermissions() []string {
+	return []string{authorization.PermissionVaultFiles}
+}
+
 // ServeHTTP handles HTTP requests
 func (h *UpdateEncryptedFileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Apply MaplesSend middleware before handling the request
@@ -69,8 +87,11 @@ func (h *UpdateEncryptedFileHandler) Execute(w http.ResponseWriter, r *http.Requ
 	}
 
 	// Parse multipart form to get file and metadata
-	if err := r.ParseMultipartForm(32 << 20); err != nil { // 32MB max
-		h.logger.Error("Failed to parse multipart form", zap.Error(err))
+	_, parseSpan := tracing.Start(ctx, "ParseMultipartForm")
+	err = r.ParseMultipartForm(32 << 20) // 32MB max
+	parseSpan.End()
+	if err != nil {
+		logging.Logger(ctx, h.logger).Error("Failed to parse multipart form", zap.Error(err))
 		httperror.ResponseError(w, httperror.NewForBadRequestWithSingleField("content", "Invalid multipart form"))
 		return
 	}
@@ -97,7 +118,7 @@ func (h *UpdateEncryptedFileHandler) Execute(w http.ResponseWriter, r *http.Requ
 	)
 
 	if err != nil {
-		h.logger.Error("Failed to update encrypted file", zap.Error(err))
+		logging.Logger(ctx, h.logger).Error("Failed to update encrypted file", zap.Error(err))
 		httperror.ResponseError(w, err)
 		return
 	}
@@ -109,15 +130,17 @@ func (h *UpdateEncryptedFileHandler) Execute(w http.ResponseWriter, r *http.Requ
 	response := FileResponse{
 		ID:                result.ID,
 		UserID:            result.UserID,
+		OrganizationID:    result.OrganizationID,
 		FileID:            result.FileID,
 		EncryptedMetadata: result.EncryptedMetadata,
 		EncryptionVersion: result.EncryptionVersion,
 		EncryptedHash:     result.EncryptedHash,
+		EncryptedFileKey:  requesterFileKey(ctx, result),
 		CreatedAt:         result.CreatedAt,
 		ModifiedAt:        result.ModifiedAt,
 	}
 
 	if err := json.NewEncoder(w).Encode(response); err != nil {
-		h.logger.Error("Failed to encode response", zap.Error(err))
+		logging.Logger(ctx, h.logger).Error("Failed to encode response", zap.Error(err))
 	}
 }
@@ -11,15 +11,21 @@ import (
 // Module registers all HTTP handlers for encrypted files
 func Module() fx.Option {
 	return fx.Options(
-		fx.Provide(
-			unifiedhttp.AsRoute(encryptedfile.NewCreateEncryptedFileHandler),
-			unifiedhttp.AsRoute(encryptedfile.NewGetEncryptedFileByIDHandler),
-			unifiedhttp.AsRoute(encryptedfile.NewGetEncryptedFileByFileIDHandler),
-			unifiedhttp.AsRoute(encryptedfile.NewUpdateEncryptedFileHandler),
-			unifiedhttp.AsRoute(encryptedfile.NewDeleteEncryptedFileHandler),
-			unifiedhttp.AsRoute(encryptedfile.NewListEncryptedFilesHandler),
-			unifiedhttp.AsRoute(encryptedfile.NewDownloadEncryptedFileHandler),
-			unifiedhttp.AsRoute(encryptedfile.NewGetEncryptedFileDownloadURLHandler),
-		),
+		fx.Provide(unifiedhttp.AsRoutes(Routes())...),
 	)
 }
+
+// Routes returns the constructors of the routes of this module.
+func Routes() []any {
+	return []any{
+		encryptedfile.NewCreateEncryptedFileHandler,
+		encryptedfile.NewGetEncryptedFileByIDHandler,
+		encryptedfile.NewGetEncryptedFileByFileIDHandler,
+		encryptedfile.NewUpdateEncryptedFileHandler,
+		encryptedfile.NewShareEncryptedFileHandler,
+		encryptedfile.NewDeleteEncryptedFileHandler,
+		encryptedfile.NewListEncryptedFilesHandler,
+		encryptedfile.NewDownloadEncryptedFileHandler,
+		encryptedfile.NewGetEncryptedFileDownloadURLHandler,
+	}
+}
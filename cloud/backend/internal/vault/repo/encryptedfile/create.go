@@ -5,12 +5,16 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.uber.org/zap"
 
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // Create stores a new encrypted file
@@ -19,6 +23,9 @@ func (repo *encryptedFileRepository) Create(
 	file *domain.EncryptedFile,
 	encryptedContent io.Reader,
 ) error {
+	ctx, span := tracing.Start(ctx, "EncryptedFileRepository.Create")
+	defer span.End()
+
 	// Generate a new ID if not provided
 	if file.ID == primitive.NilObjectID {
 		file.ID = primitive.NewObjectID()
@@ -29,14 +36,21 @@ func (repo *encryptedFileRepository) Create(
 	file.CreatedAt = now
 	file.ModifiedAt = now
 
-	// Generate a unique storage path for the file
+	// Generate a unique storage path for the file, the file IDs of the
+	// organization files are unique per organization instead of per user.
 	userID := file.UserID.Hex()
 	storagePath := fmt.Sprintf("%s/%s", userID, file.FileID)
+	if !file.OrganizationID.IsZero() {
+		storagePath = fmt.Sprintf("organizations/%s/%s", file.OrganizationID.Hex(), file.FileID)
+	}
 	file.StoragePath = storagePath
 
 	// Save metadata to MongoDB collection
 	_, err := repo.collection.InsertOne(ctx, file)
 	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return httperror.WithCode(httperror.NewForSingleField(http.StatusConflict, "file_id", "A file with this ID already exists"), httperror.CodeAlreadyExists)
+		}
 		return fmt.Errorf("failed to save encrypted file metadata: %w", err)
 	}
 
@@ -8,6 +8,8 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // DeleteByID deletes an encrypted file
@@ -15,6 +17,9 @@ func (repo *encryptedFileRepository) DeleteByID(
 	ctx context.Context,
 	id primitive.ObjectID,
 ) error {
+	ctx, span := tracing.Start(ctx, "EncryptedFileRepository.DeleteByID")
+	defer span.End()
+
 	// First get the file to retrieve the storage path
 	file, err := repo.GetByID(ctx, id)
 	if err != nil {
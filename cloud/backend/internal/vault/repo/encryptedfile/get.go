@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo"
 
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // GetByID retrieves an encrypted file by its ID
@@ -18,6 +19,9 @@ func (repo *encryptedFileRepository) GetByID(
 	ctx context.Context,
 	id primitive.ObjectID,
 ) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "EncryptedFileRepository.GetByID")
+	defer span.End()
+
 	var file domain.EncryptedFile
 
 	err := repo.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&file)
@@ -35,19 +39,25 @@ func (repo *encryptedFileRepository) GetByID(
 	return &file, nil
 }
 
-// GetByFileID retrieves an encrypted file by user ID and file ID
+// GetByFileID retrieves a personal encrypted file by user ID and file ID, the
+// files the user shared with an organization are retrieved by
+// `GetByOrganizationFileID`.
 func (repo *encryptedFileRepository) GetByFileID(
 	ctx context.Context,
 	userID primitive.ObjectID,
 	fileID string,
 ) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "EncryptedFileRepository.GetByFileID")
+	defer span.End()
+
 	var file domain.EncryptedFile
 
 	err := repo.collection.FindOne(
 		ctx,
 		bson.M{
-			"user_id": userID,
-			"file_id": fileID,
+			"user_id":         userID,
+			"organization_id": bson.M{"$exists": false},
+			"file_id":         fileID,
 		},
 	).Decode(&file)
 
@@ -64,3 +74,32 @@ func (repo *encryptedFileRepository) GetByFileID(
 
 	return &file, nil
 }
+
+// GetByOrganizationFileID retrieves an encrypted file by organization ID and file ID
+func (repo *encryptedFileRepository) GetByOrganizationFileID(
+	ctx context.Context,
+	organizationID primitive.ObjectID,
+	fileID string,
+) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "EncryptedFileRepository.GetByOrganizationFileID")
+	defer span.End()
+
+	var file domain.EncryptedFile
+
+	err := repo.collection.FindOne(
+		ctx,
+		bson.M{
+			"organization_id": organizationID,
+			"file_id":         fileID,
+		},
+	).Decode(&file)
+
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil // Not found
+		}
+		return nil, fmt.Errorf("failed to get encrypted file: %w", err)
+	}
+
+	return &file, nil
+}
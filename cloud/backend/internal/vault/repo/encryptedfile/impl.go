@@ -32,21 +32,49 @@ func NewRepository(
 	// Initialize the MongoDB collection for file metadata
 	collection := database.Collection("encrypted_files")
 
+	// The file IDs were unique per user before the files could be shared
+	// with organizations, which stopped a user from holding a personal and
+	// an organization file with the same ID.
+	if err := collection.Indexes().DropOne(context.Background(), "user_id_1_file_id_1"); err != nil {
+		logger.Debug("Legacy file ID index of encrypted files collection not dropped", zap.Error(err))
+	}
+
 	// Create indexes for efficient queries
 	indexModels := []mongo.IndexModel{
 		{
+			// Personal file IDs are unique per user, the organization ID of
+			// the personal files is missing and indexed as null.
 			Keys: bson.D{
 				{Key: "user_id", Value: 1},
+				{Key: "organization_id", Value: 1},
 				{Key: "file_id", Value: 1},
 			},
 			Options: options.Index().SetUnique(true),
 		},
+		{
+			// Organization file IDs are unique per organization, whichever
+			// member uploaded them.
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "file_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{
+				"organization_id": bson.M{"$exists": true},
+			}),
+		},
 		{
 			Keys: bson.D{
 				{Key: "user_id", Value: 1},
 				{Key: "created_at", Value: -1},
 			},
 		},
+		{
+			Keys: bson.D{
+				{Key: "organization_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+			Options: options.Index().SetSparse(true),
+		},
 	}
 
 	_, err := collection.Indexes().CreateMany(context.Background(), indexModels)
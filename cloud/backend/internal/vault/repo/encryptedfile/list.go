@@ -10,20 +10,27 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
-// ListByUserID lists all encrypted files for a user
+// ListByUserID lists all personal encrypted files for a user
 func (repo *encryptedFileRepository) ListByUserID(
 	ctx context.Context,
 	userID primitive.ObjectID,
 ) ([]*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "EncryptedFileRepository.ListByUserID")
+	defer span.End()
+
 	// Define query options for sorting by creation time
 	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
 
 	// Execute the query
 	cursor, err := repo.collection.Find(
 		ctx,
-		bson.M{"user_id": userID},
+		bson.M{
+			"user_id":         userID,
+			"organization_id": bson.M{"$exists": false},
+		},
 		findOptions,
 	)
 
@@ -40,3 +47,35 @@ func (repo *encryptedFileRepository) ListByUserID(
 
 	return files, nil
 }
+
+// ListByOrganizationID lists all encrypted files shared with an organization
+func (repo *encryptedFileRepository) ListByOrganizationID(
+	ctx context.Context,
+	organizationID primitive.ObjectID,
+) ([]*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "EncryptedFileRepository.ListByOrganizationID")
+	defer span.End()
+
+	// Define query options for sorting by creation time
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	// Execute the query
+	cursor, err := repo.collection.Find(
+		ctx,
+		bson.M{"organization_id": organizationID},
+		findOptions,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization encrypted files: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	// Decode the results
+	var files []*domain.EncryptedFile
+	if err := cursor.All(ctx, &files); err != nil {
+		return nil, fmt.Errorf("failed to decode encrypted files: %w", err)
+	}
+
+	return files, nil
+}
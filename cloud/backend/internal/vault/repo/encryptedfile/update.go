@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // UpdateByID updates an encrypted file
@@ -19,6 +20,9 @@ func (repo *encryptedFileRepository) UpdateByID(
 	file *domain.EncryptedFile,
 	encryptedContent io.Reader,
 ) error {
+	ctx, span := tracing.Start(ctx, "EncryptedFileRepository.UpdateByID")
+	defer span.End()
+
 	// Get the existing file to retrieve the storage path
 	existingFile, err := repo.GetByID(ctx, file.ID)
 	if err != nil {
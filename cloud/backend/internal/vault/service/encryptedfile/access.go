@@ -0,0 +1,79 @@
+package encryptedfile
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/organizationmember"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+)
+
+// sessionOrganizationID returns the organization the request acts on behalf
+// of, or a zero ID when the request is in a personal context.
+func sessionOrganizationID(ctx context.Context) primitive.ObjectID {
+	orgID, _ := ctx.Value(constants.SessionOrganizationID).(primitive.ObjectID)
+	return orgID
+}
+
+// canAccessFile returns true if the authenticated user uploaded `file` or
+// the file is shared with the organization selected for this request. The
+// members decrypt the shared files with their copy of the file key, see
+// `EncryptedFile.EncryptedFileKeys`.
+func canAccessFile(ctx context.Context, file *encryptedfile.EncryptedFile) bool {
+	userID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok || userID.IsZero() || file.UserID == userID {
+		return true
+	}
+	orgID := sessionOrganizationID(ctx)
+	return !orgID.IsZero() && file.OrganizationID == orgID
+}
+
+// canModifyFile is like `canAccessFile` but only owners and admins of the
+// organization may update, share or delete files uploaded by other members.
+func canModifyFile(ctx context.Context, file *encryptedfile.EncryptedFile) bool {
+	userID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !ok || userID.IsZero() || file.UserID == userID {
+		return true
+	}
+	role, _ := ctx.Value(constants.SessionOrganizationRole).(int8)
+	return canAccessFile(ctx, file) && dom_member.CanManageMembers(role)
+}
+
+// validateFileKeys returns an error unless every one of the wrapped
+// `fileKeys` belongs to a member of the organization. Personal files are
+// only decrypted by the uploader so they cannot share their key.
+func validateFileKeys(
+	ctx context.Context,
+	memberListUseCase uc_member.OrganizationMemberListByOrganizationIDUseCase,
+	organizationID primitive.ObjectID,
+	fileKeys map[string]string,
+) error {
+	if len(fileKeys) == 0 {
+		return nil
+	}
+	if organizationID.IsZero() {
+		return httperror.NewForBadRequestWithSingleField("encrypted_file_keys", "Only files shared with an organization can share their key")
+	}
+	members, err := memberListUseCase.Execute(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m.FederatedUserID.Hex()] = true
+	}
+	for userID, fileKey := range fileKeys {
+		if !isMember[userID] {
+			return httperror.NewForBadRequestWithSingleField("encrypted_file_keys", fmt.Sprintf("User %s is not a member of the organization", userID))
+		}
+		if fileKey == "" {
+			return httperror.NewForBadRequestWithSingleField("encrypted_file_keys", fmt.Sprintf("File key of user %s is empty", userID))
+		}
+	}
+	return nil
+}
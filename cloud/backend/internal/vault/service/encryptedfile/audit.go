@@ -0,0 +1,37 @@
+package encryptedfile
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+)
+
+// recordFileAuditEvent appends an event about `file` to the security audit
+// log. The file owner is the subject so they see who touched their file.
+// Failing to record is logged but never blocks the request.
+func recordFileAuditEvent(
+	ctx context.Context,
+	logger *zap.Logger,
+	uc uc_auditevent.AuditEventCreateUseCase,
+	eventType string,
+	outcome string,
+	file *encryptedfile.EncryptedFile,
+) {
+	event := &dom_auditevent.AuditEvent{
+		Type:          eventType,
+		Outcome:       outcome,
+		SubjectUserID: file.UserID,
+		ResourceType:  dom_auditevent.AuditEventResourceTypeEncryptedFile,
+		ResourceID:    file.ID.Hex(),
+		Details:       map[string]string{"file_id": file.FileID},
+	}
+	if err := uc.Execute(ctx, event); err != nil {
+		logger.Error("Failed to record audit event",
+			zap.String("type", eventType),
+			zap.Error(err))
+	}
+}
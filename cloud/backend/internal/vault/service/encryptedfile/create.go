@@ -12,20 +12,28 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/zap"
 
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/object/s3"
 )
 
 // CreateEncryptedFileService defines the service for creating encrypted files
 type CreateEncryptedFileService interface {
-	Execute(ctx context.Context, userID primitive.ObjectID, fileID, encryptedMetadata, encryptedHash, encryptionVersion string, encryptedContent io.Reader) (*encryptedfile.EncryptedFile, error)
+	Execute(ctx context.Context, userID primitive.ObjectID, fileID, encryptedMetadata, encryptedHash, encryptionVersion string, encryptedFileKeys map[string]string, encryptedContent io.Reader) (*encryptedfile.EncryptedFile, error)
 }
 
 // createEncryptedFileService implements the CreateEncryptedFileService interface
 type createEncryptedFileService struct {
-	repo      encryptedfile.Repository
-	s3Storage s3.S3ObjectStorage
-	logger    *zap.Logger
+	repo                    encryptedfile.Repository
+	s3Storage               s3.S3ObjectStorage
+	logger                  *zap.Logger
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
+	memberListUseCase       uc_member.OrganizationMemberListByOrganizationIDUseCase
 }
 
 // NewCreateEncryptedFileService creates a new service instance
@@ -33,11 +41,15 @@ func NewCreateEncryptedFileService(
 	repo encryptedfile.Repository,
 	s3Storage s3.S3ObjectStorage,
 	logger *zap.Logger,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+	memberListUseCase uc_member.OrganizationMemberListByOrganizationIDUseCase,
 ) CreateEncryptedFileService {
 	return &createEncryptedFileService{
-		repo:      repo,
-		s3Storage: s3Storage,
-		logger:    logger.With(zap.String("service", "create-encrypted-file")),
+		repo:                    repo,
+		s3Storage:               s3Storage,
+		logger:                  logger.With(zap.String("service", "create-encrypted-file")),
+		auditEventCreateUseCase: auditEventCreateUseCase,
+		memberListUseCase:       memberListUseCase,
 	}
 }
 
@@ -49,12 +61,27 @@ func (s *createEncryptedFileService) Execute(
 	encryptedMetadata string,
 	encryptedHash string,
 	encryptionVersion string,
+	encryptedFileKeys map[string]string,
 	encryptedContent io.Reader,
 ) (*encryptedfile.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "CreateEncryptedFileService.Execute")
+	defer span.End()
+
+	// The other members cannot decrypt a shared file without its key.
+	organizationID := sessionOrganizationID(ctx)
+	if !organizationID.IsZero() && len(encryptedFileKeys) == 0 {
+		return nil, httperror.NewForBadRequestWithSingleField("encrypted_file_keys", "Files shared with an organization need the file key of its members")
+	}
+	if err := validateFileKeys(ctx, s.memberListUseCase, organizationID, encryptedFileKeys); err != nil {
+		return nil, err
+	}
+
 	// Create a new file entry
 	file := &encryptedfile.EncryptedFile{
 		ID:                primitive.NewObjectID(),
 		UserID:            userID,
+		OrganizationID:    organizationID,
+		EncryptedFileKeys: encryptedFileKeys,
 		FileID:            fileID,
 		EncryptedMetadata: encryptedMetadata,
 		EncryptedHash:     encryptedHash,
@@ -93,10 +120,10 @@ func (s *createEncryptedFileService) Execute(
 		// Set the encrypted size in the file metadata
 		file.EncryptedSize = int64(len(content))
 
-		// Create the S3 storage path using the same format as in the Repository
-		storagePath := fmt.Sprintf("%s/%s", userID.Hex(), fileID)
+		// Upload to the S3 storage path chosen by the repository
+		storagePath := file.StoragePath
 
-		// Upload to S3 - always use private visibility for encrypted files
+		// Always use private visibility for encrypted files
 		err = s.s3Storage.UploadContentWithVisibility(ctx, storagePath, content, false)
 		if err != nil {
 			s.logger.Error("Failed to upload encrypted content to S3",
@@ -106,6 +133,7 @@ func (s *createEncryptedFileService) Execute(
 				zap.String("storage_path", storagePath))
 			return nil, fmt.Errorf("failed to upload encrypted content: %w", err)
 		}
+		metrics.ObserveUpload(file.EncryptedSize)
 
 		s.logger.Debug("Successfully uploaded encrypted content to S3",
 			zap.String("user_id", userID.Hex()),
@@ -118,5 +146,7 @@ func (s *createEncryptedFileService) Execute(
 		// If it needs to be saved, we'd need another repo call to update
 	}
 
+	recordFileAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, dom_auditevent.AuditEventTypeFileCreated, dom_auditevent.AuditEventOutcomeSuccess, file)
+
 	return file, nil
 }
@@ -10,8 +10,11 @@ import (
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/usecase/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // DeleteEncryptedFileService defines operations for deleting an encrypted file
@@ -20,10 +23,11 @@ type DeleteEncryptedFileService interface {
 }
 
 type deleteEncryptedFileServiceImpl struct {
-	config         *config.Configuration
-	logger         *zap.Logger
-	getByIDUseCase encryptedfile.GetEncryptedFileByIDUseCase
-	deleteUseCase  encryptedfile.DeleteEncryptedFileUseCase
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	getByIDUseCase          encryptedfile.GetEncryptedFileByIDUseCase
+	deleteUseCase           encryptedfile.DeleteEncryptedFileUseCase
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
 }
 
 // NewDeleteEncryptedFileService creates a new instance of the service
@@ -32,12 +36,14 @@ func NewDeleteEncryptedFileService(
 	logger *zap.Logger,
 	getByIDUseCase encryptedfile.GetEncryptedFileByIDUseCase,
 	deleteUseCase encryptedfile.DeleteEncryptedFileUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
 ) DeleteEncryptedFileService {
 	return &deleteEncryptedFileServiceImpl{
-		config:         config,
-		logger:         logger.With(zap.String("component", "delete-encrypted-file-service")),
-		getByIDUseCase: getByIDUseCase,
-		deleteUseCase:  deleteUseCase,
+		config:                  config,
+		logger:                  logger.With(zap.String("component", "delete-encrypted-file-service")),
+		getByIDUseCase:          getByIDUseCase,
+		deleteUseCase:           deleteUseCase,
+		auditEventCreateUseCase: auditEventCreateUseCase,
 	}
 }
 
@@ -46,6 +52,9 @@ func (s *deleteEncryptedFileServiceImpl) Execute(
 	ctx context.Context,
 	id primitive.ObjectID,
 ) error {
+	ctx, span := tracing.Start(ctx, "DeleteEncryptedFileService.Execute")
+	defer span.End()
+
 	// Validate inputs (moved from usecase to service)
 	if id.IsZero() {
 		return httperror.NewForBadRequestWithSingleField("id", "File ID cannot be empty")
@@ -62,18 +71,19 @@ func (s *deleteEncryptedFileServiceImpl) Execute(
 	}
 
 	if file == nil {
-		return httperror.NewForBadRequestWithSingleField("id", "File not found")
+		return httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "File not found"), httperror.CodeFileNotFound)
 	}
 
 	// Verify that the authenticated user has access to this file
-	userID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
-	if ok && !userID.IsZero() && file.UserID != userID {
+	userID, _ := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !canModifyFile(ctx, file) {
 		s.logger.Warn("Unauthorized file deletion attempt",
 			zap.String("file_id", id.Hex()),
 			zap.String("file_owner", file.UserID.Hex()),
 			zap.String("requester", userID.Hex()),
 		)
-		return httperror.NewForForbiddenWithSingleField("message", "You do not have permission to delete this file")
+		recordFileAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, dom_auditevent.AuditEventTypeFileDeleted, dom_auditevent.AuditEventOutcomeFailure, file)
+		return httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "You do not have permission to delete this file"), httperror.CodeFileAccessDenied)
 	}
 
 	// Delete the file using the use case
@@ -86,6 +96,8 @@ func (s *deleteEncryptedFileServiceImpl) Execute(
 		return fmt.Errorf("failed to delete encrypted file: %w", err)
 	}
 
+	recordFileAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, dom_auditevent.AuditEventTypeFileDeleted, dom_auditevent.AuditEventOutcomeSuccess, file)
+
 	s.logger.Info("Successfully deleted encrypted file",
 		zap.String("id", id.Hex()),
 		zap.String("userID", file.UserID.Hex()),
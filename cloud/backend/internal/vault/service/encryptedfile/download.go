@@ -10,8 +10,11 @@ import (
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/usecase/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // DownloadEncryptedFileService defines operations for downloading encrypted file content
@@ -20,10 +23,11 @@ type DownloadEncryptedFileService interface {
 }
 
 type downloadEncryptedFileServiceImpl struct {
-	config          *config.Configuration
-	logger          *zap.Logger
-	getByIDUseCase  encryptedfile.GetEncryptedFileByIDUseCase
-	downloadUseCase encryptedfile.DownloadEncryptedFileUseCase
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	getByIDUseCase          encryptedfile.GetEncryptedFileByIDUseCase
+	downloadUseCase         encryptedfile.DownloadEncryptedFileUseCase
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
 }
 
 // NewDownloadEncryptedFileService creates a new instance of the service
@@ -32,12 +36,14 @@ func NewDownloadEncryptedFileService(
 	logger *zap.Logger,
 	getByIDUseCase encryptedfile.GetEncryptedFileByIDUseCase,
 	downloadUseCase encryptedfile.DownloadEncryptedFileUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
 ) DownloadEncryptedFileService {
 	return &downloadEncryptedFileServiceImpl{
-		config:          config,
-		logger:          logger.With(zap.String("component", "download-encrypted-file-service")),
-		getByIDUseCase:  getByIDUseCase,
-		downloadUseCase: downloadUseCase,
+		config:                  config,
+		logger:                  logger.With(zap.String("component", "download-encrypted-file-service")),
+		getByIDUseCase:          getByIDUseCase,
+		downloadUseCase:         downloadUseCase,
+		auditEventCreateUseCase: auditEventCreateUseCase,
 	}
 }
 
@@ -46,6 +52,9 @@ func (s *downloadEncryptedFileServiceImpl) Execute(
 	ctx context.Context,
 	id primitive.ObjectID,
 ) (io.ReadCloser, error) {
+	ctx, span := tracing.Start(ctx, "DownloadEncryptedFileService.Execute")
+	defer span.End()
+
 	// First get the file to verify ownership
 	file, err := s.getByIDUseCase.Execute(ctx, id)
 	if err != nil {
@@ -53,20 +62,27 @@ func (s *downloadEncryptedFileServiceImpl) Execute(
 	}
 
 	if file == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("id", "File not found")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "File not found"), httperror.CodeFileNotFound)
 	}
 
 	// Verify that the authenticated user has access to this file
-	userID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
-	if ok && !userID.IsZero() && file.UserID != userID {
+	userID, _ := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !canAccessFile(ctx, file) {
 		s.logger.Warn("Unauthorized file download attempt",
 			zap.String("file_id", id.Hex()),
 			zap.String("file_owner", file.UserID.Hex()),
 			zap.String("requester", userID.Hex()),
 		)
-		return nil, httperror.NewForForbiddenWithSingleField("message", "You do not have permission to download this file")
+		recordFileAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, dom_auditevent.AuditEventTypeFileDownloaded, dom_auditevent.AuditEventOutcomeFailure, file)
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "You do not have permission to download this file"), httperror.CodeFileAccessDenied)
 	}
 
 	// Download the file using the use case
-	return s.downloadUseCase.Execute(ctx, id)
+	content, err := s.downloadUseCase.Execute(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	recordFileAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, dom_auditevent.AuditEventTypeFileDownloaded, dom_auditevent.AuditEventOutcomeSuccess, file)
+	return content, nil
 }
@@ -12,6 +12,7 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/usecase/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // GetEncryptedFileByFileIDService defines operations for retrieving an encrypted file by file ID
@@ -23,6 +24,8 @@ type getEncryptedFileByFileIDServiceImpl struct {
 	config             *config.Configuration
 	logger             *zap.Logger
 	getByFileIDUseCase encryptedfile.GetEncryptedFileByFileIDUseCase
+
+	getByOrganizationFileIDUseCase encryptedfile.GetEncryptedFileByOrganizationFileIDUseCase
 }
 
 // NewGetEncryptedFileByFileIDService creates a new instance of the service
@@ -30,11 +33,14 @@ func NewGetEncryptedFileByFileIDService(
 	config *config.Configuration,
 	logger *zap.Logger,
 	getByFileIDUseCase encryptedfile.GetEncryptedFileByFileIDUseCase,
+	getByOrganizationFileIDUseCase encryptedfile.GetEncryptedFileByOrganizationFileIDUseCase,
 ) GetEncryptedFileByFileIDService {
 	return &getEncryptedFileByFileIDServiceImpl{
 		config:             config,
 		logger:             logger.With(zap.String("component", "get-encrypted-file-by-file-id-service")),
 		getByFileIDUseCase: getByFileIDUseCase,
+
+		getByOrganizationFileIDUseCase: getByOrganizationFileIDUseCase,
 	}
 }
 
@@ -44,6 +50,9 @@ func (s *getEncryptedFileByFileIDServiceImpl) Execute(
 	userID primitive.ObjectID,
 	fileID string,
 ) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "GetEncryptedFileByFileIDService.Execute")
+	defer span.End()
+
 	// Extract authenticated user ID from context if not provided
 	if userID.IsZero() {
 		contextUserID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
@@ -61,7 +70,12 @@ func (s *getEncryptedFileByFileIDServiceImpl) Execute(
 			zap.String("requested_user_id", userID.Hex()),
 			zap.String("authenticated_user_id", contextUserID.Hex()),
 		)
-		return nil, httperror.NewForForbiddenWithSingleField("message", "You do not have permission to access files for this user")
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "You do not have permission to access files for this user"), httperror.CodeFileAccessDenied)
+	}
+
+	// In an organization context the file ID is scoped to the organization.
+	if orgID := sessionOrganizationID(ctx); !orgID.IsZero() {
+		return s.getByOrganizationFileIDUseCase.Execute(ctx, orgID, fileID)
 	}
 
 	// Get the file using the use case
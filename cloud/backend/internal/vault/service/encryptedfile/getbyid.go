@@ -13,6 +13,7 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/usecase/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // GetEncryptedFileByIDService defines operations for retrieving an encrypted file by ID
@@ -44,6 +45,9 @@ func (s *getEncryptedFileByIDServiceImpl) Execute(
 	ctx context.Context,
 	id primitive.ObjectID,
 ) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "GetEncryptedFileByIDService.Execute")
+	defer span.End()
+
 	// Validate inputs (moved from usecase to service)
 	if id.IsZero() {
 		return nil, httperror.NewForBadRequestWithSingleField("id", "File ID cannot be empty")
@@ -60,18 +64,18 @@ func (s *getEncryptedFileByIDServiceImpl) Execute(
 	}
 
 	if file == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("id", "File not found")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "File not found"), httperror.CodeFileNotFound)
 	}
 
 	// Verify that the authenticated user has access to this file
-	userID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
-	if ok && !userID.IsZero() && file.UserID != userID {
+	userID, _ := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !canAccessFile(ctx, file) {
 		s.logger.Warn("Unauthorized file access attempt",
 			zap.String("file_id", id.Hex()),
 			zap.String("file_owner", file.UserID.Hex()),
 			zap.String("requester", userID.Hex()),
 		)
-		return nil, httperror.NewForForbiddenWithSingleField("message", "You do not have permission to access this file")
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "You do not have permission to access this file"), httperror.CodeFileAccessDenied)
 	}
 
 	return file, nil
@@ -10,8 +10,11 @@ import (
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/usecase/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // GetEncryptedFileDownloadURLService defines operations for generating a download URL
@@ -20,10 +23,11 @@ type GetEncryptedFileDownloadURLService interface {
 }
 
 type getEncryptedFileDownloadURLServiceImpl struct {
-	config                *config.Configuration
-	logger                *zap.Logger
-	getByIDUseCase        encryptedfile.GetEncryptedFileByIDUseCase
-	getDownloadURLUseCase encryptedfile.GetEncryptedFileDownloadURLUseCase
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	getByIDUseCase          encryptedfile.GetEncryptedFileByIDUseCase
+	getDownloadURLUseCase   encryptedfile.GetEncryptedFileDownloadURLUseCase
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
 }
 
 // NewGetEncryptedFileDownloadURLService creates a new instance of the service
@@ -32,12 +36,14 @@ func NewGetEncryptedFileDownloadURLService(
 	logger *zap.Logger,
 	getByIDUseCase encryptedfile.GetEncryptedFileByIDUseCase,
 	getDownloadURLUseCase encryptedfile.GetEncryptedFileDownloadURLUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
 ) GetEncryptedFileDownloadURLService {
 	return &getEncryptedFileDownloadURLServiceImpl{
-		config:                config,
-		logger:                logger.With(zap.String("component", "get-encrypted-file-download-url-service")),
-		getByIDUseCase:        getByIDUseCase,
-		getDownloadURLUseCase: getDownloadURLUseCase,
+		config:                  config,
+		logger:                  logger.With(zap.String("component", "get-encrypted-file-download-url-service")),
+		getByIDUseCase:          getByIDUseCase,
+		getDownloadURLUseCase:   getDownloadURLUseCase,
+		auditEventCreateUseCase: auditEventCreateUseCase,
 	}
 }
 
@@ -47,6 +53,9 @@ func (s *getEncryptedFileDownloadURLServiceImpl) Execute(
 	id primitive.ObjectID,
 	expiryDuration time.Duration,
 ) (string, error) {
+	ctx, span := tracing.Start(ctx, "GetEncryptedFileDownloadURLService.Execute")
+	defer span.End()
+
 	// First get the file to verify ownership
 	file, err := s.getByIDUseCase.Execute(ctx, id)
 	if err != nil {
@@ -54,20 +63,28 @@ func (s *getEncryptedFileDownloadURLServiceImpl) Execute(
 	}
 
 	if file == nil {
-		return "", httperror.NewForBadRequestWithSingleField("id", "File not found")
+		return "", httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "File not found"), httperror.CodeFileNotFound)
 	}
 
 	// Verify that the authenticated user has access to this file
-	userID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
-	if ok && !userID.IsZero() && file.UserID != userID {
+	userID, _ := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !canAccessFile(ctx, file) {
 		s.logger.Warn("Unauthorized file URL generation attempt",
 			zap.String("file_id", id.Hex()),
 			zap.String("file_owner", file.UserID.Hex()),
 			zap.String("requester", userID.Hex()),
 		)
-		return "", httperror.NewForForbiddenWithSingleField("message", "You do not have permission to get a download URL for this file")
+		recordFileAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, dom_auditevent.AuditEventTypeFileDownloaded, dom_auditevent.AuditEventOutcomeFailure, file)
+		return "", httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "You do not have permission to get a download URL for this file"), httperror.CodeFileAccessDenied)
 	}
 
 	// Get the download URL using the use case
-	return s.getDownloadURLUseCase.Execute(ctx, id, expiryDuration)
+	url, err := s.getDownloadURLUseCase.Execute(ctx, id, expiryDuration)
+	if err != nil {
+		return "", err
+	}
+
+	// Handing out a presigned URL is as good as downloading the file.
+	recordFileAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, dom_auditevent.AuditEventTypeFileDownloaded, dom_auditevent.AuditEventOutcomeSuccess, file)
+	return url, nil
 }
@@ -12,6 +12,7 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/usecase/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // ListEncryptedFilesService defines operations for listing all encrypted files for a user
@@ -23,6 +24,8 @@ type listEncryptedFilesServiceImpl struct {
 	config      *config.Configuration
 	logger      *zap.Logger
 	listUseCase encryptedfile.ListEncryptedFilesUseCase
+
+	listByOrganizationIDUseCase encryptedfile.ListEncryptedFilesByOrganizationIDUseCase
 }
 
 // NewListEncryptedFilesService creates a new instance of the service
@@ -30,11 +33,14 @@ func NewListEncryptedFilesService(
 	config *config.Configuration,
 	logger *zap.Logger,
 	listUseCase encryptedfile.ListEncryptedFilesUseCase,
+	listByOrganizationIDUseCase encryptedfile.ListEncryptedFilesByOrganizationIDUseCase,
 ) ListEncryptedFilesService {
 	return &listEncryptedFilesServiceImpl{
 		config:      config,
 		logger:      logger.With(zap.String("component", "list-encrypted-files-service")),
 		listUseCase: listUseCase,
+
+		listByOrganizationIDUseCase: listByOrganizationIDUseCase,
 	}
 }
 
@@ -43,6 +49,9 @@ func (s *listEncryptedFilesServiceImpl) Execute(
 	ctx context.Context,
 	userID primitive.ObjectID,
 ) ([]*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "ListEncryptedFilesService.Execute")
+	defer span.End()
+
 	// Extract authenticated user ID from context if not provided
 	if userID.IsZero() {
 		contextUserID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
@@ -60,7 +69,13 @@ func (s *listEncryptedFilesServiceImpl) Execute(
 			zap.String("requested_user_id", userID.Hex()),
 			zap.String("authenticated_user_id", contextUserID.Hex()),
 		)
-		return nil, httperror.NewForForbiddenWithSingleField("message", "You do not have permission to list files for this user")
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "You do not have permission to list files for this user"), httperror.CodeFileAccessDenied)
+	}
+
+	// In an organization context list the files shared with the organization
+	// instead of the personal files of the user.
+	if orgID := sessionOrganizationID(ctx); !orgID.IsZero() {
+		return s.listByOrganizationIDUseCase.Execute(ctx, orgID)
 	}
 
 	// List the files using the use case
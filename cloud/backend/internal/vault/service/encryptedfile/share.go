@@ -0,0 +1,99 @@
+// cloud/backend/internal/vault/service/encryptedfile/share.go
+package encryptedfile
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+	dom_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/domain/auditevent"
+	uc_auditevent "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/auditevent"
+	uc_member "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/iam/usecase/organizationmember"
+	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/usecase/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// ShareEncryptedFileService defines operations for sharing the key of an
+// organization file with more members, like the ones who joined after the
+// upload
+type ShareEncryptedFileService interface {
+	Execute(ctx context.Context, id primitive.ObjectID, encryptedFileKeys map[string]string) (*domain.EncryptedFile, error)
+}
+
+type shareEncryptedFileServiceImpl struct {
+	config                  *config.Configuration
+	logger                  *zap.Logger
+	getByIDUseCase          encryptedfile.GetEncryptedFileByIDUseCase
+	updateKeysUseCase       encryptedfile.UpdateEncryptedFileKeysUseCase
+	memberListUseCase       uc_member.OrganizationMemberListByOrganizationIDUseCase
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase
+}
+
+// NewShareEncryptedFileService creates a new instance of the service
+func NewShareEncryptedFileService(
+	config *config.Configuration,
+	logger *zap.Logger,
+	getByIDUseCase encryptedfile.GetEncryptedFileByIDUseCase,
+	updateKeysUseCase encryptedfile.UpdateEncryptedFileKeysUseCase,
+	memberListUseCase uc_member.OrganizationMemberListByOrganizationIDUseCase,
+	auditEventCreateUseCase uc_auditevent.AuditEventCreateUseCase,
+) ShareEncryptedFileService {
+	return &shareEncryptedFileServiceImpl{
+		config:                  config,
+		logger:                  logger.With(zap.String("component", "share-encrypted-file-service")),
+		getByIDUseCase:          getByIDUseCase,
+		updateKeysUseCase:       updateKeysUseCase,
+		memberListUseCase:       memberListUseCase,
+		auditEventCreateUseCase: auditEventCreateUseCase,
+	}
+}
+
+// Execute stores the file keys wrapped by the client for the given members
+// after verifying the requester may modify the file
+func (s *shareEncryptedFileServiceImpl) Execute(
+	ctx context.Context,
+	id primitive.ObjectID,
+	encryptedFileKeys map[string]string,
+) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "ShareEncryptedFileService.Execute")
+	defer span.End()
+
+	// First get the file to verify ownership
+	file, err := s.getByIDUseCase.Execute(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file for sharing: %w", err)
+	}
+	if file == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "File not found"), httperror.CodeFileNotFound)
+	}
+
+	userID, _ := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !canModifyFile(ctx, file) {
+		s.logger.Warn("Unauthorized file sharing attempt",
+			zap.String("file_id", id.Hex()),
+			zap.String("file_owner", file.UserID.Hex()),
+			zap.String("requester", userID.Hex()),
+		)
+		recordFileAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, dom_auditevent.AuditEventTypeFileShared, dom_auditevent.AuditEventOutcomeFailure, file)
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "You do not have permission to share this file"), httperror.CodeFileAccessDenied)
+	}
+
+	if err := validateFileKeys(ctx, s.memberListUseCase, file.OrganizationID, encryptedFileKeys); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.updateKeysUseCase.Execute(ctx, id, encryptedFileKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	recordFileAuditEvent(ctx, s.logger, s.auditEventCreateUseCase, dom_auditevent.AuditEventTypeFileShared, dom_auditevent.AuditEventOutcomeSuccess, updated)
+
+	return updated, nil
+}
@@ -15,6 +15,8 @@ import (
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/usecase/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/object/s3"
 )
 
@@ -56,24 +58,27 @@ func (s *updateEncryptedFileServiceImpl) Execute(
 	encryptedHash string,
 	encryptedContent io.Reader,
 ) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "UpdateEncryptedFileService.Execute")
+	defer span.End()
+
 	// First get the file to verify ownership
 	file, err := s.getByIDUseCase.Execute(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	if file == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("id", "File not found")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "File not found"), httperror.CodeFileNotFound)
 	}
 
 	// Verify that the authenticated user has access to this file
-	userID, ok := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
-	if ok && !userID.IsZero() && file.UserID != userID {
+	userID, _ := ctx.Value(constants.SessionFederatedUserID).(primitive.ObjectID)
+	if !canModifyFile(ctx, file) {
 		s.logger.Warn("Unauthorized file update attempt",
 			zap.String("file_id", id.Hex()),
 			zap.String("file_owner", file.UserID.Hex()),
 			zap.String("requester", userID.Hex()),
 		)
-		return nil, httperror.NewForForbiddenWithSingleField("message", "You do not have permission to update this file")
+		return nil, httperror.WithCode(httperror.NewForForbiddenWithSingleField("message", "You do not have permission to update this file"), httperror.CodeFileAccessDenied)
 	}
 
 	// If new content is provided, update it in S3 first
@@ -100,6 +105,7 @@ func (s *updateEncryptedFileServiceImpl) Execute(
 				zap.String("storage_path", file.StoragePath))
 			return nil, fmt.Errorf("failed to upload updated encrypted content: %w", err)
 		}
+		metrics.ObserveUpload(int64(len(content)))
 
 		s.logger.Debug("Successfully uploaded updated encrypted content to S3",
 			zap.String("file_id", id.Hex()),
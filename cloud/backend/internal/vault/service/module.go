@@ -15,6 +15,7 @@ func Module() fx.Option {
 			encryptedfile.NewGetEncryptedFileByIDService,
 			encryptedfile.NewGetEncryptedFileByFileIDService,
 			encryptedfile.NewUpdateEncryptedFileService,
+			encryptedfile.NewShareEncryptedFileService,
 			encryptedfile.NewDeleteEncryptedFileService,
 			encryptedfile.NewListEncryptedFilesService,
 			encryptedfile.NewDownloadEncryptedFileService,
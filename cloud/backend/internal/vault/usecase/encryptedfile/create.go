@@ -6,6 +6,7 @@ import (
 	"io"
 
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // CreateEncryptedFileUseCase defines operations for creating a new encrypted file
@@ -30,6 +31,9 @@ func (uc *createEncryptedFileUseCaseImpl) Execute(
 	file *domain.EncryptedFile,
 	encryptedContent io.Reader,
 ) error {
+	ctx, span := tracing.Start(ctx, "CreateEncryptedFileUseCase.Execute")
+	defer span.End()
+
 	// Simply delegate to repository for storage
 	return uc.repository.Create(ctx, file, encryptedContent)
 }
@@ -7,6 +7,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // DeleteEncryptedFileUseCase defines operations for deleting an encrypted file
@@ -30,6 +31,9 @@ func (uc *deleteEncryptedFileUseCaseImpl) Execute(
 	ctx context.Context,
 	id primitive.ObjectID,
 ) error {
+	ctx, span := tracing.Start(ctx, "DeleteEncryptedFileUseCase.Execute")
+	defer span.End()
+
 	// Simply delegate to repository for deletion
 	return uc.repository.DeleteByID(ctx, id)
 }
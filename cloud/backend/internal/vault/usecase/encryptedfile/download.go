@@ -12,6 +12,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/object/s3"
 )
 
@@ -47,6 +48,9 @@ func (uc *downloadEncryptedFileUseCaseImpl) Execute(
 	ctx context.Context,
 	id primitive.ObjectID,
 ) (io.ReadCloser, error) {
+	ctx, span := tracing.Start(ctx, "DownloadEncryptedFileUseCase.Execute")
+	defer span.End()
+
 	// Validate inputs
 	if id.IsZero() {
 		return nil, httperror.NewForBadRequestWithSingleField("id", "File ID cannot be empty")
@@ -63,7 +67,7 @@ func (uc *downloadEncryptedFileUseCaseImpl) Execute(
 	}
 
 	if file == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("id", "File not found")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "File not found"), httperror.CodeFileNotFound)
 	}
 
 	// Use the S3 storage to download the file
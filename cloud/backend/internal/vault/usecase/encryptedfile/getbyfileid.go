@@ -11,6 +11,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // GetEncryptedFileByFileIDUseCase defines operations for retrieving an encrypted file by user ID and file ID
@@ -43,6 +44,9 @@ func (uc *getEncryptedFileByFileIDUseCaseImpl) Execute(
 	userID primitive.ObjectID,
 	fileID string,
 ) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "GetEncryptedFileByFileIDUseCase.Execute")
+	defer span.End()
+
 	// Validate inputs
 	if userID.IsZero() {
 		return nil, httperror.NewForBadRequestWithSingleField("user_id", "User ID cannot be empty")
@@ -64,7 +68,7 @@ func (uc *getEncryptedFileByFileIDUseCaseImpl) Execute(
 	}
 
 	if file == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("file_id", "File not found")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("file_id", "File not found"), httperror.CodeFileNotFound)
 	}
 
 	return file, nil
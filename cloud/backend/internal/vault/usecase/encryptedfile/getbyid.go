@@ -7,6 +7,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // GetEncryptedFileByIDUseCase defines operations for retrieving an encrypted file by ID
@@ -30,6 +31,9 @@ func (uc *getEncryptedFileByIDUseCaseImpl) Execute(
 	ctx context.Context,
 	id primitive.ObjectID,
 ) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "GetEncryptedFileByIDUseCase.Execute")
+	defer span.End()
+
 	// Simply delegate to repository for retrieval
 	return uc.repository.GetByID(ctx, id)
 }
@@ -0,0 +1,75 @@
+// cloud/backend/internal/vault/usecase/encryptedfile/getbyorganizationfileid.go
+package encryptedfile
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// GetEncryptedFileByOrganizationFileIDUseCase defines operations for retrieving an encrypted file by organization ID and file ID
+type GetEncryptedFileByOrganizationFileIDUseCase interface {
+	Execute(ctx context.Context, organizationID primitive.ObjectID, fileID string) (*domain.EncryptedFile, error)
+}
+
+type getEncryptedFileByOrganizationFileIDUseCaseImpl struct {
+	config     *config.Configuration
+	logger     *zap.Logger
+	repository domain.Repository
+}
+
+// NewGetEncryptedFileByOrganizationFileIDUseCase creates a new instance of the use case
+func NewGetEncryptedFileByOrganizationFileIDUseCase(
+	config *config.Configuration,
+	logger *zap.Logger,
+	repository domain.Repository,
+) GetEncryptedFileByOrganizationFileIDUseCase {
+	return &getEncryptedFileByOrganizationFileIDUseCaseImpl{
+		config:     config,
+		logger:     logger.With(zap.String("component", "get-encrypted-file-by-organization-file-id-usecase")),
+		repository: repository,
+	}
+}
+
+// Execute retrieves an encrypted file by organization ID and file ID
+func (uc *getEncryptedFileByOrganizationFileIDUseCaseImpl) Execute(
+	ctx context.Context,
+	organizationID primitive.ObjectID,
+	fileID string,
+) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "GetEncryptedFileByOrganizationFileIDUseCase.Execute")
+	defer span.End()
+
+	// Validate inputs
+	if organizationID.IsZero() {
+		return nil, httperror.NewForBadRequestWithSingleField("organization_id", "Organization ID cannot be empty")
+	}
+
+	if fileID == "" {
+		return nil, httperror.NewForBadRequestWithSingleField("file_id", "File ID cannot be empty")
+	}
+
+	// Retrieve the file
+	file, err := uc.repository.GetByOrganizationFileID(ctx, organizationID, fileID)
+	if err != nil {
+		uc.logger.Error("Failed to get encrypted file by organization file ID",
+			zap.String("organizationID", organizationID.Hex()),
+			zap.String("fileID", fileID),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get encrypted file: %w", err)
+	}
+
+	if file == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("file_id", "File not found"), httperror.CodeFileNotFound)
+	}
+
+	return file, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/object/s3"
 )
 
@@ -48,6 +49,9 @@ func (uc *getEncryptedFileDownloadURLUseCaseImpl) Execute(
 	id primitive.ObjectID,
 	expiryDuration time.Duration,
 ) (string, error) {
+	ctx, span := tracing.Start(ctx, "GetEncryptedFileDownloadURLUseCase.Execute")
+	defer span.End()
+
 	// Validate inputs
 	if id.IsZero() {
 		return "", httperror.NewForBadRequestWithSingleField("id", "File ID cannot be empty")
@@ -75,7 +79,7 @@ func (uc *getEncryptedFileDownloadURLUseCaseImpl) Execute(
 	}
 
 	if file == nil {
-		return "", httperror.NewForBadRequestWithSingleField("id", "File not found")
+		return "", httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "File not found"), httperror.CodeFileNotFound)
 	}
 
 	// Generate the download URL
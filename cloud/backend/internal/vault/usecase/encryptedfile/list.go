@@ -11,6 +11,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // ListEncryptedFilesUseCase defines operations for listing all encrypted files for a user
@@ -42,6 +43,9 @@ func (uc *listEncryptedFilesUseCaseImpl) Execute(
 	ctx context.Context,
 	userID primitive.ObjectID,
 ) ([]*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "ListEncryptedFilesUseCase.Execute")
+	defer span.End()
+
 	// Validate inputs
 	if userID.IsZero() {
 		return nil, httperror.NewForBadRequestWithSingleField("user_id", "User ID cannot be empty")
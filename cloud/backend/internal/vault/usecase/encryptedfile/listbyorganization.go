@@ -0,0 +1,70 @@
+// cloud/backend/internal/vault/usecase/encryptedfile/listbyorganization.go
+package encryptedfile
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// ListEncryptedFilesByOrganizationIDUseCase defines operations for listing all encrypted files shared with an organization
+type ListEncryptedFilesByOrganizationIDUseCase interface {
+	Execute(ctx context.Context, organizationID primitive.ObjectID) ([]*domain.EncryptedFile, error)
+}
+
+type listEncryptedFilesByOrganizationIDUseCaseImpl struct {
+	config     *config.Configuration
+	logger     *zap.Logger
+	repository domain.Repository
+}
+
+// NewListEncryptedFilesByOrganizationIDUseCase creates a new instance of the use case
+func NewListEncryptedFilesByOrganizationIDUseCase(
+	config *config.Configuration,
+	logger *zap.Logger,
+	repository domain.Repository,
+) ListEncryptedFilesByOrganizationIDUseCase {
+	return &listEncryptedFilesByOrganizationIDUseCaseImpl{
+		config:     config,
+		logger:     logger.With(zap.String("component", "list-encrypted-files-by-organization-id-usecase")),
+		repository: repository,
+	}
+}
+
+// Execute lists all encrypted files shared with an organization
+func (uc *listEncryptedFilesByOrganizationIDUseCaseImpl) Execute(
+	ctx context.Context,
+	organizationID primitive.ObjectID,
+) ([]*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "ListEncryptedFilesByOrganizationIDUseCase.Execute")
+	defer span.End()
+
+	// Validate inputs
+	if organizationID.IsZero() {
+		return nil, httperror.NewForBadRequestWithSingleField("organization_id", "Organization ID cannot be empty")
+	}
+
+	// List the files
+	files, err := uc.repository.ListByOrganizationID(ctx, organizationID)
+	if err != nil {
+		uc.logger.Error("Failed to list organization encrypted files",
+			zap.String("organizationID", organizationID.Hex()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list encrypted files: %w", err)
+	}
+
+	uc.logger.Debug("Successfully listed organization encrypted files",
+		zap.String("organizationID", organizationID.Hex()),
+		zap.Int("count", len(files)),
+	)
+
+	return files, nil
+}
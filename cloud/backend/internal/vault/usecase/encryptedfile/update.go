@@ -12,6 +12,7 @@ import (
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // UpdateEncryptedFileUseCase defines operations for updating an encrypted file
@@ -46,6 +47,9 @@ func (uc *updateEncryptedFileUseCaseImpl) Execute(
 	encryptedHash string,
 	encryptedContent io.Reader,
 ) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "UpdateEncryptedFileUseCase.Execute")
+	defer span.End()
+
 	// Validate inputs
 	if id.IsZero() {
 		return nil, httperror.NewForBadRequestWithSingleField("id", "File ID cannot be empty")
@@ -62,7 +66,7 @@ func (uc *updateEncryptedFileUseCaseImpl) Execute(
 	}
 
 	if existingFile == nil {
-		return nil, httperror.NewForBadRequestWithSingleField("id", "File not found")
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "File not found"), httperror.CodeFileNotFound)
 	}
 
 	// Update the file fields
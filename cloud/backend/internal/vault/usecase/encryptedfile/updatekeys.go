@@ -0,0 +1,96 @@
+// cloud/backend/internal/vault/usecase/encryptedfile/updatekeys.go
+package encryptedfile
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	domain "github.com/Maple-Open-Tech/monorepo/cloud/backend/internal/vault/domain/encryptedfile"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// UpdateEncryptedFileKeysUseCase defines operations for sharing the key of
+// an encrypted file with more members
+type UpdateEncryptedFileKeysUseCase interface {
+	Execute(ctx context.Context, id primitive.ObjectID, encryptedFileKeys map[string]string) (*domain.EncryptedFile, error)
+}
+
+type updateEncryptedFileKeysUseCaseImpl struct {
+	config     *config.Configuration
+	logger     *zap.Logger
+	repository domain.Repository
+}
+
+// NewUpdateEncryptedFileKeysUseCase creates a new instance of the use case
+func NewUpdateEncryptedFileKeysUseCase(
+	config *config.Configuration,
+	logger *zap.Logger,
+	repository domain.Repository,
+) UpdateEncryptedFileKeysUseCase {
+	return &updateEncryptedFileKeysUseCaseImpl{
+		config:     config,
+		logger:     logger.With(zap.String("component", "update-encrypted-file-keys-usecase")),
+		repository: repository,
+	}
+}
+
+// Execute adds the wrapped file keys to the file, replacing the ones of the
+// same members
+func (uc *updateEncryptedFileKeysUseCaseImpl) Execute(
+	ctx context.Context,
+	id primitive.ObjectID,
+	encryptedFileKeys map[string]string,
+) (*domain.EncryptedFile, error) {
+	ctx, span := tracing.Start(ctx, "UpdateEncryptedFileKeysUseCase.Execute")
+	defer span.End()
+
+	// Validate inputs
+	if id.IsZero() {
+		return nil, httperror.NewForBadRequestWithSingleField("id", "File ID cannot be empty")
+	}
+	if len(encryptedFileKeys) == 0 {
+		return nil, httperror.NewForBadRequestWithSingleField("encrypted_file_keys", "File keys are required")
+	}
+
+	// Get the existing file
+	existingFile, err := uc.repository.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("Failed to get existing file for key update",
+			zap.String("id", id.Hex()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get existing file: %w", err)
+	}
+
+	if existingFile == nil {
+		return nil, httperror.WithCode(httperror.NewForBadRequestWithSingleField("id", "File not found"), httperror.CodeFileNotFound)
+	}
+
+	if existingFile.EncryptedFileKeys == nil {
+		existingFile.EncryptedFileKeys = make(map[string]string, len(encryptedFileKeys))
+	}
+	for userID, fileKey := range encryptedFileKeys {
+		existingFile.EncryptedFileKeys[userID] = fileKey
+	}
+
+	// Update the file
+	if err := uc.repository.UpdateByID(ctx, existingFile, nil); err != nil {
+		uc.logger.Error("Failed to update encrypted file keys",
+			zap.String("id", id.Hex()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to update encrypted file keys: %w", err)
+	}
+
+	uc.logger.Info("Successfully updated encrypted file keys",
+		zap.String("id", id.Hex()),
+		zap.Int("keys", len(encryptedFileKeys)),
+	)
+
+	return existingFile, nil
+}
@@ -14,9 +14,12 @@ func Module() fx.Option {
 			encryptedfile.NewCreateEncryptedFileUseCase,
 			encryptedfile.NewGetEncryptedFileByIDUseCase,
 			encryptedfile.NewGetEncryptedFileByFileIDUseCase,
+			encryptedfile.NewGetEncryptedFileByOrganizationFileIDUseCase,
 			encryptedfile.NewUpdateEncryptedFileUseCase,
+			encryptedfile.NewUpdateEncryptedFileKeysUseCase,
 			encryptedfile.NewDeleteEncryptedFileUseCase,
 			encryptedfile.NewListEncryptedFilesUseCase,
+			encryptedfile.NewListEncryptedFilesByOrganizationIDUseCase,
 			encryptedfile.NewDownloadEncryptedFileUseCase,
 			encryptedfile.NewGetEncryptedFileDownloadURLUseCase,
 		),
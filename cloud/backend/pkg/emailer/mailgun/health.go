@@ -0,0 +1,20 @@
+package mailgun
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/health"
+)
+
+// NewHealthCheck returns the readiness check confirming the emailer of the
+// module `name` is configured, without it users cannot verify their email or
+// sign in. Mailgun itself is not contacted to avoid using up the quota.
+func NewHealthCheck(name string, emailer Emailer) health.Check {
+	return health.NewCheck(name+"-emailer", func(ctx context.Context) error {
+		if !emailer.IsConfigured() {
+			return errors.New("mailgun api key, domain or sender email is missing")
+		}
+		return nil
+	})
+}
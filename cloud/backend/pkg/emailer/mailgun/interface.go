@@ -9,4 +9,5 @@ type Emailer interface {
 	GetBackendDomainName() string
 	GetFrontendDomainName() string
 	GetMaintenanceEmail() string
+	IsConfigured() bool // Reports whether the API key, domain and sender are set.
 }
@@ -61,3 +61,7 @@ func (me *mailgunEmailer) GetFrontendDomainName() string {
 func (me *mailgunEmailer) GetMaintenanceEmail() string {
 	return me.config.GetMaintenanceEmail()
 }
+
+func (me *mailgunEmailer) IsConfigured() bool {
+	return me.config.GetAPIKey() != "" && me.config.GetDomainName() != "" && me.config.GetSenderEmail() != ""
+}
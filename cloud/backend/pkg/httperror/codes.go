@@ -0,0 +1,175 @@
+package httperror
+
+import "net/http"
+
+// Error codes are the stable, machine-readable identifiers of the problems
+// returned by the API. Clients should branch on them instead of the
+// messages, which may change. Once published a code must never be renamed.
+const (
+	// General codes, used by default depending on the status code.
+	CodeValidationFailed   = "validation_failed"
+	CodeUnauthenticated    = "unauthenticated"
+	CodeForbidden          = "forbidden"
+	CodeNotFound           = "not_found"
+	CodeConflict           = "conflict"
+	CodeGone               = "gone"
+	CodeUnprocessable      = "unprocessable"
+	CodeLocked             = "locked"
+	CodeRateLimited        = "rate_limited"
+	CodeInternal           = "internal_error"
+	CodeServiceUnavailable = "service_unavailable"
+	CodeMalformedRequest   = "malformed_request"
+	CodeAlreadyExists      = "already_exists"
+	CodeRequestTooLarge    = "request_too_large"
+
+	// Access control codes, returned before the request reaches a module.
+	CodeIPAddressBanned = "ip_address_banned"
+	CodeCountryBlocked  = "country_blocked"
+
+	// Idempotency codes.
+	CodeInvalidIdempotencyKey = "invalid_idempotency_key"
+	CodeIdempotencyKeyReused  = "idempotency_key_reused"
+	CodeIdempotencyKeyInUse   = "idempotency_key_in_use"
+
+	// IAM codes.
+	CodeEmailAlreadyExists           = "email_already_exists"
+	CodeEmailNotFound                = "email_not_found"
+	CodeEmailAlreadyVerified         = "email_already_verified"
+	CodeIncorrectPassword            = "incorrect_password"
+	CodeInvalidBetaAccessCode        = "invalid_beta_access_code"
+	CodeAccountLocked                = "account_locked"
+	CodeAccountArchived              = "account_archived"
+	CodeAccountDisabled              = "account_disabled"
+	CodeLoginThrottled               = "login_throttled"
+	CodeVerificationAttemptsExceeded = "verification_attempts_exceeded"
+	CodeInvalidVerificationCode      = "invalid_verification_code"
+	CodeVerificationCodeExpired      = "verification_code_expired"
+	CodeVerificationCodeUsed         = "verification_code_used"
+	CodeInvalidChallenge             = "invalid_challenge"
+	CodeChallengeExpired             = "challenge_expired"
+	CodeChallengeUsed                = "challenge_used"
+	CodeInvalidChallengeResponse     = "invalid_challenge_response"
+	CodeInvalidToken                 = "invalid_token"
+	CodeTokenExpired                 = "token_expired"
+	CodeTokenUsed                    = "token_used"
+	CodeSessionExpired               = "session_expired"
+	CodeInvalidAPIKey                = "invalid_api_key"
+	CodeMagicLinkDisabled            = "magic_link_disabled"
+	CodeNoRecoveryKey                = "no_recovery_key"
+	CodeAuthorizationRequestNotFound = "authorization_request_not_found"
+	CodeTermsOfServiceOutdated       = "terms_of_service_outdated"
+	CodeNotOrganizationMember        = "not_organization_member"
+	CodeOrganizationNotFound         = "organization_not_found"
+	CodeMemberNotFound               = "member_not_found"
+	CodeAlreadyMember                = "already_member"
+	CodeLastOwner                    = "last_owner"
+	CodeInvitationNotFound           = "invitation_not_found"
+	CodeInvitationAlreadySent        = "invitation_already_sent"
+	CodeInvitationEmailMismatch      = "invitation_email_mismatch"
+	CodePermissionDenied             = "permission_denied"
+	CodeUserNotFound                 = "user_not_found"
+
+	// Vault codes.
+	CodeFileNotFound     = "file_not_found"
+	CodeFileAccessDenied = "file_access_denied"
+)
+
+// titles are the short, human-readable summaries of the problem types,
+// which unlike the details must not change from one occurrence to another.
+var titles = map[string]string{
+	CodeValidationFailed:             "Your request parameters didn't validate",
+	CodeUnauthenticated:              "Authentication is required",
+	CodeForbidden:                    "You are not allowed to do this",
+	CodeNotFound:                     "The resource does not exist",
+	CodeConflict:                     "The request conflicts with the current state",
+	CodeGone:                         "The resource is no longer available",
+	CodeUnprocessable:                "The request could not be processed",
+	CodeLocked:                       "The resource is locked",
+	CodeRateLimited:                  "Too many requests",
+	CodeInternal:                     "An internal error occurred",
+	CodeServiceUnavailable:           "The service is unavailable",
+	CodeMalformedRequest:             "The request body is malformed",
+	CodeAlreadyExists:                "The resource already exists",
+	CodeRequestTooLarge:              "The request body is too large",
+	CodeIPAddressBanned:              "Your IP address is banned",
+	CodeCountryBlocked:               "Access is denied from your country",
+	CodeInvalidIdempotencyKey:        "The idempotency key is invalid",
+	CodeIdempotencyKeyReused:         "The idempotency key was used for another request",
+	CodeIdempotencyKeyInUse:          "A request with the idempotency key is in progress",
+	CodeEmailAlreadyExists:           "The email address is already in use",
+	CodeEmailNotFound:                "The email address does not exist",
+	CodeEmailAlreadyVerified:         "The email address is already verified",
+	CodeIncorrectPassword:            "The password is incorrect",
+	CodeInvalidBetaAccessCode:        "The beta access code is invalid",
+	CodeAccountLocked:                "The account is locked",
+	CodeAccountArchived:              "The account has been archived",
+	CodeAccountDisabled:              "The account is disabled",
+	CodeLoginThrottled:               "Too many login requests",
+	CodeVerificationAttemptsExceeded: "Too many failed verification attempts",
+	CodeInvalidVerificationCode:      "The verification code is invalid",
+	CodeVerificationCodeExpired:      "The verification code has expired",
+	CodeVerificationCodeUsed:         "The verification code has already been used",
+	CodeInvalidChallenge:             "The challenge is invalid",
+	CodeChallengeExpired:             "The challenge has expired",
+	CodeChallengeUsed:                "The challenge has already been used",
+	CodeInvalidChallengeResponse:     "The challenge response is invalid",
+	CodeInvalidToken:                 "The token is invalid",
+	CodeTokenExpired:                 "The token has expired",
+	CodeTokenUsed:                    "The token has already been used",
+	CodeSessionExpired:               "The session has expired",
+	CodeInvalidAPIKey:                "The API key is invalid or has expired",
+	CodeMagicLinkDisabled:            "Magic links are not enabled",
+	CodeNoRecoveryKey:                "The account has no recovery key",
+	CodeAuthorizationRequestNotFound: "The authorization request does not exist",
+	CodeTermsOfServiceOutdated:       "The terms of service must be accepted",
+	CodeNotOrganizationMember:        "You are not a member of the organization",
+	CodeOrganizationNotFound:         "The organization does not exist",
+	CodeMemberNotFound:               "The member does not exist",
+	CodeAlreadyMember:                "The user is already a member of the organization",
+	CodeLastOwner:                    "The organization must keep an owner",
+	CodeInvitationNotFound:           "The invitation does not exist",
+	CodeInvitationAlreadySent:        "The invitation was already sent",
+	CodeInvitationEmailMismatch:      "The invitation was sent to another email address",
+	CodePermissionDenied:             "You do not have the required permission",
+	CodeUserNotFound:                 "The user does not exist",
+	CodeFileNotFound:                 "The file does not exist",
+	CodeFileAccessDenied:             "You do not have access to the file",
+}
+
+// DefaultCode returns the code of the errors created without one.
+func DefaultCode(statusCode int) string {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return CodeValidationFailed
+	case http.StatusUnauthorized:
+		return CodeUnauthenticated
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusGone:
+		return CodeGone
+	case http.StatusRequestEntityTooLarge:
+		return CodeRequestTooLarge
+	case http.StatusUnprocessableEntity:
+		return CodeUnprocessable
+	case http.StatusLocked:
+		return CodeLocked
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	default:
+		return CodeInternal
+	}
+}
+
+// Title returns the summary of the problem type `code`.
+func Title(code string, statusCode int) string {
+	if title, ok := titles[code]; ok {
+		return title
+	}
+	return http.StatusText(statusCode)
+}
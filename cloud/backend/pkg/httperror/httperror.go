@@ -6,12 +6,18 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/logging"
 )
 
 // HTTPError represents an http error that occurred while handling a request
 type HTTPError struct {
 	Code   int                `json:"-"` // HTTP Status code. We use `-` to skip json marshaling.
 	Errors *map[string]string `json:"-"` // The original error. Same reason as above.
+
+	// ErrorCode is the stable, machine-readable code of the error, defaults
+	// to the code of the status code if empty. See `WithCode`.
+	ErrorCode string `json:"-"`
 }
 
 // New creates a new HTTPError instance with a multi-field errors.
@@ -101,6 +107,37 @@ func NewForGoneWithSingleField(field string, message string) error {
 	}
 }
 
+// NewForTooManyRequestsWithSingleField create a new HTTPError instance pertaining to 429 too many requests for a single field. This is a convinience constructor.
+func NewForTooManyRequestsWithSingleField(field string, message string) error {
+	return HTTPError{
+		Code:   http.StatusTooManyRequests,
+		Errors: &map[string]string{field: message},
+	}
+}
+
+// WithCode returns `err` with the machine-readable `code` if it is an
+// HTTPError, otherwise it returns `err` unchanged.
+func WithCode(err error, code string) error {
+	var ew HTTPError
+	if !errors.As(err, &ew) {
+		return err
+	}
+	ew.ErrorCode = code
+	return ew
+}
+
+// Code returns the machine-readable code of `err`.
+func Code(err error) string {
+	var ew HTTPError
+	if !errors.As(err, &ew) {
+		return CodeInternal
+	}
+	if ew.ErrorCode != "" {
+		return ew.ErrorCode
+	}
+	return DefaultCode(ew.Code)
+}
+
 // Error function used to implement the `error` interface for returning errors.
 func (err HTTPError) Error() string {
 	b, e := json.Marshal(err.Errors)
@@ -111,10 +148,22 @@ func (err HTTPError) Error() string {
 }
 
 // ResponseError function returns the HTTP error response based on the httpcode used.
+// The error is written as RFC 7807 problem details if the client asked for them,
+// see `Negotiate`, or as the legacy map of field errors otherwise.
 func ResponseError(rw http.ResponseWriter, err error) {
 	// Copied from:
 	// https://dev.to/tigorlazuardi/go-creating-custom-error-wrapper-and-do-proper-error-equality-check-11k7
 
+	if pw := negotiated(rw); pw != nil {
+		var ew HTTPError
+		if !errors.As(err, &ew) {
+			// Do not leak the internal error to the client.
+			ew = HTTPError{Code: http.StatusInternalServerError}
+		}
+		writeProblem(rw, NewProblem(ew, pw.instance, rw.Header().Get(logging.RequestIDHeader)))
+		return
+	}
+
 	rw.Header().Set("Content-Type", "Application/json")
 
 	//
@@ -124,7 +173,7 @@ func ResponseError(rw http.ResponseWriter, err error) {
 	var ew HTTPError
 	if errors.As(err, &ew) {
 		rw.WriteHeader(ew.Code)
-		_ = json.NewEncoder(rw).Encode(ew.Errors)
+		_ = json.NewEncoder(rw).Encode(withRequestID(rw, ew.Errors))
 		return
 	}
 
@@ -136,3 +185,19 @@ func ResponseError(rw http.ResponseWriter, err error) {
 
 	_ = json.NewEncoder(rw).Encode(err.Error())
 }
+
+// withRequestID adds the request ID, set in the response headers by the
+// request ID middleware, to the errors so clients can include it in their
+// reports.
+func withRequestID(rw http.ResponseWriter, errs *map[string]string) *map[string]string {
+	requestID := rw.Header().Get(logging.RequestIDHeader)
+	if requestID == "" || errs == nil {
+		return errs
+	}
+	body := make(map[string]string, len(*errs)+1)
+	for field, message := range *errs {
+		body[field] = message
+	}
+	body["request_id"] = requestID
+	return &body
+}
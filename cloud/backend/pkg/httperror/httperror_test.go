@@ -307,6 +307,13 @@ func TestConvenienceConstructors(t *testing.T) {
 			},
 			wantCode: http.StatusGone,
 		},
+		{
+			name: "NewForTooManyRequestsWithSingleField",
+			create: func() error {
+				return NewForTooManyRequestsWithSingleField("field", "message")
+			},
+			wantCode: http.StatusTooManyRequests,
+		},
 	}
 
 	for _, tt := range tests {
@@ -325,3 +332,29 @@ func TestConvenienceConstructors(t *testing.T) {
 		})
 	}
 }
+
+func TestResponseErrorWithRequestID(t *testing.T) {
+	rr := httptest.NewRecorder()
+	rr.Header().Set("X-Request-ID", "abc-123")
+	err := NewForBadRequestWithSingleField("field", "invalid")
+	ResponseError(rr, err)
+
+	var body map[string]string
+	if decodeErr := json.NewDecoder(rr.Body).Decode(&body); decodeErr != nil {
+		t.Fatalf("failed to decode response: %v", decodeErr)
+	}
+	if body["request_id"] != "abc-123" {
+		t.Errorf("ResponseError() request_id = %v, want abc-123", body["request_id"])
+	}
+	if body["field"] != "invalid" {
+		t.Errorf("ResponseError() field = %v, want invalid", body["field"])
+	}
+
+	// The error itself must stay untouched.
+	var ew HTTPError
+	if errors.As(err, &ew) {
+		if _, ok := (*ew.Errors)["request_id"]; ok {
+			t.Error("ResponseError() modified the errors of the error")
+		}
+	}
+}
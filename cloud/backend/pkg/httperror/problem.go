@@ -0,0 +1,121 @@
+package httperror
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details, which
+// clients request with the `Accept` header.
+const ProblemContentType = "application/problem+json"
+
+// ProblemTypeURIPrefix prefixes the code of a problem to form its type URI.
+const ProblemTypeURIPrefix = "urn:problem-type:"
+
+// Problem is the RFC 7807 representation of an HTTPError. The field errors,
+// the code and the request ID are extension members.
+type Problem struct {
+	Type      string            `json:"type"`
+	Title     string            `json:"title"`
+	Status    int               `json:"status"`
+	Detail    string            `json:"detail,omitempty"`
+	Instance  string            `json:"instance,omitempty"`
+	Code      string            `json:"code"`
+	RequestID string            `json:"request_id,omitempty"`
+	Errors    map[string]string `json:"errors,omitempty"`
+}
+
+// NewProblem returns the problem details of `ew` which occurred on the
+// request of path `instance`.
+func NewProblem(ew HTTPError, instance, requestID string) *Problem {
+	code := ew.ErrorCode
+	if code == "" {
+		code = DefaultCode(ew.Code)
+	}
+	p := &Problem{
+		Type:      ProblemTypeURIPrefix + code,
+		Title:     Title(code, ew.Code),
+		Status:    ew.Code,
+		Instance:  instance,
+		Code:      code,
+		RequestID: requestID,
+	}
+	if ew.Errors != nil && len(*ew.Errors) > 0 {
+		p.Errors = *ew.Errors
+		if len(p.Errors) == 1 {
+			for _, message := range p.Errors {
+				p.Detail = message
+			}
+		} else {
+			p.Detail = "One or more fields are invalid"
+		}
+	}
+	return p
+}
+
+// problemWriter remembers the error format negotiated for the request so
+// `ResponseError` can honour it without access to the request.
+type problemWriter struct {
+	http.ResponseWriter
+	instance string
+}
+
+// Unwrap lets `http.ResponseController` reach the original writer.
+func (pw *problemWriter) Unwrap() http.ResponseWriter {
+	return pw.ResponseWriter
+}
+
+// Negotiate returns the writer through which the errors of the request `r`
+// are written as problem details, if the client accepts them, or as the
+// legacy map of field errors otherwise. It must wrap the writer before the
+// handlers, writers wrapping it afterwards need an `Unwrap` method.
+func Negotiate(w http.ResponseWriter, r *http.Request) http.ResponseWriter {
+	w.Header().Add("Vary", "Accept")
+	if !AcceptsProblem(r.Header.Values("Accept")) {
+		return w
+	}
+	return &problemWriter{ResponseWriter: w, instance: r.URL.Path}
+}
+
+// AcceptsProblem returns true if the values of the `Accept` header include
+// the problem details media type with a non zero quality.
+func AcceptsProblem(accept []string) bool {
+	for _, value := range accept {
+		for _, part := range strings.Split(value, ",") {
+			mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+			if err != nil || mediaType != ProblemContentType {
+				continue
+			}
+			if q, ok := params["q"]; ok {
+				if f, err := strconv.ParseFloat(q, 64); err != nil || f <= 0 {
+					continue
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// negotiated returns the problem writer wrapped by `rw`, if any.
+func negotiated(rw http.ResponseWriter) *problemWriter {
+	for {
+		if pw, ok := rw.(*problemWriter); ok {
+			return pw
+		}
+		u, ok := rw.(interface{ Unwrap() http.ResponseWriter })
+		if !ok {
+			return nil
+		}
+		rw = u.Unwrap()
+	}
+}
+
+func writeProblem(rw http.ResponseWriter, p *Problem) {
+	rw.Header().Set("Content-Type", ProblemContentType)
+	rw.WriteHeader(p.Status)
+	_ = json.NewEncoder(rw).Encode(p)
+}
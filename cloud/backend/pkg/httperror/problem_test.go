@@ -0,0 +1,157 @@
+package httperror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAcceptsProblem(t *testing.T) {
+	tests := []struct {
+		name   string
+		accept []string
+		want   bool
+	}{
+		{name: "no header", accept: nil, want: false},
+		{name: "json", accept: []string{"application/json"}, want: false},
+		{name: "any", accept: []string{"*/*"}, want: false},
+		{name: "problem", accept: []string{"application/problem+json"}, want: true},
+		{name: "problem in list", accept: []string{"application/json, application/problem+json;q=0.9"}, want: true},
+		{name: "problem in second value", accept: []string{"text/html", "application/problem+json"}, want: true},
+		{name: "problem refused", accept: []string{"application/problem+json;q=0"}, want: false},
+		{name: "invalid quality", accept: []string{"application/problem+json;q=x"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AcceptsProblem(tt.accept); got != tt.want {
+				t.Errorf("AcceptsProblem() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "default", err: NewForBadRequestWithSingleField("field", "invalid"), want: CodeValidationFailed},
+		{name: "not found", err: NewForNotFoundWithSingleField("id", "missing"), want: CodeNotFound},
+		{name: "with code", err: WithCode(NewForBadRequestWithSingleField("id", "File not found"), CodeFileNotFound), want: CodeFileNotFound},
+		{name: "wrapped", err: errors.Join(errors.New("context"), WithCode(NewForLockedWithSingleField("email", "locked"), CodeAccountLocked)), want: CodeAccountLocked},
+		{name: "standard error", err: errors.New("boom"), want: CodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Code(tt.err); got != tt.want {
+				t.Errorf("Code() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithCodeStandardError(t *testing.T) {
+	err := errors.New("boom")
+	if got := WithCode(err, CodeFileNotFound); got != err {
+		t.Errorf("WithCode() = %v, want the error unchanged", got)
+	}
+}
+
+func TestResponseErrorNegotiation(t *testing.T) {
+	err := WithCode(NewForBadRequestWithSingleField("id", "File not found"), CodeFileNotFound)
+
+	t.Run("legacy", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/vault/api/v1/encrypted-files/1", nil)
+		r.Header.Set("Accept", "application/json")
+		ResponseError(Negotiate(rr, r), err)
+
+		if ct := rr.Header().Get("Content-Type"); ct != "Application/json" {
+			t.Errorf("Content-Type = %v, want Application/json", ct)
+		}
+		var body map[string]string
+		if decodeErr := json.NewDecoder(rr.Body).Decode(&body); decodeErr != nil {
+			t.Fatalf("failed to decode response: %v", decodeErr)
+		}
+		if len(body) != 1 || body["id"] != "File not found" {
+			t.Errorf("body = %v, want the legacy map", body)
+		}
+	})
+
+	t.Run("problem", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		rr.Header().Set("X-Request-ID", "abc-123")
+		r := httptest.NewRequest(http.MethodGet, "/vault/api/v1/encrypted-files/1", nil)
+		r.Header.Set("Accept", "application/problem+json")
+		ResponseError(Negotiate(rr, r), err)
+
+		if rr.Code != http.StatusBadRequest {
+			t.Errorf("code = %v, want %v", rr.Code, http.StatusBadRequest)
+		}
+		if ct := rr.Header().Get("Content-Type"); ct != ProblemContentType {
+			t.Errorf("Content-Type = %v, want %v", ct, ProblemContentType)
+		}
+		if vary := rr.Header().Get("Vary"); vary != "Accept" {
+			t.Errorf("Vary = %v, want Accept", vary)
+		}
+		var p Problem
+		if decodeErr := json.NewDecoder(rr.Body).Decode(&p); decodeErr != nil {
+			t.Fatalf("failed to decode response: %v", decodeErr)
+		}
+		want := Problem{
+			Type:      ProblemTypeURIPrefix + CodeFileNotFound,
+			Title:     Title(CodeFileNotFound, http.StatusBadRequest),
+			Status:    http.StatusBadRequest,
+			Detail:    "File not found",
+			Instance:  "/vault/api/v1/encrypted-files/1",
+			Code:      CodeFileNotFound,
+			RequestID: "abc-123",
+		}
+		if p.Type != want.Type || p.Title != want.Title || p.Status != want.Status || p.Detail != want.Detail ||
+			p.Instance != want.Instance || p.Code != want.Code || p.RequestID != want.RequestID {
+			t.Errorf("problem = %+v, want %+v", p, want)
+		}
+		if p.Errors["id"] != "File not found" {
+			t.Errorf("problem errors = %v, want the field errors", p.Errors)
+		}
+	})
+
+	t.Run("problem through wrapping writer", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept", "application/problem+json")
+		ResponseError(&wrappingWriter{Negotiate(rr, r)}, errors.New("secret database failure"))
+
+		if rr.Code != http.StatusInternalServerError {
+			t.Errorf("code = %v, want %v", rr.Code, http.StatusInternalServerError)
+		}
+		var p Problem
+		if decodeErr := json.NewDecoder(rr.Body).Decode(&p); decodeErr != nil {
+			t.Fatalf("failed to decode response: %v", decodeErr)
+		}
+		if p.Code != CodeInternal || p.Detail != "" {
+			t.Errorf("problem = %+v, want an internal error without details", p)
+		}
+	})
+}
+
+type wrappingWriter struct {
+	http.ResponseWriter
+}
+
+func (w *wrappingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func TestEveryCodeHasATitle(t *testing.T) {
+	for _, status := range []int{400, 401, 403, 404, 409, 410, 413, 422, 423, 429, 500, 503} {
+		if _, ok := titles[DefaultCode(status)]; !ok {
+			t.Errorf("code %v has no title", DefaultCode(status))
+		}
+	}
+}
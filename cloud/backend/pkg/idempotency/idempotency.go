@@ -0,0 +1,216 @@
+// Package idempotency lets clients safely retry the mutating requests sent
+// with an `Idempotency-Key` header: the response of the first request is
+// stored and replayed to the retries instead of executing them again.
+package idempotency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+)
+
+const (
+	// HeaderKey is the request header holding the key chosen by the client,
+	// a unique random value like a UUID reused for every retry.
+	HeaderKey = "Idempotency-Key"
+
+	// HeaderReplayed is set on the responses replayed from a previous
+	// request.
+	HeaderReplayed = "Idempotent-Replayed"
+
+	// MaxResponseBytes is the size of the largest response body which is
+	// stored, larger responses are not replayed.
+	MaxResponseBytes = 1 << 20
+
+	// memoryBodyBytes is the size of the largest request body kept in
+	// memory while it is fingerprinted, larger bodies like the uploads are
+	// spooled to a temporary file.
+	memoryBodyBytes = 1 << 20
+)
+
+// keyPattern accepts 1 to 255 visible ASCII characters.
+var keyPattern = regexp.MustCompile(`^[\x21-\x7e]{1,255}$`)
+
+// replayedHeaders are the response headers stored with the body, the other
+// ones, like the request ID or the rate limits, describe the retry itself.
+var replayedHeaders = []string{"Content-Type", "Content-Language", "Location"}
+
+// Applies returns true if requests of the `method` honour the key.
+func Applies(method string) bool {
+	return method == http.MethodPost || method == http.MethodPatch
+}
+
+// ValidKey returns true if `key` is an acceptable idempotency key.
+func ValidKey(key string) bool {
+	return keyPattern.MatchString(key)
+}
+
+// CacheKey returns the cache key of the response to the idempotency `key`
+// sent by the client identified by `scope`, like the authenticated user, so
+// clients can never replay the responses of one another.
+func CacheKey(scope, key string) string {
+	s := sha256.Sum256([]byte(scope))
+	k := sha256.Sum256([]byte(key))
+	return "idempotency:" + hex.EncodeToString(s[:]) + ":" + hex.EncodeToString(k[:])
+}
+
+// Fingerprint returns the digest of the method, URL and body of the request
+// and replaces its body, which was consumed, by a copy. The returned
+// function releases the copy and must be called once the request is served.
+func Fingerprint(r *http.Request) (string, func(), error) {
+	h := sha256.New()
+	io.WriteString(h, r.Method+"\n"+r.URL.RequestURI()+"\n")
+
+	if r.Body == nil || r.Body == http.NoBody {
+		return hex.EncodeToString(h.Sum(nil)), func() {}, nil
+	}
+	body := io.TeeReader(r.Body, h)
+
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, body, memoryBodyBytes+1); err == io.EOF {
+		r.Body = io.NopCloser(bytes.NewReader(buf.Bytes()))
+		return hex.EncodeToString(h.Sum(nil)), func() {}, nil
+	} else if err != nil {
+		return "", nil, err
+	}
+
+	// Too large to be kept in memory, spool the rest to a temporary file.
+	f, err := os.CreateTemp("", "idempotency-*")
+	if err != nil {
+		return "", nil, err
+	}
+	release := func() {
+		f.Close()
+		os.Remove(f.Name())
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		release()
+		return "", nil, err
+	}
+	if _, err := io.Copy(f, body); err != nil {
+		release()
+		return "", nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		release()
+		return "", nil, err
+	}
+	r.Body = io.NopCloser(f)
+	return hex.EncodeToString(h.Sum(nil)), release, nil
+}
+
+// Response is a stored response, a zero `Status` means the first request
+// is still being served.
+type Response struct {
+	Fingerprint string      `json:"fingerprint"`
+	Status      int         `json:"status"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+}
+
+// IsPending returns true if the first request is still being served.
+func (resp *Response) IsPending() bool {
+	return resp.Status == 0
+}
+
+// Replay writes the stored response to `w`.
+func (resp *Response) Replay(w http.ResponseWriter) {
+	for name, values := range resp.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(HeaderReplayed, "true")
+	w.WriteHeader(resp.Status)
+	w.Write(resp.Body)
+}
+
+// storable returns true if the responses of `status` are replayed: the
+// successes and the validation failures, which the retries would get too.
+// The other failures may not repeat, like the authentication and permission
+// failures once the client signs in again, the conflicts, the rate limits
+// or the server errors, so the retries are executed again.
+func storable(status int) bool {
+	switch {
+	case status >= 200 && status < 300:
+		return true
+	case status == http.StatusBadRequest, status == http.StatusUnprocessableEntity:
+		return true
+	default:
+		return false
+	}
+}
+
+// Recorder captures the response written through it so it can be stored.
+type Recorder struct {
+	http.ResponseWriter
+	status   int
+	header   http.Header
+	body     bytes.Buffer
+	overflow bool
+	noStore  bool
+}
+
+// NewRecorder returns a recorder writing through to `w`.
+func NewRecorder(w http.ResponseWriter) *Recorder {
+	return &Recorder{ResponseWriter: w}
+}
+
+func (rec *Recorder) WriteHeader(code int) {
+	if rec.status == 0 {
+		rec.status = code
+		rec.header = http.Header{}
+		for _, name := range replayedHeaders {
+			if values := rec.Header().Values(name); len(values) > 0 {
+				rec.header[name] = values
+			}
+		}
+		// The responses carrying credentials, like tokens, forbid caching.
+		rec.noStore = strings.Contains(strings.ToLower(rec.Header().Get("Cache-Control")), "no-store")
+	}
+	rec.ResponseWriter.WriteHeader(code)
+}
+
+func (rec *Recorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.WriteHeader(http.StatusOK)
+	}
+	if !rec.overflow {
+		if rec.body.Len()+len(b) > MaxResponseBytes {
+			rec.overflow = true
+			rec.body.Reset()
+		} else {
+			rec.body.Write(b)
+		}
+	}
+	return rec.ResponseWriter.Write(b)
+}
+
+// Unwrap lets `http.ResponseController` reach the underlying writer.
+func (rec *Recorder) Unwrap() http.ResponseWriter {
+	return rec.ResponseWriter
+}
+
+// Response returns the recorded response of the request of `fingerprint`,
+// false if it should not be replayed: the body was too large, the response
+// forbids caching with `Cache-Control: no-store` or it is not the outcome of
+// the request, see `storable`.
+func (rec *Recorder) Response(fingerprint string) (*Response, bool) {
+	status := rec.status
+	if status == 0 {
+		// Nothing was written, the server sent an empty `200 OK`.
+		status = http.StatusOK
+	}
+	if rec.overflow || rec.noStore || !storable(status) {
+		return nil, false
+	}
+	return &Response{
+		Fingerprint: fingerprint,
+		Status:      status,
+		Header:      rec.header,
+		Body:        rec.body.Bytes(),
+	}, true
+}
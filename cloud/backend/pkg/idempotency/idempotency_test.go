@@ -0,0 +1,119 @@
+package idempotency
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidKey(t *testing.T) {
+	assert.True(t, ValidKey("8e03978e-40d5-43e8-bc93-6894a57f9324"))
+	assert.True(t, ValidKey(strings.Repeat("a", 255)))
+	assert.False(t, ValidKey(""))
+	assert.False(t, ValidKey(strings.Repeat("a", 256)))
+	assert.False(t, ValidKey("with space"))
+	assert.False(t, ValidKey("new\nline"))
+}
+
+func TestCacheKey(t *testing.T) {
+	assert.Equal(t, CacheKey("JWT a", "key"), CacheKey("JWT a", "key"))
+	assert.NotEqual(t, CacheKey("JWT a", "key"), CacheKey("JWT b", "key"))
+	assert.NotEqual(t, CacheKey("JWT a", "key"), CacheKey("JWT a", "other"))
+}
+
+func TestFingerprint(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "empty body", body: ""},
+		{name: "small body", body: `{"email":"a@example.com"}`},
+		{name: "spooled body", body: strings.Repeat("x", memoryBodyBytes+10)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/vault/api/v1/encrypted-files", strings.NewReader(tt.body))
+			got, release, err := Fingerprint(r)
+			require.NoError(t, err)
+			defer release()
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.body, string(body), "body was not restored")
+
+			same := httptest.NewRequest(http.MethodPost, "/vault/api/v1/encrypted-files", strings.NewReader(tt.body))
+			want, release2, err := Fingerprint(same)
+			require.NoError(t, err)
+			defer release2()
+			assert.Equal(t, want, got)
+		})
+	}
+
+	a := httptest.NewRequest(http.MethodPost, "/iam/api/v1/register", strings.NewReader(`{"a":1}`))
+	b := httptest.NewRequest(http.MethodPost, "/iam/api/v1/register", strings.NewReader(`{"a":2}`))
+	c := httptest.NewRequest(http.MethodPatch, "/iam/api/v1/register", strings.NewReader(`{"a":1}`))
+	fa, _, _ := Fingerprint(a)
+	fb, _, _ := Fingerprint(b)
+	fc, _, _ := Fingerprint(c)
+	assert.NotEqual(t, fa, fb, "payloads were not told apart")
+	assert.NotEqual(t, fa, fc, "methods were not told apart")
+}
+
+func TestRecorderReplay(t *testing.T) {
+	w := httptest.NewRecorder()
+	rec := NewRecorder(w)
+	rec.Header().Set("Content-Type", "application/json")
+	rec.Header().Set("X-Request-ID", "first")
+	rec.WriteHeader(http.StatusCreated)
+	rec.Write([]byte(`{"id":"1"}`))
+
+	resp, ok := rec.Response("fingerprint")
+	require.True(t, ok)
+	assert.Equal(t, http.StatusCreated, resp.Status)
+	assert.Empty(t, resp.Header.Get("X-Request-ID"), "headers of the request itself were stored")
+
+	replayed := httptest.NewRecorder()
+	resp.Replay(replayed)
+	assert.Equal(t, http.StatusCreated, replayed.Code)
+	assert.Equal(t, "application/json", replayed.Header().Get("Content-Type"))
+	assert.Equal(t, "true", replayed.Header().Get(HeaderReplayed))
+	assert.Equal(t, `{"id":"1"}`, replayed.Body.String())
+}
+
+func TestRecorderSkipsTransientFailures(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusConflict, http.StatusInternalServerError, http.StatusServiceUnavailable, http.StatusTooManyRequests} {
+		rec := NewRecorder(httptest.NewRecorder())
+		rec.WriteHeader(status)
+		_, ok := rec.Response("fingerprint")
+		assert.False(t, ok, "status %d was stored", status)
+	}
+
+	for _, status := range []int{http.StatusCreated, http.StatusBadRequest, http.StatusUnprocessableEntity} {
+		rec := NewRecorder(httptest.NewRecorder())
+		rec.WriteHeader(status)
+		_, ok := rec.Response("fingerprint")
+		assert.True(t, ok, "status %d was not stored", status)
+	}
+
+	rec := NewRecorder(httptest.NewRecorder())
+	rec.Write(bytes.Repeat([]byte("x"), MaxResponseBytes+1))
+	_, ok := rec.Response("fingerprint")
+	assert.False(t, ok, "oversized body was stored")
+
+	rec = NewRecorder(httptest.NewRecorder())
+	rec.Header().Set("Cache-Control", "no-store")
+	rec.WriteHeader(http.StatusOK)
+	_, ok = rec.Response("fingerprint")
+	assert.False(t, ok, "response forbidding caching was stored")
+
+	rec = NewRecorder(httptest.NewRecorder())
+	resp, ok := rec.Response("fingerprint")
+	require.True(t, ok)
+	assert.Equal(t, http.StatusOK, resp.Status, "empty response was not stored as 200")
+}
@@ -5,17 +5,26 @@ import (
 
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/distributedmutex"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/emailer/mailgun"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/health"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/authorization"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/blacklist"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/clientip"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/ipcountryblocker"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/jwt"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/oidc"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/password"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/security/ratelimit"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodb"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/database/mongodbcache"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/memory/redis"
 	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/storage/object/s3"
 )
 
 func Module() fx.Option {
 	return fx.Options(
+		// Installed first so the tracer provider is flushed last on stop.
+		fx.Invoke(tracing.Setup),
 		fx.Provide(
 			fx.Annotate(
 				mailgun.NewPaperCloudPropertyEvaluatorModuleEmailer,
@@ -27,14 +36,43 @@ func Module() fx.Option {
 			),
 		),
 		fx.Provide(
-			blacklist.NewProvider,
+			authorization.NewProvider,
+			fx.Annotate(
+				blacklist.NewProvider,
+				fx.ParamTags(``, ``, ``, ``, `group:"blacklist_sources"`),
+			),
+			clientip.NewResolver,
 			distributedmutex.NewAdapter,
 			ipcountryblocker.NewProvider,
 			jwt.NewProvider,
+			oidc.NewProvider,
 			password.NewProvider,
+			ratelimit.NewProvider,
 			mongodb.NewProvider,
 			mongodbcache.NewProvider,
 			s3.NewProvider,
+			redis.NewUniversalClient,
+			fx.Annotate(
+				health.NewChecker,
+				fx.ParamTags(``, ``, `group:"health_checks"`),
+			),
+		),
+		fx.Provide(
+			health.AsCheck(mongodb.NewHealthCheck),
+			health.AsCheck(mongodbcache.NewHealthCheck),
+			health.AsCheck(redis.NewHealthCheck),
+			health.AsCheck(s3.NewHealthCheck),
+			health.AsCheck(ipcountryblocker.NewHealthCheck),
+			fx.Annotate(
+				func(e mailgun.Emailer) health.Check { return mailgun.NewHealthCheck("papercloud", e) },
+				fx.ParamTags(`name:"papercloud-module-emailer"`),
+				fx.ResultTags(`group:"health_checks"`),
+			),
+			fx.Annotate(
+				func(e mailgun.Emailer) health.Check { return mailgun.NewHealthCheck("maplesend", e) },
+				fx.ParamTags(`name:"maplesend-module-emailer"`),
+				fx.ResultTags(`group:"health_checks"`),
+			),
 		),
 	)
 }
@@ -0,0 +1,167 @@
+// Package health runs the readiness checks contributed by the modules, for
+// example pinging the database, and reports their status and latency.
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	c "github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+)
+
+// Statuses of a check and of the whole report.
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// Check verifies that a dependency required to serve requests is usable.
+type Check interface {
+	// Name identifies the check in the report, ex: `mongodb`.
+	Name() string
+
+	// Check returns an error if the dependency is unusable.
+	Check(ctx context.Context) error
+}
+
+// AsCheck annotates the given constructor to state that it provides a check
+// to the "health_checks" group.
+func AsCheck(f any) any {
+	return fx.Annotate(
+		f,
+		fx.As(new(Check)),
+		fx.ResultTags(`group:"health_checks"`),
+	)
+}
+
+type checkFunc struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// NewCheck returns a check named `name` running `fn`.
+func NewCheck(name string, fn func(ctx context.Context) error) Check {
+	return &checkFunc{name: name, fn: fn}
+}
+
+func (c *checkFunc) Name() string {
+	return c.name
+}
+
+func (c *checkFunc) Check(ctx context.Context) error {
+	return c.fn(ctx)
+}
+
+// Result is the outcome of a single check.
+type Result struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMS float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Report is the outcome of every check, its status fails if any check does.
+type Report struct {
+	Status    string    `json:"status"`
+	CheckedAt time.Time `json:"checked_at"`
+	Checks    []Result  `json:"checks"`
+}
+
+// IsOK returns true if every check passed.
+func (r *Report) IsOK() bool {
+	return r.Status == StatusOK
+}
+
+// Checker runs the readiness checks.
+type Checker interface {
+	// Ready returns the report of the checks. The report is reused for a
+	// few seconds so frequent probes do not hammer the dependencies.
+	Ready(ctx context.Context) *Report
+}
+
+type checker struct {
+	checks  []Check
+	ttl     time.Duration
+	timeout time.Duration
+	logger  *zap.Logger
+
+	mu     sync.Mutex // Held while checking so concurrent probes share the report.
+	report *Report
+}
+
+// NewChecker returns the checker running the `checks` of the modules.
+func NewChecker(cfg *c.Configuration, logger *zap.Logger, checks []Check) Checker {
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Name() < checks[j].Name() })
+	return &checker{
+		checks:  checks,
+		ttl:     time.Duration(cfg.App.HealthCheckCacheSeconds) * time.Second,
+		timeout: time.Duration(cfg.App.HealthCheckTimeoutSeconds) * time.Second,
+		logger:  logger.Named("health"),
+	}
+}
+
+func (h *checker) Ready(ctx context.Context) *Report {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.report != nil && time.Since(h.report.CheckedAt) < h.ttl {
+		return h.report
+	}
+
+	// The report is shared so a probe which disconnects must not fail it.
+	ctx = context.WithoutCancel(ctx)
+	if h.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+	}
+
+	report := &Report{
+		Status:    StatusOK,
+		CheckedAt: time.Now(),
+		Checks:    make([]Result, len(h.checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, check := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Checks[i] = run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	for _, res := range report.Checks {
+		if res.Status != StatusOK {
+			report.Status = StatusFail
+			h.logger.Warn("health check failed",
+				zap.String("check", res.Name),
+				zap.String("error", res.Error),
+				zap.Float64("latency_ms", res.LatencyMS))
+		}
+	}
+
+	h.report = report
+	return report
+}
+
+func run(ctx context.Context, check Check) Result {
+	start := time.Now()
+	err := check.Check(ctx)
+	res := Result{
+		Name:      check.Name(),
+		Status:    StatusOK,
+		LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		res.Status = StatusFail
+		res.Error = err.Error()
+	}
+	return res
+}
@@ -0,0 +1,66 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	c "github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+)
+
+func newTestConfig(cacheSeconds int) *c.Configuration {
+	cfg := &c.Configuration{}
+	cfg.App.HealthCheckCacheSeconds = cacheSeconds
+	cfg.App.HealthCheckTimeoutSeconds = 1
+	return cfg
+}
+
+func TestCheckerReportsEveryCheck(t *testing.T) {
+	checker := NewChecker(newTestConfig(0), zap.NewNop(), []Check{
+		NewCheck("s3", func(ctx context.Context) error { return errors.New("bucket does not exist") }),
+		NewCheck("mongodb", func(ctx context.Context) error { return nil }),
+	})
+
+	report := checker.Ready(context.Background())
+	assert.False(t, report.IsOK())
+	assert.Equal(t, StatusFail, report.Status)
+	if assert.Len(t, report.Checks, 2) {
+		assert.Equal(t, Result{Name: "mongodb", Status: StatusOK, LatencyMS: report.Checks[0].LatencyMS}, report.Checks[0])
+		assert.Equal(t, "s3", report.Checks[1].Name)
+		assert.Equal(t, StatusFail, report.Checks[1].Status)
+		assert.Equal(t, "bucket does not exist", report.Checks[1].Error)
+	}
+}
+
+func TestCheckerCachesReport(t *testing.T) {
+	var calls atomic.Int32
+	checker := NewChecker(newTestConfig(60), zap.NewNop(), []Check{
+		NewCheck("cache", func(ctx context.Context) error { calls.Add(1); return nil }),
+	})
+
+	assert.True(t, checker.Ready(context.Background()).IsOK())
+	assert.True(t, checker.Ready(context.Background()).IsOK())
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestCheckerTimesOut(t *testing.T) {
+	checker := NewChecker(newTestConfig(0), zap.NewNop(), []Check{
+		NewCheck("slow", func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(5 * time.Second):
+				return nil
+			}
+		}),
+	})
+
+	report := checker.Ready(context.Background())
+	assert.False(t, report.IsOK())
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks[0].Error)
+}
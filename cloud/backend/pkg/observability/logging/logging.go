@@ -0,0 +1,95 @@
+// Package logging ties the log lines of a request together. The request ID
+// middleware saves an entry in the context which the middleware of each
+// module enrich once they know more, like the authenticated user, so every
+// layer can log with the same request fields.
+package logging
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config/constants"
+)
+
+// RequestIDHeader is the header clients may send their own request ID in
+// and which every response includes.
+const RequestIDHeader = "X-Request-ID"
+
+const maxRequestIDLength = 128
+
+// Entry holds the fields identifying a request in the logs.
+type Entry struct {
+	mu     sync.RWMutex
+	fields []zap.Field
+}
+
+// Fields returns a copy of the fields of the request.
+func (e *Entry) Fields() []zap.Field {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return append([]zap.Field(nil), e.fields...)
+}
+
+func (e *Entry) add(fields ...zap.Field) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.fields = append(e.fields, fields...)
+}
+
+// NewRequestID returns a new random request ID.
+func NewRequestID() string {
+	return uuid.NewString()
+}
+
+// IsValidRequestID returns true if the request ID sent by a client is safe
+// to be logged and echoed back.
+func IsValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// NewContext returns the context of the request `requestID` with its entry,
+// starting with `fields`.
+func NewContext(ctx context.Context, requestID string, fields ...zap.Field) (context.Context, *Entry) {
+	entry := &Entry{fields: append([]zap.Field{zap.String("request_id", requestID)}, fields...)}
+	ctx = context.WithValue(ctx, constants.SessionRequestID, requestID)
+	ctx = context.WithValue(ctx, constants.SessionLogEntry, entry)
+	return ctx, entry
+}
+
+// RequestID returns the ID of the request or an empty string outside of one.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(constants.SessionRequestID).(string)
+	return id
+}
+
+// With adds `fields` to every following log line of the request, including
+// its access log line. It does nothing outside of a request.
+func With(ctx context.Context, fields ...zap.Field) {
+	if entry, ok := ctx.Value(constants.SessionLogEntry).(*Entry); ok {
+		entry.add(fields...)
+	}
+}
+
+// Logger returns `logger` with the fields of the request in `ctx`, so the
+// lines of a component can be tied to the request which caused them.
+func Logger(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	entry, ok := ctx.Value(constants.SessionLogEntry).(*Entry)
+	if !ok {
+		return logger
+	}
+	return logger.With(entry.Fields()...)
+}
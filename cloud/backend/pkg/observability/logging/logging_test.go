@@ -0,0 +1,48 @@
+package logging
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestIsValidRequestID(t *testing.T) {
+	assert.True(t, IsValidRequestID("0f8fad5b-d9cb-469f-a165-70867728950e"))
+	assert.True(t, IsValidRequestID("lb:1234.abc_def"))
+	assert.False(t, IsValidRequestID(""))
+	assert.False(t, IsValidRequestID("line\nbreak"))
+	assert.False(t, IsValidRequestID("with space"))
+	assert.False(t, IsValidRequestID(strings.Repeat("a", maxRequestIDLength+1)))
+	assert.True(t, IsValidRequestID(NewRequestID()))
+}
+
+func TestLoggerIncludesRequestFields(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	logger := zap.New(core)
+
+	ctx, entry := NewContext(context.Background(), "req-1", zap.String("pattern", "GET /foo"))
+	With(ctx, zap.String("user_id", "u1"))
+	Logger(ctx, logger).Info("hello")
+
+	assert.Equal(t, "req-1", RequestID(ctx))
+	assert.Len(t, entry.Fields(), 3)
+	if assert.Equal(t, 1, logs.Len()) {
+		fields := logs.All()[0].ContextMap()
+		assert.Equal(t, "req-1", fields["request_id"])
+		assert.Equal(t, "GET /foo", fields["pattern"])
+		assert.Equal(t, "u1", fields["user_id"])
+	}
+}
+
+func TestOutsideOfRequest(t *testing.T) {
+	logger := zap.NewNop()
+	ctx := context.Background()
+
+	With(ctx, zap.String("user_id", "u1")) // Does not panic.
+	assert.Same(t, logger, Logger(ctx, logger))
+	assert.Empty(t, RequestID(ctx))
+}
@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// AddAWSMiddleware adds the middleware recording the latency and errors of
+// every AWS API call, use it in the client `APIOptions`. It is added to the
+// deserialize step so presigned requests, which are never sent, are ignored.
+func AddAWSMiddleware(stack *middleware.Stack) error {
+	return stack.Deserialize.Add(middleware.DeserializeMiddlewareFunc("BackendMetrics", func(
+		ctx context.Context,
+		in middleware.DeserializeInput,
+		next middleware.DeserializeHandler,
+	) (middleware.DeserializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleDeserialize(ctx, in)
+		ObserveS3Operation(awsmiddleware.GetOperationName(ctx), time.Since(start), err)
+		return out, metadata, err
+	}), middleware.Before)
+}
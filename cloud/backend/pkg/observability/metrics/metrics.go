@@ -0,0 +1,184 @@
+// Package metrics holds the Prometheus collectors of the backend. They are
+// package level so any layer can record without threading a dependency
+// through every constructor; nothing is exposed unless the metrics server is
+// enabled in the configuration.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "backend"
+
+// Reasons a request was rejected before reaching its handler.
+const (
+	BlockReasonClientRateLimit = "client_rate_limit"
+	BlockReasonUserRateLimit   = "user_rate_limit"
+	BlockReasonBannedIP        = "banned_ip"
+	BlockReasonBannedURL       = "banned_url"
+	BlockReasonCountry         = "country"
+)
+
+// Caches and the results of their lookups.
+const (
+	CacheMongoDB = "mongodb"
+	CacheRedis   = "redis"
+
+	CacheResultHit   = "hit"
+	CacheResultMiss  = "miss"
+	CacheResultError = "error"
+)
+
+// Registry holds every collector of the backend, including the Go runtime
+// and process collectors.
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+var (
+	httpRequestsTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests by route pattern and status code.",
+	}, []string{"pattern", "code"})
+
+	httpRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests by route pattern and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"pattern", "code"})
+
+	httpRequestsInFlight = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "Number of HTTP requests currently being served.",
+	})
+
+	httpRequestsBlockedTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_blocked_total",
+		Help:      "Number of HTTP requests rejected by rate limits, the blacklist or geo-blocking.",
+	}, []string{"reason"})
+
+	cacheLookupsTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "lookups_total",
+		Help:      "Number of cache lookups by cache and result, the hit ratio is hits over all lookups.",
+	}, []string{"cache", "result"})
+
+	s3OperationDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "s3",
+		Name:      "operation_duration_seconds",
+		Help:      "Latency of S3 API calls by operation, retries are observed separately.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	s3OperationErrorsTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "s3",
+		Name:      "operation_errors_total",
+		Help:      "Number of failed S3 API calls by operation.",
+	}, []string{"operation"})
+
+	registrationsTotal = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "registrations_total",
+		Help:      "Number of users who registered.",
+	})
+
+	loginsTotal = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "logins_total",
+		Help:      "Number of completed logins.",
+	})
+
+	fileUploadsTotal = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "file_uploads_total",
+		Help:      "Number of encrypted file contents uploaded.",
+	})
+
+	storedBytesTotal = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "stored_bytes_total",
+		Help:      "Number of encrypted file content bytes written to object storage.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler returns the handler exposing the metrics in the Prometheus format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// ObserveHTTPRequest records a served request. The `pattern` must be the
+// route pattern, not the path, to keep the number of series bounded.
+func ObserveHTTPRequest(pattern string, code int, duration time.Duration) {
+	if pattern == "" {
+		pattern = "unmatched"
+	}
+	labels := prometheus.Labels{"pattern": pattern, "code": strconv.Itoa(code)}
+	httpRequestsTotal.With(labels).Inc()
+	httpRequestDuration.With(labels).Observe(duration.Seconds())
+}
+
+// TrackInFlight counts a request as in flight until the returned function
+// is called.
+func TrackInFlight() func() {
+	httpRequestsInFlight.Inc()
+	return httpRequestsInFlight.Dec
+}
+
+// IncBlocked counts a request rejected for the `reason`.
+func IncBlocked(reason string) {
+	httpRequestsBlockedTotal.WithLabelValues(reason).Inc()
+}
+
+// ObserveCacheLookup counts a lookup of the `cache` with its `result`.
+func ObserveCacheLookup(cache, result string) {
+	cacheLookupsTotal.WithLabelValues(cache, result).Inc()
+}
+
+// ObserveS3Operation records an S3 API call.
+func ObserveS3Operation(operation string, duration time.Duration, err error) {
+	s3OperationDuration.WithLabelValues(operation).Observe(duration.Seconds())
+	if err != nil {
+		s3OperationErrorsTotal.WithLabelValues(operation).Inc()
+	}
+}
+
+// IncRegistrations counts a registered user.
+func IncRegistrations() {
+	registrationsTotal.Inc()
+}
+
+// IncLogins counts a completed login.
+func IncLogins() {
+	loginsTotal.Inc()
+}
+
+// ObserveUpload counts an uploaded file content of `size` bytes.
+func ObserveUpload(size int64) {
+	fileUploadsTotal.Inc()
+	storedBytesTotal.Add(float64(size))
+}
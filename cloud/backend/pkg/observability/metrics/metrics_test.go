@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/event"
+)
+
+func TestObserveHTTPRequest(t *testing.T) {
+	before := testutil.ToFloat64(httpRequestsTotal.WithLabelValues("GET /foo", "200"))
+	ObserveHTTPRequest("GET /foo", http.StatusOK, time.Millisecond)
+	assert.Equal(t, before+1, testutil.ToFloat64(httpRequestsTotal.WithLabelValues("GET /foo", "200")))
+
+	before = testutil.ToFloat64(httpRequestsTotal.WithLabelValues("unmatched", "404"))
+	ObserveHTTPRequest("", http.StatusNotFound, time.Millisecond)
+	assert.Equal(t, before+1, testutil.ToFloat64(httpRequestsTotal.WithLabelValues("unmatched", "404")))
+}
+
+func TestTrackInFlight(t *testing.T) {
+	before := testutil.ToFloat64(httpRequestsInFlight)
+	done := TrackInFlight()
+	assert.Equal(t, before+1, testutil.ToFloat64(httpRequestsInFlight))
+	done()
+	assert.Equal(t, before, testutil.ToFloat64(httpRequestsInFlight))
+}
+
+func TestObserveS3Operation(t *testing.T) {
+	before := testutil.ToFloat64(s3OperationErrorsTotal.WithLabelValues("PutObject"))
+	ObserveS3Operation("PutObject", time.Millisecond, nil)
+	ObserveS3Operation("PutObject", time.Millisecond, errors.New("failed"))
+	assert.Equal(t, before+1, testutil.ToFloat64(s3OperationErrorsTotal.WithLabelValues("PutObject")))
+}
+
+func TestMongoPoolMonitor(t *testing.T) {
+	monitor := NewMongoPoolMonitor()
+	before := testutil.ToFloat64(mongoPoolConnectionsInUse)
+	monitor.Event(&event.PoolEvent{Type: event.ConnectionCheckedOut})
+	assert.Equal(t, before+1, testutil.ToFloat64(mongoPoolConnectionsInUse))
+	monitor.Event(&event.PoolEvent{Type: event.ConnectionCheckedIn})
+	assert.Equal(t, before, testutil.ToFloat64(mongoPoolConnectionsInUse))
+}
+
+func TestHandler(t *testing.T) {
+	IncBlocked(BlockReasonCountry)
+	ObserveUpload(1024)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.True(t, strings.Contains(body, `backend_http_requests_blocked_total{reason="country"}`))
+	assert.True(t, strings.Contains(body, "backend_stored_bytes_total"))
+	assert.True(t, strings.Contains(body, "go_goroutines"))
+}
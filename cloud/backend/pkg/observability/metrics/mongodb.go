@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.mongodb.org/mongo-driver/v2/event"
+)
+
+var (
+	mongoPoolConnections = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb_pool",
+		Name:      "connections",
+		Help:      "Number of open connections in the MongoDB pools.",
+	})
+
+	mongoPoolConnectionsInUse = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb_pool",
+		Name:      "connections_in_use",
+		Help:      "Number of MongoDB connections checked out of the pools.",
+	})
+
+	mongoPoolCheckOutFailuresTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mongodb_pool",
+		Name:      "check_out_failures_total",
+		Help:      "Number of failed attempts to check a MongoDB connection out of the pools by reason.",
+	}, []string{"reason"})
+)
+
+// NewMongoPoolMonitor returns the monitor keeping the MongoDB pool metrics,
+// it must be set on the client options before connecting.
+func NewMongoPoolMonitor() *event.PoolMonitor {
+	return &event.PoolMonitor{
+		Event: func(e *event.PoolEvent) {
+			switch e.Type {
+			case event.ConnectionCreated:
+				mongoPoolConnections.Inc()
+			case event.ConnectionClosed:
+				mongoPoolConnections.Dec()
+			case event.ConnectionCheckedOut:
+				mongoPoolConnectionsInUse.Inc()
+			case event.ConnectionCheckedIn:
+				mongoPoolConnectionsInUse.Dec()
+			case event.ConnectionCheckOutFailed:
+				mongoPoolCheckOutFailuresTotal.WithLabelValues(e.Reason).Inc()
+			}
+		},
+	}
+}
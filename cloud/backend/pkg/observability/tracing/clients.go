@@ -0,0 +1,21 @@
+package tracing
+
+import (
+	"github.com/aws/smithy-go/tracing"
+	"github.com/aws/smithy-go/tracing/smithyoteltracing"
+	"github.com/redis/go-redis/extra/redisotel/v9"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+)
+
+// AWSTracerProvider returns the tracer provider to set on AWS clients so
+// every API call, including its retries, is traced.
+func AWSTracerProvider() tracing.TracerProvider {
+	return smithyoteltracing.Adapt(otel.GetTracerProvider())
+}
+
+// InstrumentRedis traces every command sent by the `client`. The command
+// arguments are not recorded as they may hold session data.
+func InstrumentRedis(client redis.UniversalClient) error {
+	return redisotel.InstrumentTracing(client, redisotel.WithDBStatement(false))
+}
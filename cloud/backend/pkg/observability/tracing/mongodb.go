@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewMongoCommandMonitor returns the monitor creating a client span for every
+// MongoDB command, it must be set on the client options before connecting.
+// The command documents are not recorded as they hold user data.
+func NewMongoCommandMonitor() *event.CommandMonitor {
+	var spans sync.Map // Keyed by connection and request ID.
+
+	key := func(connectionID string, requestID int64) string {
+		return fmt.Sprintf("%s/%d", connectionID, requestID)
+	}
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+				return // Only trace commands which are part of a request.
+			}
+			_, span := Start(ctx, "mongodb."+e.CommandName,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attribute.String("db.system", "mongodb"),
+					attribute.String("db.namespace", e.DatabaseName),
+					attribute.String("db.operation.name", e.CommandName),
+				))
+			spans.Store(key(e.ConnectionID, e.RequestID), span)
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			if v, ok := spans.LoadAndDelete(key(e.ConnectionID, e.RequestID)); ok {
+				v.(trace.Span).End()
+			}
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			if v, ok := spans.LoadAndDelete(key(e.ConnectionID, e.RequestID)); ok {
+				span := v.(trace.Span)
+				span.RecordError(e.Failure)
+				span.SetStatus(codes.Error, e.Failure.Error())
+				span.End()
+			}
+		},
+	}
+}
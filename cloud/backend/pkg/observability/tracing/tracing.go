@@ -0,0 +1,109 @@
+// Package tracing configures OpenTelemetry for the backend. Spans are always
+// created through the global tracer provider, which stays a no-op unless an
+// OTLP endpoint is configured, so instrumented code needs no dependency.
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	c "github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+)
+
+const (
+	tracerName  = "github.com/Maple-Open-Tech/monorepo/cloud/backend"
+	serviceName = "backend"
+)
+
+// Setup installs the W3C trace context propagator and, when an OTLP endpoint
+// is configured, the exporting tracer provider which is flushed on stop.
+func Setup(lc fx.Lifecycle, cfg *c.Configuration, logger *zap.Logger) error {
+	// Propagate the trace context of clients even when not exporting so the
+	// trace IDs in our logs can be matched with theirs.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if cfg.App.OTLPEndpoint == "" {
+		logger.Debug("tracing export disabled")
+		return nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.App.OTLPEndpoint)}
+	if cfg.App.OTLPInsecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return err
+	}
+
+	// `OTEL_SERVICE_NAME` and `OTEL_RESOURCE_ATTRIBUTES` take precedence.
+	res, err := resource.New(context.Background(),
+		resource.WithAttributes(attribute.String("service.name", serviceName)),
+		resource.WithFromEnv(),
+		resource.WithHost(),
+	)
+	if err != nil {
+		return err
+	}
+
+	ratio := float64(cfg.App.TracingSamplePercent) / 100
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(tp)
+
+	logger.Info("tracing export enabled",
+		zap.String("endpoint", cfg.App.OTLPEndpoint),
+		zap.Float64("sample_ratio", ratio))
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			defer cancel()
+			return tp.Shutdown(ctx)
+		},
+	})
+	return nil
+}
+
+// Start starts a span named `name` as a child of the span in `ctx`.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// LogFields returns the zap fields identifying the span in `ctx`, or nothing
+// if the context is not part of a trace.
+func LogFields(ctx context.Context) []zap.Field {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	return []zap.Field{
+		zap.String("trace_id", sc.TraceID().String()),
+		zap.String("span_id", sc.SpanID().String()),
+	}
+}
+
+// Logger returns `logger` with the fields identifying the span in `ctx`.
+func Logger(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	fields := LogFields(ctx)
+	if len(fields) == 0 {
+		return logger
+	}
+	return logger.With(fields...)
+}
@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/fx/fxtest"
+	"go.uber.org/zap"
+
+	c "github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+)
+
+func TestSetupWithoutEndpoint(t *testing.T) {
+	lc := fxtest.NewLifecycle(t)
+	err := Setup(lc, &c.Configuration{}, zap.NewNop())
+	assert.NoError(t, err)
+
+	// The client trace context is still propagated without exporting.
+	header := http.Header{}
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(header))
+
+	fields := LogFields(ctx)
+	if assert.Len(t, fields, 2) {
+		assert.Equal(t, "trace_id", fields[0].Key)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", fields[0].String)
+	}
+}
+
+func TestLogFieldsWithoutTrace(t *testing.T) {
+	assert.Empty(t, LogFields(context.Background()))
+
+	logger := zap.NewNop()
+	assert.Same(t, logger, Logger(context.Background(), logger))
+}
+
+func TestMongoCommandMonitor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	monitor := NewMongoCommandMonitor()
+
+	// Commands outside of a request are not traced.
+	monitor.Started(context.Background(), &event.CommandStartedEvent{CommandName: "ping", RequestID: 1})
+	monitor.Succeeded(context.Background(), &event.CommandSucceededEvent{CommandFinishedEvent: event.CommandFinishedEvent{RequestID: 1}})
+
+	ctx, span := Start(context.Background(), "request")
+	monitor.Started(ctx, &event.CommandStartedEvent{CommandName: "find", DatabaseName: "vault", ConnectionID: "c1", RequestID: 2})
+	monitor.Failed(ctx, &event.CommandFailedEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{ConnectionID: "c1", RequestID: 2},
+		Failure:              errors.New("failed"),
+	})
+	span.End()
+
+	ended := recorder.Ended()
+	if assert.Len(t, ended, 2) {
+		assert.Equal(t, "mongodb.find", ended[0].Name())
+		assert.Equal(t, codes.Error, ended[0].Status().Code)
+		assert.Equal(t, span.SpanContext().SpanID(), ended[0].Parent().SpanID())
+	}
+}
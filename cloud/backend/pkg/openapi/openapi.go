@@ -0,0 +1,283 @@
+// Package openapi generates the OpenAPI 3.1 document of the API from the
+// routes registered on the server and the request and response DTOs they
+// describe, so the clients can generate typed SDKs.
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+)
+
+// Version of the OpenAPI specification the documents follow.
+const Version = "3.1.0"
+
+// SecuritySchemeJWT is the name of the security scheme of the routes which
+// need an access token in the `Authorization: JWT <token>` header.
+const SecuritySchemeJWT = "jwt"
+
+// Spec describes a route in the document. Request and Response are zero
+// values of the DTOs the route decodes and encodes as JSON, like
+// `RegisterCustomerRequestIDO{}`, nil if it has none.
+type Spec struct {
+	Summary     string
+	Description string
+	Tags        []string
+
+	// Request is the JSON body of the request and RequestContentType its
+	// media type, defaults to `application/json`. Set the content type
+	// without a Request for bodies which are not JSON, like uploads.
+	Request            any
+	RequestContentType string
+
+	// Response is the JSON body of the successful response, which is sent
+	// with the Status code, defaults to `200 OK`.
+	Response any
+	Status   int
+
+	// Authenticated is true if the route requires an access token.
+	Authenticated bool
+}
+
+// Document is an OpenAPI 3.1 document.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+
+	schemas *schemas
+}
+
+// Info holds the metadata of the API.
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem holds the operations of a path by lowercase HTTP method.
+type PathItem map[string]*Operation
+
+// Operation is a single API operation on a path.
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Description string                `json:"description,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter is a path parameter of an operation.
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody is the body of the request of an operation.
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response is a response of an operation.
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the schemas and security schemes the operations refer to.
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+// SecurityScheme describes how the requests are authenticated.
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	In          string `json:"in,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// New returns an empty document of the API `title` at `version`.
+func New(title, version string) *Document {
+	s := newSchemas()
+	return &Document{
+		OpenAPI: Version,
+		Info:    Info{Title: title, Version: version},
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas: s.components,
+			SecuritySchemes: map[string]*SecurityScheme{
+				SecuritySchemeJWT: {
+					Type:        "apiKey",
+					In:          "header",
+					Name:        "Authorization",
+					Description: "Access token sent as `JWT <token>`.",
+				},
+			},
+		},
+		schemas: s,
+	}
+}
+
+// AddRoute adds the operation of the route registered at the `net/http`
+// pattern, like `POST /iam/api/v1/register`. The routes which do not
+// describe themselves are added with a nil `spec` so the document still
+// lists every path.
+func (d *Document) AddRoute(pattern string, spec *Spec) {
+	method, path := splitPattern(pattern)
+	if spec == nil {
+		spec = &Spec{}
+	}
+
+	path, params := pathParameters(path)
+	op := &Operation{
+		OperationID: operationID(method, path),
+		Summary:     spec.Summary,
+		Description: spec.Description,
+		Tags:        spec.Tags,
+		Parameters:  params,
+		Responses:   map[string]*Response{},
+	}
+	if len(op.Tags) == 0 {
+		op.Tags = defaultTags(path)
+	}
+
+	if spec.Request != nil || spec.RequestContentType != "" {
+		contentType := spec.RequestContentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		schema := &Schema{}
+		if spec.Request != nil {
+			schema = d.schemas.of(reflect.TypeOf(spec.Request))
+		}
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{contentType: {Schema: schema}},
+		}
+	}
+
+	status := spec.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := &Response{Description: http.StatusText(status)}
+	if spec.Response != nil {
+		success.Content = map[string]*MediaType{
+			"application/json": {Schema: d.schemas.of(reflect.TypeOf(spec.Response))},
+		}
+	}
+	op.Responses[strconv.Itoa(status)] = success
+	op.Responses["default"] = d.errorResponse()
+
+	if spec.Authenticated {
+		op.Security = []map[string][]string{{SecuritySchemeJWT: {}}}
+	}
+
+	item, ok := d.Paths[path]
+	if !ok {
+		item = PathItem{}
+		d.Paths[path] = item
+	}
+	item[strings.ToLower(method)] = op
+}
+
+// errorResponse describes the errors written by `httperror.ResponseError`,
+// as problem details or as the legacy map of field errors.
+func (d *Document) errorResponse() *Response {
+	return &Response{
+		Description: "Error",
+		Content: map[string]*MediaType{
+			httperror.ProblemContentType: {Schema: d.schemas.of(reflect.TypeOf(httperror.Problem{}))},
+			"application/json": {Schema: &Schema{
+				Type:                 "object",
+				AdditionalProperties: &Schema{Type: "string"},
+			}},
+		},
+	}
+}
+
+// splitPattern returns the method and path of a `net/http` pattern, the
+// patterns without a method match every method and are documented as GET.
+func splitPattern(pattern string) (method, path string) {
+	method, path, found := strings.Cut(strings.TrimSpace(pattern), " ")
+	if !found {
+		method, path = http.MethodGet, pattern
+	}
+	path = strings.TrimSpace(path)
+	if i := strings.Index(path, "/"); i > 0 {
+		path = path[i:] // Drop the host.
+	}
+	return strings.ToUpper(method), path
+}
+
+// pathParameters converts the wildcards of a `net/http` path, like `{id}`
+// or `{path...}`, to OpenAPI path templates and returns their parameters.
+func pathParameters(path string) (string, []*Parameter) {
+	var params []*Parameter
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.Trim(segment, "{}"), "...")
+		if name == "$" {
+			segments[i] = ""
+			continue
+		}
+		segments[i] = "{" + name + "}"
+		params = append(params, &Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// operationID derives a unique identifier from the method and path, like
+// `postIamApiV1Register`.
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	upper := true
+	for _, r := range path {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// defaultTags groups the operations by module, the first element of the
+// path, like `iam` or `vault`. Top level paths, like `/livez`, have none.
+func defaultTags(path string) []string {
+	module, _, found := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if !found || module == "" || strings.HasPrefix(module, "{") {
+		return nil
+	}
+	return []string{module}
+}
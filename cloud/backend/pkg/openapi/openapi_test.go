@@ -0,0 +1,170 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/httperror"
+)
+
+type testAddress struct {
+	City string `json:"city"`
+}
+
+type testEmbedded struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type testRequestIDO struct {
+	Email    string            `json:"email"`
+	Age      int               `json:"age,omitempty"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Address  *testAddress      `json:"address"`
+	Secret   string            `json:"-"`
+	Content  []byte            `json:"content"`
+	Count    int64             `json:"count,string"`
+	internal string
+	testEmbedded
+}
+
+type testResponseIDO struct {
+	ID       primitive.ObjectID `json:"id"`
+	Children []*testResponseIDO `json:"children,omitempty"`
+}
+
+func TestAddRoute(t *testing.T) {
+	doc := New("backend", "1.0.0")
+	doc.AddRoute("POST /iam/api/v1/things/{id}", &Spec{
+		Summary:       "Create a thing",
+		Request:       testRequestIDO{},
+		Response:      &testResponseIDO{},
+		Status:        http.StatusCreated,
+		Authenticated: true,
+	})
+	doc.AddRoute("/livez", nil)
+	doc.AddRoute("GET /files/{path...}", nil)
+
+	op := doc.Paths["/iam/api/v1/things/{id}"]["post"]
+	if op == nil {
+		t.Fatal("operation was not added")
+	}
+	if op.OperationID != "postIamApiV1ThingsId" {
+		t.Errorf("OperationID = %v, want postIamApiV1ThingsId", op.OperationID)
+	}
+	if len(op.Tags) != 1 || op.Tags[0] != "iam" {
+		t.Errorf("Tags = %v, want [iam]", op.Tags)
+	}
+	if len(op.Parameters) != 1 || op.Parameters[0].Name != "id" || op.Parameters[0].In != "path" {
+		t.Errorf("Parameters = %+v, want the id path parameter", op.Parameters)
+	}
+	if len(op.Security) != 1 {
+		t.Errorf("Security = %v, want the jwt scheme", op.Security)
+	}
+	if op.RequestBody.Content["application/json"].Schema.Ref != "#/components/schemas/testRequestIDO" {
+		t.Errorf("request schema = %+v", op.RequestBody.Content["application/json"].Schema)
+	}
+	if resp := op.Responses["201"]; resp == nil || resp.Content["application/json"].Schema.Ref != "#/components/schemas/testResponseIDO" {
+		t.Errorf("responses = %+v, want the 201 response", op.Responses)
+	}
+	if op.Responses["default"].Content[httperror.ProblemContentType].Schema.Ref != "#/components/schemas/Problem" {
+		t.Errorf("default response = %+v, want the problem details", op.Responses["default"])
+	}
+
+	if livez := doc.Paths["/livez"]["get"]; livez == nil || len(livez.Tags) != 0 {
+		t.Error("undescribed route without method was not added as GET without tags")
+	}
+	if get := doc.Paths["/files/{path}"]["get"]; get == nil || get.Parameters[0].Name != "path" {
+		t.Error("remaining path wildcard was not converted")
+	}
+
+	// The document must be serialisable.
+	if _, err := json.Marshal(doc); err != nil {
+		t.Fatalf("failed to marshal the document: %v", err)
+	}
+}
+
+func TestSchemas(t *testing.T) {
+	doc := New("backend", "1.0.0")
+	doc.AddRoute("POST /things", &Spec{Request: testRequestIDO{}, Response: testResponseIDO{}})
+
+	req := doc.Components.Schemas["testRequestIDO"]
+	if req == nil {
+		t.Fatal("request schema was not saved")
+	}
+
+	tests := []struct {
+		property string
+		want     Schema
+	}{
+		{property: "email", want: Schema{Type: "string"}},
+		{property: "age", want: Schema{Type: "integer", Format: "int64"}},
+		{property: "content", want: Schema{Type: "string", ContentEncoding: "base64"}},
+		{property: "count", want: Schema{Type: "string"}},
+		{property: "created_at", want: Schema{Type: "string", Format: "date-time"}},
+		{property: "address", want: Schema{Ref: "#/components/schemas/testAddress"}},
+	}
+	for _, tt := range tests {
+		got := req.Properties[tt.property]
+		if got == nil || got.Type != tt.want.Type || got.Format != tt.want.Format ||
+			got.ContentEncoding != tt.want.ContentEncoding || got.Ref != tt.want.Ref {
+			t.Errorf("property %v = %+v, want %+v", tt.property, got, tt.want)
+		}
+	}
+	if tags := req.Properties["tags"]; tags == nil || tags.Type != "array" || tags.Items.Type != "string" {
+		t.Errorf("property tags = %+v, want an array of strings", tags)
+	}
+	if labels := req.Properties["labels"]; labels == nil || labels.AdditionalProperties.Type != "string" {
+		t.Errorf("property labels = %+v, want a map of strings", labels)
+	}
+	for _, skipped := range []string{"Secret", "internal", "testEmbedded"} {
+		if _, ok := req.Properties[skipped]; ok {
+			t.Errorf("property %v should not be documented", skipped)
+		}
+	}
+
+	required := map[string]bool{}
+	for _, name := range req.Required {
+		required[name] = true
+	}
+	if !required["email"] || required["age"] || required["labels"] {
+		t.Errorf("Required = %v, want the fields without omitempty", req.Required)
+	}
+
+	resp := doc.Components.Schemas["testResponseIDO"]
+	if resp == nil || resp.Properties["id"].Type != "string" {
+		t.Errorf("response schema = %+v, want the object ID as a string", resp)
+	}
+	if resp.Properties["children"].Items.Ref != "#/components/schemas/testResponseIDO" {
+		t.Error("recursive type was not referenced")
+	}
+}
+
+func TestUniqueNames(t *testing.T) {
+	type Problem struct {
+		Other string `json:"other"`
+	}
+
+	doc := New("backend", "1.0.0")
+	doc.AddRoute("GET /a", &Spec{Response: httperror.Problem{}})
+	doc.AddRoute("GET /b", &Spec{Response: Problem{}})
+
+	if _, ok := doc.Components.Schemas["Problem"]; !ok {
+		t.Error("first type does not use its plain name")
+	}
+	if _, ok := doc.Components.Schemas["openapi.Problem"]; !ok {
+		t.Errorf("second type was not prefixed by its package, got %v", keys(doc.Components.Schemas))
+	}
+}
+
+func keys(m map[string]*Schema) []string {
+	var names []string
+	for name := range m {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,193 @@
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Schema is a JSON Schema, the dialect used by OpenAPI 3.1, restricted to
+// what the DTOs of the API need.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	durationType      = reflect.TypeOf(time.Duration(0))
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+	invalidNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+)
+
+// schemas generates the schemas of Go types, named structs are saved once
+// as components and referenced everywhere else.
+type schemas struct {
+	components map[string]*Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemas() *schemas {
+	return &schemas{
+		components: map[string]*Schema{},
+		names:      map[reflect.Type]string{},
+	}
+}
+
+// of returns the schema of the values of type `t`.
+func (s *schemas) of(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case t == durationType:
+		return &Schema{Type: "integer", Format: "int64", Description: "Duration in nanoseconds."}
+	case implements(t, textMarshalerType):
+		// Like the object IDs, which are written as hexadecimal strings.
+		return &Schema{Type: "string"}
+	case implements(t, jsonMarshalerType):
+		// The JSON written by the type is unknown, accept anything.
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		zero := 0.0
+		return &Schema{Type: "integer", Minimum: &zero}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", ContentEncoding: "base64"}
+		}
+		return &Schema{Type: "array", Items: s.of(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.of(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + s.component(t)}
+	default:
+		// Interfaces and anything else unknown accept any value.
+		return &Schema{}
+	}
+}
+
+// component saves the schema of the named struct `t` as a component, if it
+// was not already, and returns its name.
+func (s *schemas) component(t reflect.Type) string {
+	if name, ok := s.names[t]; ok {
+		return name
+	}
+
+	name := s.uniqueName(t)
+	s.names[t] = name // Saved before generating so recursive types terminate.
+	s.components[name] = s.object(t)
+	return name
+}
+
+// uniqueName returns the name of `t`, prefixed by as many elements of its
+// package path as needed to tell it apart from the other types of the same
+// name, like the `MeResponseDTO` of several modules.
+func (s *schemas) uniqueName(t reflect.Type) string {
+	name := invalidNameChars.ReplaceAllString(t.Name(), "_")
+	if _, taken := s.components[name]; !taken {
+		return name
+	}
+	parts := strings.Split(t.PkgPath(), "/")
+	for i := len(parts) - 1; i >= 0; i-- {
+		candidate := invalidNameChars.ReplaceAllString(strings.Join(parts[i:], "."), "_") + "." + name
+		if _, taken := s.components[candidate]; !taken {
+			return candidate
+		}
+	}
+	return name
+}
+
+// object returns the schema of the struct `t` from the JSON names of its
+// fields, the fields without `omitempty` are required.
+func (s *schemas) object(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	s.addFields(schema, t)
+	return schema
+}
+
+func (s *schemas) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		// Promote the fields of embedded structs like `encoding/json` does.
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				s.addFields(schema, ft)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		var fieldSchema *Schema
+		if hasOption(opts, "string") {
+			fieldSchema = &Schema{Type: "string"}
+		} else {
+			fieldSchema = s.of(field.Type)
+		}
+		schema.Properties[name] = fieldSchema
+		if !hasOption(opts, "omitempty") && !hasOption(opts, "omitzero") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PointerTo(t).Implements(iface)
+}
+
+func hasOption(opts, option string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+package authorization
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+)
+
+// Roles mirror the `FederatedUserRole*` values stored on the user record.
+const (
+	RoleRoot       int8 = 1
+	RoleCompany    int8 = 2
+	RoleIndividual int8 = 3
+)
+
+// Permissions which routes may require. Which roles hold each permission is
+// controlled by `DefaultPermissionRoles` and may be overridden through the
+// `BACKEND_APP_PERMISSION_ROLES` environment variable.
+const (
+	PermissionVaultFiles    = "vault:files"
+	PermissionAPIKeysManage = "apikeys:manage"
+	PermissionUsersManage   = "users:manage"
+)
+
+// DefaultPermissionRoles is the permission-to-role mapping used when no
+// override was configured.
+var DefaultPermissionRoles = map[string][]int8{
+	PermissionVaultFiles:    {RoleRoot, RoleCompany, RoleIndividual},
+	PermissionAPIKeysManage: {RoleRoot, RoleCompany, RoleIndividual},
+	PermissionUsersManage:   {RoleRoot},
+}
+
+// Requirement describes what a route requires from the authenticated user.
+// The user must have one of `Roles` (if any are set) and every one of
+// `Permissions`.
+type Requirement struct {
+	Roles       []int8
+	Permissions []string
+}
+
+// IsEmpty returns true if the requirement does not restrict access.
+func (r *Requirement) IsEmpty() bool {
+	return r == nil || (len(r.Roles) == 0 && len(r.Permissions) == 0)
+}
+
+// Provider provides interface for checking if a role may access a resource.
+type Provider interface {
+	HasPermission(role int8, permission string) bool
+	IsAuthorized(role int8, req *Requirement) bool
+}
+
+type provider struct {
+	permissionRoles map[string]map[int8]struct{}
+}
+
+// NewProvider Constructor that returns the authorization checker.
+func NewProvider(cfg *config.Configuration, logger *zap.Logger) Provider {
+	mapping := DefaultPermissionRoles
+	if cfg.App.PermissionRoles != "" {
+		overrides, err := ParsePermissionRoles(cfg.App.PermissionRoles)
+		if err != nil {
+			log.Fatalf("failed to parse permission roles: %v", err)
+		}
+		mapping = make(map[string][]int8, len(DefaultPermissionRoles)+len(overrides))
+		for permission, roles := range DefaultPermissionRoles {
+			mapping[permission] = roles
+		}
+		for permission, roles := range overrides {
+			mapping[permission] = roles
+		}
+	}
+
+	logger.Debug("authorization initialized", zap.Any("permission_roles", mapping))
+
+	return newProvider(mapping)
+}
+
+func newProvider(mapping map[string][]int8) *provider {
+	p := &provider{
+		permissionRoles: make(map[string]map[int8]struct{}, len(mapping)),
+	}
+	for permission, roles := range mapping {
+		set := make(map[int8]struct{}, len(roles))
+		for _, role := range roles {
+			set[role] = struct{}{}
+		}
+		p.permissionRoles[permission] = set
+	}
+	return p
+}
+
+// HasPermission returns true if `role` was granted `permission`. Unknown
+// permissions are never granted.
+func (p *provider) HasPermission(role int8, permission string) bool {
+	roles, ok := p.permissionRoles[permission]
+	if !ok {
+		return false
+	}
+	_, ok = roles[role]
+	return ok
+}
+
+// IsAuthorized returns true if `role` satisfies the requirement.
+func (p *provider) IsAuthorized(role int8, req *Requirement) bool {
+	if req.IsEmpty() {
+		return true
+	}
+	if len(req.Roles) > 0 {
+		found := false
+		for _, r := range req.Roles {
+			if r == role {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, permission := range req.Permissions {
+		if !p.HasPermission(role, permission) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParsePermissionRoles parses a mapping in the form
+// `permission=role,role;permission=role`, for example
+// `users:manage=1;vault:files=1,2,3`.
+func ParsePermissionRoles(value string) (map[string][]int8, error) {
+	mapping := make(map[string][]int8)
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		permission, rolesStr, ok := strings.Cut(entry, "=")
+		permission = strings.TrimSpace(permission)
+		if !ok || permission == "" {
+			return nil, fmt.Errorf("invalid permission entry: %q", entry)
+		}
+		roles := []int8{}
+		for _, roleStr := range strings.Split(rolesStr, ",") {
+			roleStr = strings.TrimSpace(roleStr)
+			if roleStr == "" {
+				continue
+			}
+			role, err := strconv.ParseInt(roleStr, 10, 8)
+			if err != nil {
+				return nil, fmt.Errorf("invalid role %q for permission %q: %w", roleStr, permission, err)
+			}
+			roles = append(roles, int8(role))
+		}
+		mapping[permission] = roles
+	}
+	return mapping, nil
+}
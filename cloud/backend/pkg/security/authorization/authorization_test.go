@@ -0,0 +1,89 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePermissionRoles(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string][]int8
+		wantErr bool
+	}{
+		{
+			name:  "single permission",
+			value: "users:manage=1",
+			want:  map[string][]int8{"users:manage": {1}},
+		},
+		{
+			name:  "multiple permissions with spaces",
+			value: " users:manage=1 ; vault:files=1, 2,3;",
+			want: map[string][]int8{
+				"users:manage": {1},
+				"vault:files":  {1, 2, 3},
+			},
+		},
+		{
+			name:  "permission without roles",
+			value: "vault:files=",
+			want:  map[string][]int8{"vault:files": {}},
+		},
+		{
+			name:    "missing separator",
+			value:   "users:manage",
+			wantErr: true,
+		},
+		{
+			name:    "invalid role",
+			value:   "users:manage=root",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePermissionRoles(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIsAuthorized(t *testing.T) {
+	p := newProvider(DefaultPermissionRoles)
+
+	tests := []struct {
+		name string
+		role int8
+		req  *Requirement
+		want bool
+	}{
+		{"no requirement", RoleIndividual, nil, true},
+		{"empty requirement", RoleIndividual, &Requirement{}, true},
+		{"role allowed", RoleRoot, &Requirement{Roles: []int8{RoleRoot}}, true},
+		{"role denied", RoleCompany, &Requirement{Roles: []int8{RoleRoot}}, false},
+		{"permission allowed", RoleIndividual, &Requirement{Permissions: []string{PermissionVaultFiles}}, true},
+		{"permission denied", RoleIndividual, &Requirement{Permissions: []string{PermissionUsersManage}}, false},
+		{"unknown permission", RoleRoot, &Requirement{Permissions: []string{"unknown"}}, false},
+		{"unauthenticated", 0, &Requirement{Permissions: []string{PermissionVaultFiles}}, false},
+		{
+			"role and permission",
+			RoleRoot,
+			&Requirement{Roles: []int8{RoleRoot, RoleCompany}, Permissions: []string{PermissionUsersManage}},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, p.IsAuthorized(tt.role, tt.req))
+		})
+	}
+}
@@ -1,21 +1,87 @@
 package blacklist
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+)
+
+const (
+	bannedIPAddressesFilePath = "static/blacklist/ips.json"
+	bannedURLsFilePath        = "static/blacklist/urls.json"
+
+	// changedChannel is the pub/sub channel used to tell every instance to
+	// refresh; the payload is the ID of the instance which made the change.
+	changedChannel = "blacklist:changed"
+
+	// autoBansKey is the sorted set of automatically banned IP addresses
+	// scored by the unix time their ban expires.
+	autoBansKey = "blacklist:auto_bans"
+
+	// strikesKeyPrefix counts the requests of an IP address to banned URLs.
+	strikesKeyPrefix = "blacklist:strikes:"
+
+	defaultRefreshInterval = 5 * time.Minute
 )
 
-// Provider provides an interface for abstracting time.
+// Provider provides an interface for checking requests against the banned
+// IP addresses and URLs.
 type Provider interface {
+	// IsBannedIPAddress returns true if the IP address is banned on its own
+	// or belongs to a banned range and the ban has not expired.
 	IsBannedIPAddress(ipAddress string) bool
+
+	// IsBannedURL returns true if the URL path is banned.
 	IsBannedURL(url string) bool
+
+	// ReportAbuse counts a request from `ipAddress` to a banned URL and bans
+	// the IP address temporarily once it reached the configured threshold.
+	ReportAbuse(ctx context.Context, ipAddress string)
+
+	// Refresh reloads the entries of the blacklist files, the sources and the
+	// automatic bans.
+	Refresh(ctx context.Context) error
+
+	// NotifyChanged refreshes the entries of every instance, it must be
+	// called after an entry was added or removed.
+	NotifyChanged(ctx context.Context) error
 }
 
 type blacklistProvider struct {
-	bannedIPAddresses map[string]bool
-	bannedURLs        map[string]bool
+	logger           *zap.Logger
+	redis            redis.UniversalClient
+	sources          []Source
+	instanceID       string
+	refreshInterval  time.Duration
+	autoBanThreshold int64
+	autoBanDuration  time.Duration
+
+	mu                  sync.RWMutex
+	bannedIPAddresses   map[string]bool
+	expiringIPAddresses map[string]time.Time
+	bannedNetworks      []bannedNetwork
+	bannedURLs          map[string]bool
+
+	// autoBans are the last automatic bans listed, kept while Redis is
+	// unreachable. Only `Refresh` uses them.
+	autoBansMu sync.Mutex
+	autoBans   []Entry
+
+	cancel context.CancelFunc
+	done   chan struct{}
 }
 
 // readBlacklistFileContent reads the contents of the blacklist file and returns
@@ -41,36 +107,277 @@ func readBlacklistFileContent(filePath string) ([]string, error) {
 	return ips, nil
 }
 
-// NewProvider Provider contructor that returns the default time provider.
-func NewProvider() Provider {
-	bannedIPAddresses := make(map[string]bool)
-	bannedIPAddressesFilePath := "static/blacklist/ips.json"
+// readBlacklistFiles returns the banned IP addresses and URLs of the
+// blacklist files, a missing file is treated as empty.
+func readBlacklistFiles() ([]Entry, map[string]bool) {
+	var entries []Entry
 	ips, err := readBlacklistFileContent(bannedIPAddressesFilePath)
 	if err == nil { // Aka: if the file exists...
 		for _, ip := range ips {
-			bannedIPAddresses[ip] = true
+			entries = append(entries, Entry{Value: ip})
 		}
 	}
 
 	bannedURLs := make(map[string]bool)
-	bannedURLsFilePath := "static/blacklist/urls.json"
 	urls, err := readBlacklistFileContent(bannedURLsFilePath)
 	if err == nil { // Aka: if the file exists...
 		for _, url := range urls {
 			bannedURLs[url] = true
 		}
 	}
+	return entries, bannedURLs
+}
+
+// NewProvider Provider contructor that returns the blocklist merging the
+// blacklist files with the entries of the `sources`, for example the banned
+// IP addresses in the database. The entries of the files are available
+// immediately while the sources are loaded when the application starts and
+// reloaded whenever an instance publishes a change through Redis.
+func NewProvider(
+	lc fx.Lifecycle,
+	cfg *config.Configuration,
+	logger *zap.Logger,
+	redisClient redis.UniversalClient,
+	sources []Source,
+) Provider {
+	instanceID := make([]byte, 8)
+	_, _ = rand.Read(instanceID)
 
-	return blacklistProvider{
-		bannedIPAddresses: bannedIPAddresses,
-		bannedURLs:        bannedURLs,
+	p := &blacklistProvider{
+		logger:           logger,
+		redis:            redisClient,
+		sources:          sources,
+		instanceID:       hex.EncodeToString(instanceID),
+		refreshInterval:  time.Duration(cfg.App.BlacklistRefreshSeconds) * time.Second,
+		autoBanThreshold: int64(cfg.App.BlacklistAutoBanThreshold),
+		autoBanDuration:  time.Duration(cfg.App.BlacklistAutoBanSeconds) * time.Second,
 	}
+	if p.refreshInterval <= 0 {
+		p.refreshInterval = defaultRefreshInterval
+	}
+
+	entries, bannedURLs := readBlacklistFiles()
+	p.set(entries, bannedURLs)
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := p.Refresh(ctx); err != nil {
+				// Do not crash app, the files are still enforced and the
+				// sources are tried again on the next refresh.
+				logger.Warn("blacklist failed initial refresh", zap.Any("err", err))
+			}
+			watchCtx, cancel := context.WithCancel(context.Background())
+			p.cancel = cancel
+			p.done = make(chan struct{})
+			go p.watch(watchCtx)
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if p.cancel == nil {
+				return nil
+			}
+			p.cancel()
+			select {
+			case <-p.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+
+	logger.Debug("blacklist initialized",
+		zap.Int("sources", len(sources)),
+		zap.Duration("refresh_interval", p.refreshInterval),
+		zap.Int64("auto_ban_threshold", p.autoBanThreshold),
+		zap.Duration("auto_ban_duration", p.autoBanDuration))
+
+	return p
 }
 
-func (p blacklistProvider) IsBannedIPAddress(ipAddress string) bool {
-	return p.bannedIPAddresses[ipAddress]
+// set replaces the entries which are matched against.
+func (p *blacklistProvider) set(entries []Entry, bannedURLs map[string]bool) {
+	s := newEntrySet(entries, time.Now())
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.bannedIPAddresses = s.ipAddresses
+	p.expiringIPAddresses = s.expiringIPAddresses
+	p.bannedNetworks = s.networks
+	p.bannedURLs = bannedURLs
 }
 
-func (p blacklistProvider) IsBannedURL(url string) bool {
+func (p *blacklistProvider) IsBannedIPAddress(ipAddress string) bool {
+	ip := normalizeIPAddress(ipAddress)
+	now := time.Now()
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.bannedIPAddresses[ip] {
+		return true
+	}
+	if expiresAt, ok := p.expiringIPAddresses[ip]; ok && now.Before(expiresAt) {
+		return true
+	}
+	if len(p.bannedNetworks) == 0 {
+		return false
+	}
+	parsed, _, err := parseValue(ip)
+	if err != nil || parsed == nil {
+		return false
+	}
+	for _, n := range p.bannedNetworks {
+		if (n.expiresAt.IsZero() || now.Before(n.expiresAt)) && n.network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *blacklistProvider) IsBannedURL(url string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 	return p.bannedURLs[url]
 }
+
+func (p *blacklistProvider) Refresh(ctx context.Context) error {
+	entries, bannedURLs := readBlacklistFiles()
+
+	for _, source := range p.sources {
+		sourceEntries, err := source.ListBannedIPAddresses(ctx)
+		if err != nil {
+			return fmt.Errorf("failed listing banned ip addresses: %w", err)
+		}
+		entries = append(entries, sourceEntries...)
+	}
+
+	if p.redis != nil {
+		// Redis being unreachable must not stop the other entries from
+		// being applied, the last known automatic bans are kept instead.
+		// The lock is not held while listing so the concurrent refreshes
+		// never wait for a slow Redis one after the other.
+		autoBans, err := p.listAutoBans(ctx)
+		p.autoBansMu.Lock()
+		if err != nil {
+			p.logger.Warn("blacklist failed listing automatic bans, keeping the last known ones",
+				zap.Int("auto_bans", len(p.autoBans)),
+				zap.Any("err", err))
+			autoBans = p.autoBans
+		} else {
+			p.autoBans = autoBans
+		}
+		p.autoBansMu.Unlock()
+		entries = append(entries, autoBans...)
+	}
+
+	p.set(entries, bannedURLs)
+	return nil
+}
+
+func (p *blacklistProvider) NotifyChanged(ctx context.Context) error {
+	if err := p.Refresh(ctx); err != nil {
+		return err
+	}
+	if p.redis == nil {
+		return nil
+	}
+	if err := p.redis.Publish(ctx, changedChannel, p.instanceID).Err(); err != nil {
+		// The change was saved, the other instances apply it on their next
+		// periodic refresh.
+		p.logger.Warn("blacklist failed publishing change", zap.Any("err", err))
+	}
+	return nil
+}
+
+// listAutoBans returns the automatic bans which have not expired and removes
+// the ones which have.
+func (p *blacklistProvider) listAutoBans(ctx context.Context) ([]Entry, error) {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	if err := p.redis.ZRemRangeByScore(ctx, autoBansKey, "-inf", now).Err(); err != nil {
+		return nil, err
+	}
+	bans, err := p.redis.ZRangeByScoreWithScores(ctx, autoBansKey, &redis.ZRangeBy{Min: "(" + now, Max: "+inf"}).Result()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]Entry, 0, len(bans))
+	for _, ban := range bans {
+		value, _ := ban.Member.(string)
+		entries = append(entries, Entry{Value: value, ExpiresAt: time.Unix(int64(ban.Score), 0)})
+	}
+	return entries, nil
+}
+
+func (p *blacklistProvider) ReportAbuse(ctx context.Context, ipAddress string) {
+	if p.redis == nil || p.autoBanThreshold <= 0 || p.autoBanDuration <= 0 {
+		return
+	}
+	ipAddress = normalizeIPAddress(ipAddress)
+	if _, _, err := parseValue(ipAddress); err != nil {
+		return
+	}
+
+	key := strikesKeyPrefix + ipAddress
+	strikes, err := p.redis.Incr(ctx, key).Result()
+	if err != nil {
+		p.logger.Warn("blacklist failed counting strike", zap.Any("err", err))
+		return
+	}
+	if strikes == 1 {
+		p.redis.Expire(ctx, key, p.autoBanDuration)
+	}
+	if strikes < p.autoBanThreshold {
+		return
+	}
+
+	expiresAt := time.Now().Add(p.autoBanDuration)
+	if err := p.redis.ZAdd(ctx, autoBansKey, redis.Z{Score: float64(expiresAt.Unix()), Member: ipAddress}).Err(); err != nil {
+		p.logger.Warn("blacklist failed banning ip address", zap.Any("err", err))
+		return
+	}
+	p.redis.Del(ctx, key)
+
+	p.logger.Warn("ip address banned automatically",
+		zap.String("ip_address", ipAddress),
+		zap.Int64("strikes", strikes),
+		zap.Time("expires_at", expiresAt))
+
+	if err := p.NotifyChanged(ctx); err != nil {
+		p.logger.Warn("blacklist failed notifying change", zap.Any("err", err))
+	}
+}
+
+// watch refreshes the entries whenever another instance publishes a change
+// and periodically in case a notification was missed while disconnected.
+func (p *blacklistProvider) watch(ctx context.Context) {
+	defer close(p.done)
+
+	var messages <-chan *redis.Message
+	if p.redis != nil {
+		pubsub := p.redis.Subscribe(ctx, changedChannel)
+		defer pubsub.Close()
+		messages = pubsub.Channel()
+	}
+
+	ticker := time.NewTicker(p.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				messages = nil
+				continue
+			}
+			if msg.Payload == p.instanceID {
+				continue
+			}
+		case <-ticker.C:
+		}
+		if err := p.Refresh(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Warn("blacklist failed refreshing", zap.Any("err", err))
+		}
+	}
+}
@@ -1,11 +1,19 @@
 package blacklist
 
 import (
+	"context"
+	"net"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/fx/fxtest"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
 )
 
 func createTempFile(t *testing.T, content string) string {
@@ -91,7 +99,7 @@ func TestNewProvider(t *testing.T) {
 	assert.NoError(t, err)
 	defer os.Chdir(originalWd)
 
-	provider := NewProvider()
+	provider := NewProvider(fxtest.NewLifecycle(t), &config.Configuration{}, zap.NewNop(), nil, nil)
 	assert.NotNil(t, provider)
 
 	// Test IP blacklist
@@ -130,3 +138,149 @@ func TestIsBannedURL(t *testing.T) {
 	assert.True(t, provider.IsBannedURL("malicious.com"))
 	assert.False(t, provider.IsBannedURL("safe.com"))
 }
+
+type staticSource []Entry
+
+func (s staticSource) ListBannedIPAddresses(ctx context.Context) ([]Entry, error) {
+	return s, nil
+}
+
+func TestRefreshMergesSources(t *testing.T) {
+	source := staticSource{
+		{Value: "203.0.113.7"},
+		{Value: "198.51.100.0/24"},
+		{Value: "2001:db8::/32"},
+		{Value: "192.0.2.1", ExpiresAt: time.Now().Add(time.Hour)},
+		{Value: "192.0.2.2", ExpiresAt: time.Now().Add(-time.Hour)},
+	}
+	provider := &blacklistProvider{sources: []Source{source}}
+
+	assert.NoError(t, provider.Refresh(context.Background()))
+
+	assert.True(t, provider.IsBannedIPAddress("203.0.113.7"))
+	assert.True(t, provider.IsBannedIPAddress("198.51.100.42"))
+	assert.False(t, provider.IsBannedIPAddress("198.51.101.1"))
+	assert.True(t, provider.IsBannedIPAddress("2001:DB8:0:1::5"))
+	assert.False(t, provider.IsBannedIPAddress("2001:db9::1"))
+	assert.True(t, provider.IsBannedIPAddress("::ffff:198.51.100.1"))
+	assert.True(t, provider.IsBannedIPAddress("192.0.2.1"))
+	assert.False(t, provider.IsBannedIPAddress("192.0.2.2"))
+}
+
+func TestRefreshWithUnreachableRedis(t *testing.T) {
+	client := redis.NewClient(&redis.Options{
+		Addr:        "127.0.0.1:1",
+		MaxRetries:  -1,
+		DialTimeout: 100 * time.Millisecond,
+	})
+	defer client.Close()
+
+	provider := &blacklistProvider{
+		logger:   zap.NewNop(),
+		redis:    client,
+		sources:  []Source{staticSource{{Value: "203.0.113.7"}}},
+		autoBans: []Entry{{Value: "192.0.2.1", ExpiresAt: time.Now().Add(time.Hour)}},
+	}
+
+	assert.NoError(t, provider.Refresh(context.Background()))
+	assert.True(t, provider.IsBannedIPAddress("203.0.113.7"), "source entries were not applied")
+	assert.True(t, provider.IsBannedIPAddress("192.0.2.1"), "last known automatic bans were not kept")
+
+	assert.NoError(t, provider.NotifyChanged(context.Background()))
+}
+
+func TestConcurrentRefreshesWithSlowRedis(t *testing.T) {
+	// Accepts the connections but never answers, like an overloaded Redis.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	const timeout = 200 * time.Millisecond
+	client := redis.NewClient(&redis.Options{
+		Addr:        listener.Addr().String(),
+		MaxRetries:  -1,
+		ReadTimeout: timeout,
+	})
+	defer client.Close()
+
+	provider := &blacklistProvider{
+		logger: zap.NewNop(),
+		redis:  client,
+	}
+
+	const refreshes = 5
+	start := time.Now()
+	done := make(chan error, refreshes)
+	for range refreshes {
+		go func() { done <- provider.Refresh(context.Background()) }()
+	}
+	for range refreshes {
+		assert.NoError(t, <-done)
+	}
+	assert.Less(t, time.Since(start), refreshes*timeout/2, "the refreshes waited for one another")
+}
+
+func TestIsBannedIPAddressExpires(t *testing.T) {
+	provider := blacklistProvider{
+		expiringIPAddresses: map[string]time.Time{
+			"192.0.2.1": time.Now().Add(time.Hour),
+			"192.0.2.2": time.Now().Add(-time.Second),
+		},
+		bannedNetworks: []bannedNetwork{
+			{network: mustParseCIDR(t, "10.0.0.0/8"), expiresAt: time.Now().Add(-time.Second)},
+		},
+	}
+
+	assert.True(t, provider.IsBannedIPAddress("192.0.2.1"))
+	assert.False(t, provider.IsBannedIPAddress("192.0.2.2"))
+	assert.False(t, provider.IsBannedIPAddress("10.0.0.1"))
+}
+
+func TestNewEntrySetKeepsLongestBan(t *testing.T) {
+	now := time.Now()
+	s := newEntrySet([]Entry{
+		{Value: "192.0.2.1", ExpiresAt: now.Add(time.Hour)},
+		{Value: "192.0.2.1", ExpiresAt: now.Add(2 * time.Hour)},
+		{Value: "192.0.2.2", ExpiresAt: now.Add(time.Hour)},
+		{Value: "192.0.2.2"},
+		{Value: "not-an-ip"},
+	}, now)
+
+	assert.Equal(t, now.Add(2*time.Hour), s.expiringIPAddresses["192.0.2.1"])
+	assert.True(t, s.ipAddresses["192.0.2.2"])
+	assert.NotContains(t, s.expiringIPAddresses, "192.0.2.2")
+	assert.True(t, s.ipAddresses["not-an-ip"])
+}
+
+func TestNormalizeValue(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "203.0.113.7", want: "203.0.113.7"},
+		{value: " 2001:DB8::1 ", want: "2001:db8::1"},
+		{value: "10.1.2.3/8", want: "10.0.0.0/8"},
+		{value: "2001:db8:1::/48", want: "2001:db8:1::/48"},
+		{value: "10.0.0.0/33", wantErr: true},
+		{value: "example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := NormalizeValue(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func mustParseCIDR(t *testing.T, value string) *net.IPNet {
+	_, network, err := net.ParseCIDR(value)
+	assert.NoError(t, err)
+	return network
+}
@@ -0,0 +1,134 @@
+package blacklist
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"go.uber.org/fx"
+)
+
+// Entry is a banned IP address, CIDR range or IPv6 prefix, for example
+// `203.0.113.7`, `198.51.100.0/24` or `2001:db8::/32`.
+type Entry struct {
+	Value string
+
+	// ExpiresAt is when a temporary ban is lifted, a zero value bans forever.
+	ExpiresAt time.Time
+}
+
+// isActive returns true if the ban has not expired at `now`.
+func (e Entry) isActive(now time.Time) bool {
+	return e.ExpiresAt.IsZero() || now.Before(e.ExpiresAt)
+}
+
+// Source provides banned IP addresses which are stored outside of the
+// blacklist files, for example in the database.
+type Source interface {
+	// ListBannedIPAddresses returns every entry which has not expired.
+	ListBannedIPAddresses(ctx context.Context) ([]Entry, error)
+}
+
+// AsSource annotates the given constructor to state that it provides a
+// source to the "blacklist_sources" group.
+func AsSource(f any) any {
+	return fx.Annotate(
+		f,
+		fx.As(new(Source)),
+		fx.ResultTags(`group:"blacklist_sources"`),
+	)
+}
+
+// NormalizeValue returns the canonical form of an IP address or CIDR, for
+// example `2001:DB8::1` becomes `2001:db8::1` and `10.1.2.3/8` becomes
+// `10.0.0.0/8`, so equal entries are stored only once.
+func NormalizeValue(value string) (string, error) {
+	ip, network, err := parseValue(value)
+	if err != nil {
+		return "", err
+	}
+	if network != nil {
+		return network.String(), nil
+	}
+	return ip.String(), nil
+}
+
+// parseValue returns either the IP address or the network of `value`.
+func parseValue(value string) (net.IP, *net.IPNet, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "/") {
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid cidr: %q", value)
+		}
+		return nil, network, nil
+	}
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, nil, fmt.Errorf("invalid ip address: %q", value)
+	}
+	return ip, nil, nil
+}
+
+// normalizeIPAddress returns the canonical form of `ipAddress` or the value
+// unchanged if it is not an IP address.
+func normalizeIPAddress(ipAddress string) string {
+	if ip := net.ParseIP(ipAddress); ip != nil {
+		return ip.String()
+	}
+	return ipAddress
+}
+
+type bannedNetwork struct {
+	network   *net.IPNet
+	expiresAt time.Time
+}
+
+// entrySet indexes entries for matching; single addresses are looked up by
+// their canonical form while networks are searched.
+type entrySet struct {
+	ipAddresses         map[string]bool
+	expiringIPAddresses map[string]time.Time
+	networks            []bannedNetwork
+}
+
+func newEntrySet(entries []Entry, now time.Time) *entrySet {
+	s := &entrySet{
+		ipAddresses:         make(map[string]bool),
+		expiringIPAddresses: make(map[string]time.Time),
+	}
+	for _, entry := range entries {
+		if !entry.isActive(now) {
+			continue
+		}
+		ip, network, err := parseValue(entry.Value)
+		switch {
+		case network != nil:
+			s.networks = append(s.networks, bannedNetwork{network: network, expiresAt: entry.ExpiresAt})
+		case ip != nil:
+			s.addIPAddress(ip.String(), entry.ExpiresAt)
+		case err != nil:
+			// Values of the blacklist files were always matched as-is, keep
+			// doing so for the ones which are not IP addresses.
+			s.addIPAddress(strings.TrimSpace(entry.Value), entry.ExpiresAt)
+		}
+	}
+	return s
+}
+
+// addIPAddress keeps the longest ban if an address is listed more than once.
+func (s *entrySet) addIPAddress(key string, expiresAt time.Time) {
+	if s.ipAddresses[key] {
+		return
+	}
+	if expiresAt.IsZero() {
+		s.ipAddresses[key] = true
+		delete(s.expiringIPAddresses, key)
+		return
+	}
+	if current, ok := s.expiringIPAddresses[key]; !ok || expiresAt.After(current) {
+		s.expiringIPAddresses[key] = expiresAt
+	}
+}
@@ -0,0 +1,111 @@
+// Package certreloader serves a TLS certificate which is reloaded when its
+// files change, so renewed certificates are used without restarting.
+package certreloader
+
+import (
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Reloader holds the certificate loaded from a certificate and key file.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	logger   *zap.Logger
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	modTimes [2]time.Time
+
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// New loads the certificate from the PEM encoded `certFile` and `keyFile`
+// and returns an error if they are invalid.
+func New(certFile, keyFile string, logger *zap.Logger) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		logger:   logger.Named("certreloader"),
+		stop:     make(chan struct{}),
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the current certificate, use it as the
+// `tls.Config.GetCertificate` callback.
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// Reload reads the certificate files again. The previous certificate stays
+// in use if they are invalid.
+func (r *Reloader) Reload() error {
+	modTimes := r.readModTimes()
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load tls certificate: %w", err)
+	}
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.modTimes = modTimes
+	r.mu.Unlock()
+
+	r.logger.Info("tls certificate loaded",
+		zap.String("cert_file", r.certFile),
+		zap.String("key_file", r.keyFile))
+	return nil
+}
+
+func (r *Reloader) readModTimes() [2]time.Time {
+	var modTimes [2]time.Time
+	for i, path := range []string{r.certFile, r.keyFile} {
+		if info, err := os.Stat(path); err == nil {
+			modTimes[i] = info.ModTime()
+		}
+	}
+	return modTimes
+}
+
+// Watch reloads the certificate whenever one of the files changes until
+// `Close` is called. It blocks so it must be run in its own goroutine.
+func (r *Reloader) Watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.mu.RLock()
+			unchanged := r.readModTimes() == r.modTimes
+			r.mu.RUnlock()
+			if unchanged {
+				continue
+			}
+			if err := r.Reload(); err != nil {
+				// Keep the previous certificate and retry on the next tick,
+				// the files may be in the middle of being replaced.
+				r.logger.Warn("tls certificate failed reloading", zap.Any("err", err))
+			}
+		}
+	}
+}
+
+// Close stops watching the certificate files.
+func (r *Reloader) Close() {
+	r.closeOnce.Do(func() { close(r.stop) })
+}
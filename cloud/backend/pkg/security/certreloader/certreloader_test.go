@@ -0,0 +1,80 @@
+package certreloader
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// writeCertificate writes a self-signed certificate for `commonName` and
+// sets the modification time of the files to `modTime`.
+func writeCertificate(t *testing.T, dir, commonName string, modTime time.Time) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	require.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+	return certFile, keyFile
+}
+
+func commonName(t *testing.T, r *Reloader) string {
+	t.Helper()
+	cert, err := r.GetCertificate(nil)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	return leaf.Subject.CommonName
+}
+
+func TestNewInvalidFiles(t *testing.T) {
+	_, err := New("missing.crt", "missing.key", zap.NewNop())
+	assert.Error(t, err)
+}
+
+func TestWatchReloadsChangedCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCertificate(t, dir, "old", time.Now().Add(-time.Minute))
+
+	r, err := New(certFile, keyFile, zap.NewNop())
+	require.NoError(t, err)
+	defer r.Close()
+	assert.Equal(t, "old", commonName(t, r))
+
+	go r.Watch(10 * time.Millisecond)
+
+	// An invalid certificate is ignored.
+	require.NoError(t, os.WriteFile(certFile, []byte("invalid"), 0o600))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, "old", commonName(t, r))
+
+	writeCertificate(t, dir, "new", time.Now())
+	assert.Eventually(t, func() bool { return commonName(t, r) == "new" }, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,147 @@
+package clientip
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+)
+
+// Resolver provides interface for finding the IP address of the client which
+// made a request, which may have passed through our load balancer or other
+// proxies.
+type Resolver interface {
+	// Resolve returns the client IP address of the request and the forwarding
+	// header it was taken from, which is empty if the request did not come
+	// through a trusted proxy.
+	Resolve(r *http.Request) (ipAddress string, proxies string)
+}
+
+type resolver struct {
+	trustedProxies []*net.IPNet
+}
+
+// NewResolver Constructor that returns the client IP resolver. Only proxies
+// within the configured trusted CIDRs may forward the client IP address.
+// Fatally crashes the entire application if a CIDR is invalid.
+func NewResolver(cfg *config.Configuration, logger *zap.Logger) Resolver {
+	trustedProxies, err := ParseTrustedProxies(cfg.App.TrustedProxies)
+	if err != nil {
+		log.Fatalf("failed to parse trusted proxies: %v", err)
+	}
+
+	logger.Debug("client ip resolver initialized",
+		zap.Strings("trusted_proxies", cfg.App.TrustedProxies))
+
+	return &resolver{trustedProxies: trustedProxies}
+}
+
+// ParseTrustedProxies parses a list of CIDRs, for example `10.0.0.0/8`. A
+// single IP address is trusted on its own. Empty values are skipped.
+func ParseTrustedProxies(values []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy: %q", value)
+			}
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %q: %w", value, err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+func (p *resolver) isTrusted(ip net.IP) bool {
+	for _, ipNet := range p.trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *resolver) Resolve(r *http.Request) (string, string) {
+	remote := parseHop(r.RemoteAddr)
+	if remote == nil {
+		return r.RemoteAddr, ""
+	}
+	if !p.isTrusted(remote) {
+		// Anyone can send forwarding headers so they are ignored unless the
+		// connection was made by one of our proxies.
+		return remote.String(), ""
+	}
+
+	var hops []string
+	proxies := r.Header.Get("Forwarded")
+	if proxies != "" {
+		hops = parseForwarded(proxies)
+	} else {
+		proxies = r.Header.Get("X-Forwarded-For")
+		hops = strings.Split(proxies, ",")
+	}
+
+	// Every proxy appends the address it received the request from, so walk
+	// the hops from the right and stop at the first one we do not trust; any
+	// hops to its left were provided by the client and cannot be trusted.
+	client := remote
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := parseHop(hops[i])
+		if hop == nil {
+			break
+		}
+		client = hop
+		if !p.isTrusted(hop) {
+			break
+		}
+	}
+	return client.String(), proxies
+}
+
+// parseForwarded returns the `for` parameter of every element of a RFC 7239
+// `Forwarded` header, for example `for=192.0.2.60;proto=https, for="[2001:db8::1]:4711"`.
+func parseForwarded(value string) []string {
+	var hops []string
+	for _, element := range strings.Split(value, ",") {
+		hop := ""
+		for _, pair := range strings.Split(element, ";") {
+			key, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if ok && strings.EqualFold(key, "for") {
+				hop = strings.Trim(val, `"`)
+			}
+		}
+		// Keep elements without `for` so the hop positions still line up.
+		hops = append(hops, hop)
+	}
+	return hops
+}
+
+// parseHop returns the IP address of a hop which may include a port and, for
+// IPv6, square brackets. It returns nil for obfuscated or unknown hops.
+func parseHop(value string) net.IP {
+	value = strings.TrimSpace(value)
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	return net.ParseIP(value)
+}
@@ -0,0 +1,114 @@
+package clientip
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	nets, err := ParseTrustedProxies([]string{"10.0.0.0/8", " 192.168.1.10 ", "", "fd00::/8", "::1"})
+	assert.NoError(t, err)
+	assert.Len(t, nets, 4)
+	assert.Equal(t, "10.0.0.0/8", nets[0].String())
+	assert.Equal(t, "192.168.1.10/32", nets[1].String())
+	assert.Equal(t, "fd00::/8", nets[2].String())
+	assert.Equal(t, "::1/128", nets[3].String())
+
+	_, err = ParseTrustedProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+
+	_, err = ParseTrustedProxies([]string{"proxy"})
+	assert.Error(t, err)
+}
+
+func TestResolve(t *testing.T) {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8", "fd00::/8"})
+	assert.NoError(t, err)
+	r := &resolver{trustedProxies: trusted}
+
+	tests := []struct {
+		name        string
+		remoteAddr  string
+		headers     map[string]string
+		wantIP      string
+		wantProxies string
+	}{
+		{
+			name:       "direct connection",
+			remoteAddr: "203.0.113.7:5123",
+			wantIP:     "203.0.113.7",
+		},
+		{
+			name:       "untrusted peer cannot forward",
+			remoteAddr: "203.0.113.7:5123",
+			headers:    map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			wantIP:     "203.0.113.7",
+		},
+		{
+			name:        "trusted proxy",
+			remoteAddr:  "10.0.0.2:5123",
+			headers:     map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			wantIP:      "198.51.100.1",
+			wantProxies: "198.51.100.1",
+		},
+		{
+			name:        "client spoofed hops are skipped",
+			remoteAddr:  "10.0.0.2:5123",
+			headers:     map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.1, 10.0.0.3"},
+			wantIP:      "198.51.100.1",
+			wantProxies: "1.2.3.4, 198.51.100.1, 10.0.0.3",
+		},
+		{
+			name:        "only trusted hops",
+			remoteAddr:  "10.0.0.2:5123",
+			headers:     map[string]string{"X-Forwarded-For": "10.0.0.4, 10.0.0.3"},
+			wantIP:      "10.0.0.4",
+			wantProxies: "10.0.0.4, 10.0.0.3",
+		},
+		{
+			name:        "trusted proxy without header",
+			remoteAddr:  "10.0.0.2:5123",
+			wantIP:      "10.0.0.2",
+			wantProxies: "",
+		},
+		{
+			name:        "invalid hop stops at last trusted hop",
+			remoteAddr:  "10.0.0.2:5123",
+			headers:     map[string]string{"X-Forwarded-For": "198.51.100.1, garbage, 10.0.0.3"},
+			wantIP:      "10.0.0.3",
+			wantProxies: "198.51.100.1, garbage, 10.0.0.3",
+		},
+		{
+			name:       "forwarded header takes precedence",
+			remoteAddr: "[fd00::2]:5123",
+			headers: map[string]string{
+				"Forwarded":       `for=198.51.100.9;proto=https, for="[2001:db8::17]:4711";by=10.0.0.1`,
+				"X-Forwarded-For": "198.51.100.1",
+			},
+			wantIP:      "2001:db8::17",
+			wantProxies: `for=198.51.100.9;proto=https, for="[2001:db8::17]:4711";by=10.0.0.1`,
+		},
+		{
+			name:        "forwarded header with obfuscated hop",
+			remoteAddr:  "10.0.0.2:5123",
+			headers:     map[string]string{"Forwarded": "for=_hidden, for=10.0.0.3"},
+			wantIP:      "10.0.0.3",
+			wantProxies: "for=_hidden, for=10.0.0.3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			ip, proxies := r.Resolve(req)
+			assert.Equal(t, tt.wantIP, ip)
+			assert.Equal(t, tt.wantProxies, proxies)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package ipcountryblocker
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/health"
+)
+
+// NewHealthCheck returns the readiness check confirming the GeoLite2
+// database is loaded, without it country policies cannot be enforced.
+func NewHealthCheck(provider Provider) health.Check {
+	return health.NewCheck("geolite", func(ctx context.Context) error {
+		if !provider.IsLoaded() {
+			return errors.New("GeoLite2 database is not loaded")
+		}
+		return nil
+	})
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"maps"
 	"net"
+	"os"
 	"sync"
+	"time"
 
 	"github.com/oschwald/geoip2-golang"
 	"go.uber.org/zap"
@@ -21,14 +24,35 @@ type Provider interface {
 	// isoCode must be an ISO 3166-1 alpha-2 country code.
 	IsBlockedCountry(isoCode string) bool
 
-	// IsBlockedIP determines if an IP address originates from a blocked country.
-	// Returns false for nil IP addresses or if country lookup fails.
+	// IsBlockedIP determines if an IP address originates from a blocked country
+	// or autonomous system. Returns false for nil IP addresses or if the lookup
+	// fails.
 	IsBlockedIP(ctx context.Context, ip net.IP) bool
 
 	// GetCountryCode returns the ISO 3166-1 alpha-2 country code for an IP address.
 	// Returns an error if the lookup fails or no country is found.
 	GetCountryCode(ctx context.Context, ip net.IP) (string, error)
 
+	// GetASN returns the autonomous system number for an IP address. Returns
+	// an error if no ASN database is configured or the lookup fails.
+	GetASN(ctx context.Context, ip net.IP) (uint, error)
+
+	// RoutePolicy returns the policy of the route `pattern`.
+	RoutePolicy(pattern string) Policy
+
+	// IsBlockedByPolicy determines if an IP address is rejected by the
+	// `policy`, the banned countries or the banned ASNs and returns the reason.
+	// Private and loopback addresses are never blocked.
+	IsBlockedByPolicy(ctx context.Context, policy Policy, ip net.IP) (bool, string)
+
+	// Reload reopens the GeoLite2 databases, for example after they were
+	// updated on disk. The previous databases stay in use if opening fails.
+	Reload() error
+
+	// IsLoaded returns true if the GeoLite2 country database is open and
+	// usable for lookups.
+	IsLoaded() bool
+
 	// Close releases resources associated with the provider.
 	Close() error
 }
@@ -36,34 +60,91 @@ type Provider interface {
 // provider implements the Provider interface using MaxMind's GeoIP2 database.
 type provider struct {
 	db               *geoip2.Reader
+	asnDB            *geoip2.Reader
 	blockedCountries map[string]struct{} // Uses empty struct to optimize memory
+	blockedASNs      map[uint]struct{}
+	policies         map[string]Policy
 	logger           *zap.Logger
-	mu               sync.RWMutex // Protects concurrent access to blockedCountries
+	mu               sync.RWMutex // Protects concurrent access to the databases and blockedCountries
+
+	dbPath    string
+	asnDBPath string
+	modTimes  map[string]time.Time
+	stop      chan struct{}
+	closeOnce sync.Once
 }
 
 // NewProvider creates a new IP country blocking provider using the provided configuration.
-// It initializes the GeoIP2 database and sets up the blocked countries list.
-// Fatally crashes the entire application if the database cannot be opened.
+// It initializes the GeoIP2 databases, sets up the blocked countries list and
+// the route policies, and watches the databases for changes.
+// Fatally crashes the entire application if a database cannot be opened or
+// the policies are invalid.
 func NewProvider(cfg *config.Configuration, logger *zap.Logger) Provider {
 	db, err := geoip2.Open(cfg.App.GeoLiteDBPath)
 	if err != nil {
 		log.Fatalf("failed to open GeoLite2 DB: %v", err)
 	}
 
+	var asnDB *geoip2.Reader
+	if cfg.App.GeoLiteASNDBPath != "" {
+		asnDB, err = geoip2.Open(cfg.App.GeoLiteASNDBPath)
+		if err != nil {
+			log.Fatalf("failed to open GeoLite2 ASN DB: %v", err)
+		}
+	}
+
 	blocked := make(map[string]struct{}, len(cfg.App.BannedCountries))
 	for _, country := range cfg.App.BannedCountries {
 		blocked[country] = struct{}{}
 	}
 
+	blockedASNs, err := ParseASNs(cfg.App.BannedASNs)
+	if err != nil {
+		log.Fatalf("failed to parse banned asns: %v", err)
+	}
+	if len(blockedASNs) != 0 && asnDB == nil {
+		log.Fatalf("failed to block asns: no GeoLite2 ASN DB configured")
+	}
+
+	policies := make(map[string]Policy, len(DefaultPolicies))
+	for pattern, policy := range DefaultPolicies {
+		policies[pattern] = policy
+	}
+	if cfg.App.CountryPolicies != "" {
+		overrides, err := ParsePolicies(cfg.App.CountryPolicies)
+		if err != nil {
+			log.Fatalf("failed to parse country policies: %v", err)
+		}
+		for pattern, policy := range overrides {
+			policies[pattern] = policy
+		}
+	}
+
 	logger.Debug("ip blocker initialized",
 		zap.String("db_path", cfg.App.GeoLiteDBPath),
-		zap.Any("blocked_countries", cfg.App.BannedCountries))
+		zap.String("asn_db_path", cfg.App.GeoLiteASNDBPath),
+		zap.Any("blocked_countries", cfg.App.BannedCountries),
+		zap.Any("blocked_asns", cfg.App.BannedASNs),
+		zap.Any("policies", policies))
 
-	return &provider{
+	p := &provider{
 		db:               db,
+		asnDB:            asnDB,
 		blockedCountries: blocked,
+		blockedASNs:      blockedASNs,
+		policies:         policies,
 		logger:           logger,
+		dbPath:           cfg.App.GeoLiteDBPath,
+		asnDBPath:        cfg.App.GeoLiteASNDBPath,
+		stop:             make(chan struct{}),
+	}
+	p.modTimes = p.readModTimes()
+
+	if cfg.App.GeoLiteReloadSeconds > 0 {
+		go p.watch(time.Duration(cfg.App.GeoLiteReloadSeconds) * time.Second)
 	}
+
+	return p
 }
 
 // IsBlockedCountry checks if a country code exists in the blocked countries map.
@@ -82,6 +163,10 @@ func (p *provider) IsBlockedIP(ctx context.Context, ip net.IP) bool {
 		return false
 	}
 
+	if p.isBlockedASN(ctx, ip) {
+		return true
+	}
+
 	code, err := p.GetCountryCode(ctx, ip)
 	if err != nil {
 		// Developers Note:
@@ -106,7 +191,9 @@ func (p *provider) IsBlockedIP(ctx context.Context, ip net.IP) bool {
 // GetCountryCode performs a GeoIP2 database lookup to determine an IP's country.
 // Returns an error if the lookup fails or no country is found.
 func (p *provider) GetCountryCode(ctx context.Context, ip net.IP) (string, error) {
+	p.mu.RLock()
 	record, err := p.db.Country(ip)
+	p.mu.RUnlock()
 	if err != nil {
 		return "", fmt.Errorf("lookup country: %w", err)
 	}
@@ -118,7 +205,146 @@ func (p *provider) GetCountryCode(ctx context.Context, ip net.IP) (string, error
 	return record.Country.IsoCode, nil
 }
 
-// Close cleanly shuts down the GeoIP2 database connection.
+// GetASN performs a GeoLite2 ASN database lookup for the IP.
+func (p *provider) GetASN(ctx context.Context, ip net.IP) (uint, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.asnDB == nil {
+		return 0, fmt.Errorf("no asn database configured")
+	}
+	record, err := p.asnDB.ASN(ip)
+	if err != nil {
+		return 0, fmt.Errorf("lookup asn: %w", err)
+	}
+	if record == nil || record.AutonomousSystemNumber == 0 {
+		return 0, fmt.Errorf("no asn found for IP: %v", ip)
+	}
+	return record.AutonomousSystemNumber, nil
+}
+
+func (p *provider) isBlockedASN(ctx context.Context, ip net.IP) bool {
+	if len(p.blockedASNs) == 0 {
+		return false
+	}
+	asn, err := p.GetASN(ctx, ip)
+	if err != nil {
+		return false
+	}
+	_, blocked := p.blockedASNs[asn]
+	return blocked
+}
+
+func (p *provider) RoutePolicy(pattern string) Policy {
+	return p.policies[pattern]
+}
+
+func (p *provider) IsBlockedByPolicy(ctx context.Context, policy Policy, ip net.IP) (bool, string) {
+	if policy.Exempt || ip == nil {
+		return false, ""
+	}
+	// Internal traffic, for example from our own services, has no location.
+	if ip.IsPrivate() || ip.IsLoopback() {
+		return false, ""
+	}
+
+	if p.isBlockedASN(ctx, ip) {
+		return true, "banned asn"
+	}
+
+	// A failed lookup is an unknown country which only allow lists reject.
+	code, _ := p.GetCountryCode(ctx, ip)
+
+	p.mu.RLock()
+	reason := checkCountry(policy, code, p.blockedCountries)
+	p.mu.RUnlock()
+	return reason != "", reason
+}
+
+func (p *provider) Reload() error {
+	db, err := geoip2.Open(p.dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to open GeoLite2 DB: %w", err)
+	}
+	var asnDB *geoip2.Reader
+	if p.asnDBPath != "" {
+		asnDB, err = geoip2.Open(p.asnDBPath)
+		if err != nil {
+			db.Close()
+			return fmt.Errorf("failed to open GeoLite2 ASN DB: %w", err)
+		}
+	}
+
+	p.mu.Lock()
+	oldDB, oldASNDB := p.db, p.asnDB
+	p.db, p.asnDB = db, asnDB
+	p.mu.Unlock()
+
+	// Lookups hold the read lock so nothing uses the old databases anymore.
+	oldDB.Close()
+	if oldASNDB != nil {
+		oldASNDB.Close()
+	}
+
+	p.logger.Info("ip blocker databases reloaded",
+		zap.String("db_path", p.dbPath),
+		zap.String("asn_db_path", p.asnDBPath))
+	return nil
+}
+
+// readModTimes returns when the databases were last modified.
+func (p *provider) readModTimes() map[string]time.Time {
+	modTimes := make(map[string]time.Time, 2)
+	for _, path := range []string{p.dbPath, p.asnDBPath} {
+		if path == "" {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil {
+			modTimes[path] = info.ModTime()
+		}
+	}
+	return modTimes
+}
+
+// watch reloads the databases whenever one of the files changes, so they
+// can be updated, for example by `geoipupdate`, without restarting.
+func (p *provider) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			modTimes := p.readModTimes()
+			if maps.Equal(modTimes, p.modTimes) {
+				continue
+			}
+			if err := p.Reload(); err != nil {
+				// Keep the previous databases and retry on the next tick, the
+				// file may still be in the middle of being written.
+				p.logger.Warn("ip blocker failed reloading databases", zap.Any("err", err))
+				continue
+			}
+			p.modTimes = modTimes
+		}
+	}
+}
+
+func (p *provider) IsLoaded() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.db != nil && p.db.Metadata().BuildEpoch != 0
+}
+
+// Close cleanly shuts down the GeoIP2 database connections.
 func (p *provider) Close() error {
+	p.closeOnce.Do(func() { close(p.stop) })
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.asnDB != nil {
+		p.asnDB.Close()
+	}
 	return p.db.Close()
 }
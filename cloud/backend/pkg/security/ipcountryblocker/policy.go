@@ -0,0 +1,127 @@
+package ipcountryblocker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Policy restricts the countries which may access a route pattern. The
+// banned countries and ASNs of the configuration apply to every route which
+// is not exempt.
+type Policy struct {
+	// Exempt routes are never geo-blocked, for example health checks.
+	Exempt bool
+
+	// AllowedCountries limits access to these countries when not empty;
+	// clients whose country is unknown are rejected.
+	AllowedCountries []string
+
+	// DeniedCountries are rejected in addition to the banned countries.
+	DeniedCountries []string
+}
+
+// DefaultPolicies are the route policies used unless overridden by the
+// configuration. Health checks must keep working wherever the probes run.
+var DefaultPolicies = map[string]Policy{
+	"/healthcheck": {Exempt: true},
+	"/livez":       {Exempt: true},
+	"/readyz":      {Exempt: true},
+}
+
+// ParsePolicies parses policies in the form `pattern=allow:CA,US`,
+// `pattern=deny:RU` or `pattern=off` separated by `;`, for example
+// `POST /iam/api/v1/register=allow:CA,US;/healthcheck=off`. Entries for the
+// same pattern are merged.
+func ParsePolicies(value string) (map[string]Policy, error) {
+	policies := make(map[string]Policy)
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		pattern, rule, ok := strings.Cut(entry, "=")
+		pattern = strings.TrimSpace(pattern)
+		if !ok || pattern == "" {
+			return nil, fmt.Errorf("invalid policy entry: %q", entry)
+		}
+		policy := policies[pattern]
+		rule = strings.TrimSpace(rule)
+		if rule == "off" {
+			policy.Exempt = true
+			policies[pattern] = policy
+			continue
+		}
+		kind, countriesStr, ok := strings.Cut(rule, ":")
+		countries := parseCountries(countriesStr)
+		if !ok || len(countries) == 0 {
+			return nil, fmt.Errorf("invalid policy %q for pattern %q: expected allow:CC,CC, deny:CC,CC or off", rule, pattern)
+		}
+		switch strings.TrimSpace(kind) {
+		case "allow":
+			policy.AllowedCountries = append(policy.AllowedCountries, countries...)
+		case "deny":
+			policy.DeniedCountries = append(policy.DeniedCountries, countries...)
+		default:
+			return nil, fmt.Errorf("invalid policy %q for pattern %q: expected allow, deny or off", rule, pattern)
+		}
+		policies[pattern] = policy
+	}
+	return policies, nil
+}
+
+func parseCountries(value string) []string {
+	var countries []string
+	for _, country := range strings.Split(value, ",") {
+		if country = strings.ToUpper(strings.TrimSpace(country)); country != "" {
+			countries = append(countries, country)
+		}
+	}
+	return countries
+}
+
+// ParseASNs parses autonomous system numbers with or without the `AS`
+// prefix, for example `AS64496` or `64496`. Empty values are skipped.
+func ParseASNs(values []string) (map[uint]struct{}, error) {
+	asns := make(map[uint]struct{}, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		asn, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(value), "AS"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid asn: %q", value)
+		}
+		asns[uint(asn)] = struct{}{}
+	}
+	return asns, nil
+}
+
+// checkCountry returns the reason the `country` is rejected by the `policy`
+// or an empty string if it is allowed. An empty `country` is unknown.
+func checkCountry(policy Policy, country string, bannedCountries map[string]struct{}) string {
+	if policy.Exempt {
+		return ""
+	}
+	if _, banned := bannedCountries[country]; banned && country != "" {
+		return "banned country"
+	}
+	for _, denied := range policy.DeniedCountries {
+		if denied == country {
+			return "denied country"
+		}
+	}
+	if len(policy.AllowedCountries) == 0 {
+		return ""
+	}
+	for _, allowed := range policy.AllowedCountries {
+		if allowed == country {
+			return ""
+		}
+	}
+	if country == "" {
+		return "unknown country"
+	}
+	return "country not allowed"
+}
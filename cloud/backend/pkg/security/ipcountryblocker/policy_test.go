@@ -0,0 +1,93 @@
+package ipcountryblocker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]Policy
+		wantErr bool
+	}{
+		{
+			name:  "allow list",
+			value: "POST /iam/api/v1/register=allow:ca, us",
+			want:  map[string]Policy{"POST /iam/api/v1/register": {AllowedCountries: []string{"CA", "US"}}},
+		},
+		{
+			name:  "merged entries and exemption",
+			value: " GET /share=allow:CA ; GET /share=deny:RU;/healthcheck=off;",
+			want: map[string]Policy{
+				"GET /share":   {AllowedCountries: []string{"CA"}, DeniedCountries: []string{"RU"}},
+				"/healthcheck": {Exempt: true},
+			},
+		},
+		{
+			name:    "missing separator",
+			value:   "GET /share",
+			wantErr: true,
+		},
+		{
+			name:    "missing countries",
+			value:   "GET /share=allow:",
+			wantErr: true,
+		},
+		{
+			name:    "unknown rule",
+			value:   "GET /share=block:RU",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePolicies(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseASNs(t *testing.T) {
+	asns, err := ParseASNs([]string{"AS64496", " 64497 ", "", "as64498"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint]struct{}{64496: {}, 64497: {}, 64498: {}}, asns)
+
+	_, err = ParseASNs([]string{"ASX"})
+	assert.Error(t, err)
+}
+
+func TestCheckCountry(t *testing.T) {
+	banned := map[string]struct{}{"KP": {}, "": {}}
+
+	tests := []struct {
+		name    string
+		policy  Policy
+		country string
+		want    string
+	}{
+		{name: "default allows", country: "CA", want: ""},
+		{name: "default allows unknown", country: "", want: ""},
+		{name: "banned everywhere", country: "KP", want: "banned country"},
+		{name: "exempt ignores bans", policy: Policy{Exempt: true}, country: "KP", want: ""},
+		{name: "denied by route", policy: Policy{DeniedCountries: []string{"RU"}}, country: "RU", want: "denied country"},
+		{name: "allowed by route", policy: Policy{AllowedCountries: []string{"CA", "US"}}, country: "US", want: ""},
+		{name: "not in allow list", policy: Policy{AllowedCountries: []string{"CA"}}, country: "FR", want: "country not allowed"},
+		{name: "unknown with allow list", policy: Policy{AllowedCountries: []string{"CA"}}, country: "", want: "unknown country"},
+		{name: "banned wins over allow list", policy: Policy{AllowedCountries: []string{"KP"}}, country: "KP", want: "banned country"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, checkCountry(tt.policy, tt.country, banned))
+		})
+	}
+}
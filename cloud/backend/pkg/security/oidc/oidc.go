@@ -0,0 +1,169 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+)
+
+// PKCE code challenge methods, see RFC 7636. Only `S256` is accepted as the
+// `plain` method offers no protection against intercepted codes.
+const (
+	CodeChallengeMethodS256 = "S256"
+)
+
+// JSONWebKey is the public part of a signing key as published in the JWKS
+// document, see RFC 7517.
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	Modulus   string `json:"n"`
+	Exponent  string `json:"e"`
+}
+
+// JSONWebKeySet is the document served by the JWKS endpoint.
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// Provider provides interface for signing OpenID Connect ID tokens and
+// publishing the key relying parties verify them with.
+type Provider interface {
+	Issuer() string
+	SignIDToken(claims jwt.MapClaims) (string, error)
+	ParseIDToken(token string) (jwt.MapClaims, error)
+	JWKS() *JSONWebKeySet
+}
+
+type provider struct {
+	issuer string
+	keyID  string
+	key    *rsa.PrivateKey
+}
+
+// NewProvider Constructor that returns the ID token signer. The RSA key is
+// read from `BACKEND_OIDC_SIGNING_KEY_PATH`; when it is not set an ephemeral
+// key is generated which invalidates every issued ID token on restart.
+func NewProvider(cfg *config.Configuration, logger *zap.Logger) Provider {
+	var key *rsa.PrivateKey
+	var err error
+	if cfg.OIDC.SigningKeyPath != "" {
+		key, err = loadPrivateKey(cfg.OIDC.SigningKeyPath)
+		if err != nil {
+			log.Fatalf("failed loading oidc signing key: %v", err)
+		}
+	} else {
+		logger.Warn("No OIDC signing key configured, generating an ephemeral key")
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			log.Fatalf("failed generating oidc signing key: %v", err)
+		}
+	}
+	return newProvider(cfg.OIDC.Issuer, key)
+}
+
+func newProvider(issuer string, key *rsa.PrivateKey) Provider {
+	// The key ID is derived from the public key so it only changes when the
+	// key is rotated.
+	der, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	sum := sha256.Sum256(der)
+	return &provider{
+		issuer: issuer,
+		keyID:  base64.RawURLEncoding.EncodeToString(sum[:16]),
+		key:    key,
+	}
+}
+
+func loadPrivateKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		rsaKey, ok := k.(*rsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("private key is not an RSA key")
+		}
+		return rsaKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type: %s", block.Type)
+	}
+}
+
+func (p *provider) Issuer() string {
+	return p.issuer
+}
+
+// SignIDToken signs `claims` with RS256; the issuer is always set to ours.
+func (p *provider) SignIDToken(claims jwt.MapClaims) (string, error) {
+	claims["iss"] = p.issuer
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = p.keyID
+	return token.SignedString(p.key)
+}
+
+// ParseIDToken verifies the signature, expiry and issuer of an ID token we
+// issued and returns its claims.
+func (p *provider) ParseIDToken(tokenString string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return &p.key.PublicKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}), jwt.WithIssuer(p.issuer))
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (p *provider) JWKS() *JSONWebKeySet {
+	return &JSONWebKeySet{
+		Keys: []JSONWebKey{
+			{
+				KeyType:   "RSA",
+				Use:       "sig",
+				Algorithm: jwt.SigningMethodRS256.Alg(),
+				KeyID:     p.keyID,
+				Modulus:   base64.RawURLEncoding.EncodeToString(p.key.PublicKey.N.Bytes()),
+				Exponent:  base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.PublicKey.E)).Bytes()),
+			},
+		},
+	}
+}
+
+// VerifyPKCE returns true if `verifier` matches the `challenge` sent with the
+// authorization request.
+func VerifyPKCE(method, challenge, verifier string) bool {
+	if method != CodeChallengeMethodS256 || challenge == "" || verifier == "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
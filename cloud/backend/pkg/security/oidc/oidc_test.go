@@ -0,0 +1,115 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupTestProvider(t *testing.T) Provider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	return newProvider("https://auth.example.com", key)
+}
+
+func TestSignAndParseIDToken(t *testing.T) {
+	provider := setupTestProvider(t)
+
+	token, err := provider.SignIDToken(jwt.MapClaims{
+		"sub":   "user-1",
+		"aud":   "client-1",
+		"nonce": "abc",
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+	assert.NoError(t, err)
+
+	claims, err := provider.ParseIDToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://auth.example.com", claims["iss"])
+	assert.Equal(t, "user-1", claims["sub"])
+	assert.Equal(t, "abc", claims["nonce"])
+}
+
+func TestParseIDToken_Expired(t *testing.T) {
+	provider := setupTestProvider(t)
+
+	token, err := provider.SignIDToken(jwt.MapClaims{
+		"sub": "user-1",
+		"exp": time.Now().Add(-time.Minute).Unix(),
+	})
+	assert.NoError(t, err)
+
+	_, err = provider.ParseIDToken(token)
+	assert.Error(t, err)
+}
+
+func TestParseIDToken_OtherKey(t *testing.T) {
+	provider := setupTestProvider(t)
+	other := setupTestProvider(t)
+
+	token, err := other.SignIDToken(jwt.MapClaims{
+		"sub": "user-1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	assert.NoError(t, err)
+
+	_, err = provider.ParseIDToken(token)
+	assert.Error(t, err)
+}
+
+func TestJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	provider := newProvider("https://auth.example.com", key)
+
+	jwks := provider.JWKS()
+	assert.Len(t, jwks.Keys, 1)
+
+	jwk := jwks.Keys[0]
+	assert.Equal(t, "RSA", jwk.KeyType)
+	assert.Equal(t, "RS256", jwk.Algorithm)
+	assert.NotEmpty(t, jwk.KeyID)
+
+	n, err := base64.RawURLEncoding.DecodeString(jwk.Modulus)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, new(big.Int).SetBytes(n).Cmp(key.PublicKey.N))
+
+	e, err := base64.RawURLEncoding.DecodeString(jwk.Exponent)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(key.PublicKey.E), new(big.Int).SetBytes(e).Int64())
+
+	// The key ID must be stable for the same key.
+	assert.Equal(t, jwk.KeyID, newProvider("https://auth.example.com", key).JWKS().Keys[0].KeyID)
+}
+
+func TestVerifyPKCE(t *testing.T) {
+	// Example from RFC 7636, Appendix B.
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	challenge := "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+	tests := []struct {
+		name      string
+		method    string
+		challenge string
+		verifier  string
+		want      bool
+	}{
+		{"valid S256", CodeChallengeMethodS256, challenge, verifier, true},
+		{"wrong verifier", CodeChallengeMethodS256, challenge, verifier + "x", false},
+		{"plain method is rejected", "plain", verifier, verifier, false},
+		{"missing challenge", CodeChallengeMethodS256, "", verifier, false},
+		{"missing verifier", CodeChallengeMethodS256, challenge, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, VerifyPKCE(tt.method, tt.challenge, tt.verifier))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// memorySweepInterval is how often expired counters are removed so the
+// limiter does not grow without bound.
+const memorySweepInterval = time.Minute
+
+// memoryLimiter is a generic cell rate algorithm (GCRA) token bucket which
+// keeps the theoretical arrival time of every key in memory.
+type memoryLimiter struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	tats      map[string]time.Time
+	lastSweep time.Time
+}
+
+func newMemoryLimiter(now func() time.Time) *memoryLimiter {
+	return &memoryLimiter{
+		now:       now,
+		tats:      make(map[string]time.Time),
+		lastSweep: now(),
+	}
+}
+
+func (l *memoryLimiter) allow(key string, policy Policy) *Result {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) >= memorySweepInterval {
+		for k, tat := range l.tats {
+			if !tat.After(now) {
+				delete(l.tats, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	tat, ok := l.tats[key]
+	if !ok || tat.Before(now) {
+		tat = now
+	}
+	newTAT := tat.Add(policy.interval())
+	allowAt := newTAT.Add(-policy.Window)
+	if now.Before(allowAt) {
+		return newResult(policy, false, allowAt.Sub(now), tat.Sub(now))
+	}
+	l.tats[key] = newTAT
+	return newResult(policy, true, 0, newTAT.Sub(now))
+}
+
+// newResult returns the result of a request where `resetAfter` is how long
+// until the bucket of the key is full again.
+func newResult(policy Policy, allowed bool, retryAfter, resetAfter time.Duration) *Result {
+	remaining := 0
+	if allowed {
+		remaining = int((policy.Window - resetAfter) / policy.interval())
+	}
+	return &Result{
+		Allowed:    allowed,
+		Limit:      policy.Limit,
+		Remaining:  remaining,
+		ResetAfter: resetAfter,
+		RetryAfter: retryAfter,
+	}
+}
@@ -0,0 +1,260 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+)
+
+// DefaultPolicyPattern is the pattern of the policy used for routes without
+// a policy of their own.
+const DefaultPolicyPattern = "default"
+
+// redisRetryDelay is how long the in-memory limiter is used after Redis
+// failed before Redis is tried again.
+const redisRetryDelay = 30 * time.Second
+
+// Policy allows a burst of `Limit` requests which refills evenly over
+// `Window`. A zero `Limit` disables rate limiting.
+type Policy struct {
+	Limit  int
+	Window time.Duration
+}
+
+// IsUnlimited returns true if the policy does not limit requests.
+func (p Policy) IsUnlimited() bool {
+	return p.Limit <= 0 || p.Window <= 0
+}
+
+// interval returns how long it takes for a single request to be refilled.
+func (p Policy) interval() time.Duration {
+	return p.Window / time.Duration(p.Limit)
+}
+
+// DefaultClientPolicies limit the requests from a single client IP address
+// per route pattern. Authentication endpoints are much stricter as they are
+// the target of credential stuffing and email flooding.
+var DefaultClientPolicies = map[string]Policy{
+	DefaultPolicyPattern:                              {Limit: 300, Window: time.Minute},
+	"POST /iam/api/v1/register":                       {Limit: 5, Window: time.Minute},
+	"POST /iam/api/v1/verify-email-code":              {Limit: 10, Window: time.Minute},
+	"POST /iam/api/v1/request-login-ott":              {Limit: 5, Window: time.Minute},
+	"POST /iam/api/v1/verify-login-ott":               {Limit: 10, Window: time.Minute},
+	"POST /iam/api/v1/verify-login-magic-link":        {Limit: 10, Window: time.Minute},
+	"POST /iam/api/v1/complete-login":                 {Limit: 10, Window: time.Minute},
+	"POST /iam/api/v1/token/refresh":                  {Limit: 30, Window: time.Minute},
+	"POST /iam/api/v1/forgot-password":                {Limit: 5, Window: time.Minute},
+	"POST /iam/api/v1/verify-recovery":                {Limit: 10, Window: time.Minute},
+	"POST /iam/api/v1/reset-password":                 {Limit: 5, Window: time.Minute},
+	"POST /iam/api/v1/change-password/challenge":      {Limit: 10, Window: time.Minute},
+	"POST /iam/api/v1/change-password":                {Limit: 5, Window: time.Minute},
+	"POST /iam/api/v1/change-email":                   {Limit: 5, Window: time.Minute},
+	"POST /iam/api/v1/change-email/verify":            {Limit: 10, Window: time.Minute},
+	"POST /iam/api/v1/change-email/cancel":            {Limit: 10, Window: time.Minute},
+	"POST /iam/api/v1/disown-login":                   {Limit: 10, Window: time.Minute},
+	"GET /vault/api/v1/encrypted-files/{id}/download": {Limit: 600, Window: time.Minute},
+	"GET /vault/api/v1/encrypted-files/{id}/url":      {Limit: 600, Window: time.Minute},
+}
+
+// DefaultUserPolicies limit the requests of a single authenticated user per
+// route pattern, regardless of how many addresses they connect from.
+var DefaultUserPolicies = map[string]Policy{
+	DefaultPolicyPattern:                              {Limit: 600, Window: time.Minute},
+	"POST /iam/api/v1/change-password/challenge":      {Limit: 10, Window: time.Minute},
+	"POST /iam/api/v1/change-password":                {Limit: 5, Window: time.Minute},
+	"POST /iam/api/v1/change-email":                   {Limit: 5, Window: time.Minute},
+	"GET /vault/api/v1/encrypted-files/{id}/download": {Limit: 1200, Window: time.Minute},
+	"GET /vault/api/v1/encrypted-files/{id}/url":      {Limit: 1200, Window: time.Minute},
+}
+
+// Result describes the outcome of a rate limited request.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+
+	// ResetAfter is how long until the full limit is available again.
+	ResetAfter time.Duration
+
+	// RetryAfter is how long until the next request is allowed, it is zero
+	// if the request was allowed.
+	RetryAfter time.Duration
+}
+
+// Provider provides interface for limiting the rate of requests.
+type Provider interface {
+	// ClientPolicy returns the policy for requests from a single client IP
+	// address to the route `pattern`.
+	ClientPolicy(pattern string) Policy
+
+	// UserPolicy returns the policy for requests of a single authenticated
+	// user to the route `pattern`.
+	UserPolicy(pattern string) Policy
+
+	// Allow counts a request against `key` and reports if it is within the
+	// `policy`. Requests are always allowed by an unlimited policy.
+	Allow(ctx context.Context, key string, policy Policy) *Result
+
+	// Close releases resources associated with the provider.
+	Close() error
+}
+
+type provider struct {
+	logger         *zap.Logger
+	enabled        bool
+	clientPolicies map[string]Policy
+	userPolicies   map[string]Policy
+	redis          redis.UniversalClient
+	memory         *memoryLimiter
+
+	mu             sync.Mutex
+	redisDownUntil time.Time
+}
+
+// NewProvider Constructor that returns the rate limiter. Counters are kept in
+// Redis so limits are shared between instances; if Redis is disabled or
+// unavailable the counters fall back to memory.
+func NewProvider(cfg *config.Configuration, logger *zap.Logger, redisClient redis.UniversalClient) Provider {
+	clientPolicies := mergePolicies(DefaultClientPolicies, cfg.App.RateLimitClientPolicies)
+	userPolicies := mergePolicies(DefaultUserPolicies, cfg.App.RateLimitUserPolicies)
+
+	p := &provider{
+		logger:         logger,
+		enabled:        cfg.App.RateLimitEnabled,
+		clientPolicies: clientPolicies,
+		userPolicies:   userPolicies,
+		memory:         newMemoryLimiter(time.Now),
+	}
+	if cfg.App.RateLimitUseRedis {
+		p.redis = redisClient
+	}
+
+	logger.Debug("rate limiter initialized",
+		zap.Bool("enabled", p.enabled),
+		zap.Bool("redis", p.redis != nil),
+		zap.Any("client_policies", clientPolicies),
+		zap.Any("user_policies", userPolicies))
+
+	return p
+}
+
+// mergePolicies returns the `defaults` with the overrides parsed from `value`.
+func mergePolicies(defaults map[string]Policy, value string) map[string]Policy {
+	policies := make(map[string]Policy, len(defaults))
+	for pattern, policy := range defaults {
+		policies[pattern] = policy
+	}
+	if value == "" {
+		return policies
+	}
+	overrides, err := ParsePolicies(value)
+	if err != nil {
+		log.Fatalf("failed to parse rate limit policies: %v", err)
+	}
+	for pattern, policy := range overrides {
+		policies[pattern] = policy
+	}
+	return policies
+}
+
+func (p *provider) ClientPolicy(pattern string) Policy {
+	return p.policy(p.clientPolicies, pattern)
+}
+
+func (p *provider) UserPolicy(pattern string) Policy {
+	return p.policy(p.userPolicies, pattern)
+}
+
+func (p *provider) policy(policies map[string]Policy, pattern string) Policy {
+	if !p.enabled {
+		return Policy{}
+	}
+	if policy, ok := policies[pattern]; ok {
+		return policy
+	}
+	return policies[DefaultPolicyPattern]
+}
+
+func (p *provider) Allow(ctx context.Context, key string, policy Policy) *Result {
+	if policy.IsUnlimited() {
+		return &Result{Allowed: true}
+	}
+
+	if p.redis != nil && p.isRedisAvailable() {
+		res, err := allowRedis(ctx, p.redis, key, policy)
+		if err == nil {
+			return res
+		}
+		p.logger.Warn("rate limiter failed using redis, falling back to memory",
+			zap.Any("err", err),
+			zap.Duration("retry_in", redisRetryDelay))
+		p.mu.Lock()
+		p.redisDownUntil = time.Now().Add(redisRetryDelay)
+		p.mu.Unlock()
+	}
+
+	return p.memory.allow(key, policy)
+}
+
+func (p *provider) isRedisAvailable() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return time.Now().After(p.redisDownUntil)
+}
+
+// Close is a no-op as the Redis client is shared and closed with the
+// application.
+func (p *provider) Close() error {
+	return nil
+}
+
+// ClientKey returns the key counting requests from `ipAddress` to `pattern`.
+func ClientKey(pattern, ipAddress string) string {
+	return fmt.Sprintf("ratelimit:client:%s:%s", pattern, ipAddress)
+}
+
+// UserKey returns the key counting requests of `userID` to `pattern`.
+func UserKey(pattern, userID string) string {
+	return fmt.Sprintf("ratelimit:user:%s:%s", pattern, userID)
+}
+
+// ParsePolicies parses policies in the form
+// `pattern=limit/seconds;pattern=limit/seconds`, for example
+// `default=300/60;POST /iam/api/v1/request-login-ott=5/60`.
+func ParsePolicies(value string) (map[string]Policy, error) {
+	policies := make(map[string]Policy)
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		pattern, policyStr, ok := strings.Cut(entry, "=")
+		pattern = strings.TrimSpace(pattern)
+		if !ok || pattern == "" {
+			return nil, fmt.Errorf("invalid policy entry: %q", entry)
+		}
+		limitStr, secondsStr, ok := strings.Cut(strings.TrimSpace(policyStr), "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid policy %q for pattern %q: expected limit/seconds", policyStr, pattern)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(limitStr))
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid limit %q for pattern %q", limitStr, pattern)
+		}
+		seconds, err := strconv.Atoi(strings.TrimSpace(secondsStr))
+		if err != nil || seconds <= 0 {
+			return nil, fmt.Errorf("invalid window %q for pattern %q", secondsStr, pattern)
+		}
+		policies[pattern] = Policy{Limit: limit, Window: time.Duration(seconds) * time.Second}
+	}
+	return policies, nil
+}
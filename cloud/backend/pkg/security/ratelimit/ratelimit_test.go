@@ -0,0 +1,135 @@
+package ratelimit
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]Policy
+		wantErr bool
+	}{
+		{
+			name:  "single policy",
+			value: "default=300/60",
+			want:  map[string]Policy{"default": {Limit: 300, Window: time.Minute}},
+		},
+		{
+			name:  "route patterns with spaces",
+			value: " default=300/60 ; POST /iam/api/v1/request-login-ott = 5 / 600;",
+			want: map[string]Policy{
+				"default":                            {Limit: 300, Window: time.Minute},
+				"POST /iam/api/v1/request-login-ott": {Limit: 5, Window: 10 * time.Minute},
+			},
+		},
+		{
+			name:  "zero limit disables",
+			value: "GET /vault/api/v1/encrypted-files=0/60",
+			want:  map[string]Policy{"GET /vault/api/v1/encrypted-files": {Limit: 0, Window: time.Minute}},
+		},
+		{
+			name:    "missing separator",
+			value:   "default",
+			wantErr: true,
+		},
+		{
+			name:    "missing window",
+			value:   "default=300",
+			wantErr: true,
+		},
+		{
+			name:    "invalid limit",
+			value:   "default=many/60",
+			wantErr: true,
+		},
+		{
+			name:    "invalid window",
+			value:   "default=300/0",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePolicies(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMemoryLimiter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newMemoryLimiter(func() time.Time { return now })
+	policy := Policy{Limit: 3, Window: 3 * time.Second}
+
+	// The full burst is allowed.
+	for i := 2; i >= 0; i-- {
+		res := l.allow("key", policy)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, 3, res.Limit)
+		assert.Equal(t, i, res.Remaining)
+	}
+
+	// The bucket is empty so the next request must wait for one refill.
+	res := l.allow("key", policy)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	assert.Equal(t, 3*time.Second, res.ResetAfter)
+
+	// Other keys are counted separately.
+	assert.True(t, l.allow("other", policy).Allowed)
+
+	// A single request is refilled after one interval.
+	now = now.Add(time.Second)
+	res = l.allow("key", policy)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+	assert.False(t, l.allow("key", policy).Allowed)
+}
+
+func TestMemoryLimiterSweepsExpiredKeys(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newMemoryLimiter(func() time.Time { return now })
+	policy := Policy{Limit: 10, Window: time.Second}
+
+	l.allow("a", policy)
+	l.allow("b", policy)
+	assert.Len(t, l.tats, 2)
+
+	now = now.Add(memorySweepInterval)
+	l.allow("c", policy)
+	assert.Len(t, l.tats, 1)
+}
+
+func TestWriteHeaders(t *testing.T) {
+	policy := Policy{Limit: 5, Window: time.Minute}
+
+	w := httptest.NewRecorder()
+	WriteHeaders(w, policy, &Result{Allowed: true, Limit: 5, Remaining: 4, ResetAfter: 12 * time.Second})
+	assert.Equal(t, "5;w=60", w.Header().Get("RateLimit-Policy"))
+	assert.Equal(t, "5", w.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "4", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "12", w.Header().Get("RateLimit-Reset"))
+	assert.Empty(t, w.Header().Get("Retry-After"))
+
+	w = httptest.NewRecorder()
+	WriteHeaders(w, policy, &Result{Allowed: false, Limit: 5, ResetAfter: time.Minute, RetryAfter: 11500 * time.Millisecond})
+	assert.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "12", w.Header().Get("Retry-After"))
+
+	w = httptest.NewRecorder()
+	WriteHeaders(w, Policy{}, &Result{Allowed: true})
+	assert.Empty(t, w.Header())
+}
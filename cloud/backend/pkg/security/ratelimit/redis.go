@@ -0,0 +1,47 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// gcraScript is the Redis version of `memoryLimiter.allow`. It uses the Redis
+// clock so every instance agrees on the time, all durations are microseconds.
+var gcraScript = redis.NewScript(`
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+local interval = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+
+local tat = tonumber(redis.call("GET", KEYS[1])) or now
+if tat < now then
+	tat = now
+end
+
+local new_tat = tat + interval
+local allow_at = new_tat - window
+if now < allow_at then
+	return {0, allow_at - now, tat - now}
+end
+
+local ttl = math.max(1, math.ceil((new_tat - now) / 1000))
+redis.call("SET", KEYS[1], string.format("%.0f", new_tat), "PX", ttl)
+return {1, 0, new_tat - now}
+`)
+
+func allowRedis(ctx context.Context, client redis.UniversalClient, key string, policy Policy) (*Result, error) {
+	vals, err := gcraScript.Run(ctx, client, []string{key},
+		policy.interval().Microseconds(),
+		policy.Window.Microseconds(),
+	).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) != 3 {
+		return nil, fmt.Errorf("unexpected rate limit script result: %v", vals)
+	}
+	return newResult(policy, vals[0] == 1, time.Duration(vals[1])*time.Microsecond, time.Duration(vals[2])*time.Microsecond), nil
+}
@@ -0,0 +1,18 @@
+package mongodb
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/health"
+)
+
+// NewHealthCheck returns the readiness check pinging the primary, which
+// receives every write.
+func NewHealthCheck(client *mongo.Client) health.Check {
+	return health.NewCheck("mongodb", func(ctx context.Context) error {
+		return client.Ping(ctx, readpref.Primary())
+	})
+}
@@ -10,6 +10,8 @@ import (
 	"go.uber.org/zap"
 
 	c "github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 func NewProvider(appCfg *c.Configuration, logger *zap.Logger) *mongo.Client {
@@ -18,7 +20,10 @@ func NewProvider(appCfg *c.Configuration, logger *zap.Logger) *mongo.Client {
 	// DEVELOPERS NOTE:
 	// If you uncommented the ABOVE code then comment out the BOTTOM code.
 	// - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
-	client, err := mongo.Connect(options.Client().ApplyURI(appCfg.DB.URI))
+	client, err := mongo.Connect(options.Client().
+		ApplyURI(appCfg.DB.URI).
+		SetPoolMonitor(metrics.NewMongoPoolMonitor()).
+		SetMonitor(tracing.NewMongoCommandMonitor()))
 	// - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - - -
 
 	if err != nil {
@@ -32,3 +37,23 @@ func NewProvider(appCfg *c.Configuration, logger *zap.Logger) *mongo.Client {
 	logger.Debug("storage initialized successfully")
 	return client
 }
+
+// WithoutSession returns `ctx` without its session, so the writes through
+// it persist even if the transaction of the session is aborted, like the
+// audit events of the failures returning an error. The other values, like
+// the request ID or the client IP address, are kept.
+func WithoutSession(ctx context.Context) context.Context {
+	return withoutSessionContext{ctx}
+}
+
+type withoutSessionContext struct {
+	context.Context
+}
+
+func (ctx withoutSessionContext) Value(key any) any {
+	val := ctx.Context.Value(key)
+	if _, ok := val.(*mongo.Session); ok {
+		return nil
+	}
+	return val
+}
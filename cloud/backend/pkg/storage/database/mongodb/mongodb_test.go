@@ -0,0 +1,32 @@
+package mongodb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type testKey struct{}
+
+func TestWithoutSession(t *testing.T) {
+	// Sessions are created client side, no server is needed.
+	client, err := mongo.Connect(options.Client().ApplyURI("mongodb://127.0.0.1:1"))
+	require.NoError(t, err)
+	defer client.Disconnect(context.Background())
+
+	session, err := client.StartSession()
+	require.NoError(t, err)
+	defer session.EndSession(context.Background())
+
+	ctx := context.WithValue(context.Background(), testKey{}, "value")
+	ctx = mongo.NewSessionContext(ctx, session)
+	require.NotNil(t, mongo.SessionFromContext(ctx))
+
+	detached := WithoutSession(ctx)
+	assert.Nil(t, mongo.SessionFromContext(detached))
+	assert.Equal(t, "value", detached.Value(testKey{}))
+}
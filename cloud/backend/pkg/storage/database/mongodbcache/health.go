@@ -0,0 +1,34 @@
+package mongodbcache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/health"
+)
+
+// NewHealthCheck returns the readiness check writing, reading and deleting a
+// short-lived value in the cache.
+func NewHealthCheck(cache Cacher) health.Check {
+	return health.NewCheck("mongodbcache", func(ctx context.Context) error {
+		key := "healthcheck:" + uuid.NewString()
+		val := []byte(key)
+		if err := cache.SetWithExpiry(ctx, key, val, time.Minute); err != nil {
+			return err
+		}
+		defer cache.Delete(ctx, key)
+
+		got, err := cache.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(got, val) {
+			return errors.New("cache returned a different value")
+		}
+		return nil
+	})
+}
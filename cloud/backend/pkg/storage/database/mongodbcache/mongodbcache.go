@@ -2,6 +2,7 @@ package mongodbcache
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/faabiosr/cachego"
@@ -10,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	c "github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
 )
 
 type Cacher interface {
@@ -70,9 +72,15 @@ func (s *cacheImpl) Shutdown(context.Context) {
 func (s *cacheImpl) Get(ctx context.Context, key string) ([]byte, error) {
 	val, err := s.Client.Fetch(key)
 	if err != nil {
+		if errors.Is(err, cachego.ErrCacheExpired) || errors.Is(err, mongo_client.ErrNoDocuments) {
+			metrics.ObserveCacheLookup(metrics.CacheMongoDB, metrics.CacheResultMiss)
+		} else {
+			metrics.ObserveCacheLookup(metrics.CacheMongoDB, metrics.CacheResultError)
+		}
 		s.Logger.Error("cache get failed", zap.Any("error", err))
 		return nil, err
 	}
+	metrics.ObserveCacheLookup(metrics.CacheMongoDB, metrics.CacheResultHit)
 	return []byte(val), nil
 }
 
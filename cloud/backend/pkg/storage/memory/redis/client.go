@@ -0,0 +1,40 @@
+package redis
+
+import (
+	"context"
+	"log"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+
+	c "github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
+)
+
+// NewUniversalClient Constructor that returns the Redis client shared by the
+// packages which use Redis directly, for example the rate limiter. Unlike
+// `NewCache` the connection is not confirmed on startup so the callers are
+// expected to handle Redis being unavailable. The client is closed when the
+// application stops.
+func NewUniversalClient(lc fx.Lifecycle, cfg *c.Configuration, logger *zap.Logger) redis.UniversalClient {
+	opt, err := redis.ParseURL(cfg.Cache.URI)
+	if err != nil {
+		logger.Error("redis client failed parsing url", zap.Any("err", err))
+		log.Fatal(err)
+	}
+	rdb := redis.NewClient(opt)
+	if err := tracing.InstrumentRedis(rdb); err != nil {
+		logger.Error("redis client failed instrumenting tracing", zap.Any("err", err))
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			logger.Debug("redis client closing...")
+			return rdb.Close()
+		},
+	})
+
+	logger.Debug("redis client initialized")
+	return rdb
+}
@@ -0,0 +1,17 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/health"
+)
+
+// NewHealthCheck returns the readiness check pinging Redis, which holds the
+// rate limits, the automatic bans and the distributed locks.
+func NewHealthCheck(client redis.UniversalClient) health.Check {
+	return health.NewCheck("redis", func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	})
+}
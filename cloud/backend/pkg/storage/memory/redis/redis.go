@@ -10,6 +10,8 @@ import (
 	"go.uber.org/zap"
 
 	c "github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 type Cacher interface {
@@ -37,6 +39,9 @@ func NewCache(cfg *c.Configuration, logger *zap.Logger) Cacher {
 		log.Fatal(err)
 	}
 	rdb := redis.NewClient(opt)
+	if err := tracing.InstrumentRedis(rdb); err != nil {
+		logger.Error("cache failed instrumenting tracing", zap.Any("err", err))
+	}
 
 	// Confirm connection with Redis
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second) // 5-second timeout for initialization
@@ -86,12 +91,15 @@ func (s *cache) Shutdown(ctx context.Context) {
 func (s *cache) Get(ctx context.Context, key string) ([]byte, error) {
 	val, err := s.Client.Get(ctx, key).Result()
 	if errors.Is(err, redis.Nil) {
+		metrics.ObserveCacheLookup(metrics.CacheRedis, metrics.CacheResultMiss)
 		return nil, nil // Key does not exist
 	}
 	if err != nil {
+		metrics.ObserveCacheLookup(metrics.CacheRedis, metrics.CacheResultError)
 		s.Logger.Error("cache get failed", zap.Any("error", err))
 		return nil, err
 	}
+	metrics.ObserveCacheLookup(metrics.CacheRedis, metrics.CacheResultHit)
 	return []byte(val), nil
 }
 
@@ -0,0 +1,24 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/config"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/health"
+)
+
+// NewHealthCheck returns the readiness check confirming the bucket exists
+// and is reachable with our credentials.
+func NewHealthCheck(cfg *config.Configuration, storage S3ObjectStorage) health.Check {
+	return health.NewCheck("s3", func(ctx context.Context) error {
+		exists, err := storage.BucketExists(ctx, cfg.AWS.BucketName)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("bucket %q does not exist", cfg.AWS.BucketName)
+		}
+		return nil
+	})
+}
@@ -18,6 +18,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
 	"go.uber.org/zap"
+
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/metrics"
+	"github.com/Maple-Open-Tech/monorepo/cloud/backend/pkg/observability/tracing"
 )
 
 // ACL constants for public and private objects
@@ -80,7 +83,10 @@ func NewObjectStorage(s3Config S3ObjectStorageConfigurationProvider, logger *zap
 	}
 
 	// STEP 3\: Load up s3 instance.
-	s3Client := s3.NewFromConfig(sdkConfig)
+	s3Client := s3.NewFromConfig(sdkConfig, func(o *s3.Options) {
+		o.APIOptions = append(o.APIOptions, metrics.AddAWSMiddleware)
+		o.TracerProvider = tracing.AWSTracerProvider()
+	})
 
 	// Create our storage handler.
 	s3Storage := &s3ObjectStorage{
@@ -0,0 +1,57 @@
+// native/desktop/papercloud-cli/cmd/remote/changepassword.go
+package remote
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+)
+
+func ChangePasswordCmd() *cobra.Command {
+	var currentPassword, newPassword string
+
+	var cmd = &cobra.Command{
+		Use:   "change-password",
+		Short: "Change your password",
+		Long: `
+Change the password of the currently logged in account. Your master key is
+unlocked locally with the current password and re-encrypted with a key
+derived from the new password; the server never sees either password.
+All other logged in sessions are signed out.
+
+Examples:
+  # Change the password
+  papercloud-cli remote change-password --current-password oldpass --new-password newpass
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			fmt.Println("Changing password...")
+
+			if currentPassword == "" || newPassword == "" {
+				log.Fatal("Current password and new password are required")
+			}
+
+			client := createE2EEClient()
+			if !client.IsAuthenticated() {
+				log.Fatal("You are not logged in. Please login first")
+			}
+
+			if err := client.ChangePassword(currentPassword, newPassword); err != nil {
+				log.Fatalf("Failed to change password: %v", err)
+			}
+
+			fmt.Println("Password changed successfully!")
+			fmt.Println("Other sessions have been signed out.")
+		},
+	}
+
+	// Define command flags
+	cmd.Flags().StringVarP(&currentPassword, "current-password", "c", "", "Current password (required)")
+	cmd.Flags().StringVarP(&newPassword, "new-password", "n", "", "New password (required)")
+
+	// Mark required flags
+	cmd.MarkFlagRequired("current-password")
+	cmd.MarkFlagRequired("new-password")
+
+	return cmd
+}
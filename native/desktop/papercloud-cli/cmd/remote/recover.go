@@ -0,0 +1,80 @@
+// native/desktop/papercloud-cli/cmd/remote/recover.go
+package remote
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+func RecoverAccountCmd() *cobra.Command {
+	var email, recoveryKey, newPassword, code string
+	var module int
+
+	var cmd = &cobra.Command{
+		Use:   "recover",
+		Short: "Recover your account using your recovery key",
+		Long: `
+Recover your account if you forgot your password. A verification code is
+emailed to you; enter it when prompted (or pass it with --code). Your master
+key is then unlocked locally with the recovery key printed at registration
+and re-encrypted with your new password. All sessions are signed out.
+
+Examples:
+  # Recover an account, you will be prompted for the emailed code
+  papercloud-cli remote recover --email user@example.com --recovery-key "BASE64KEY" --new-password newpass --module 1
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			fmt.Println("Recovering account...")
+
+			// Sanitize inputs
+			email = strings.ToLower(strings.TrimSpace(email))
+			if email == "" || recoveryKey == "" || newPassword == "" {
+				log.Fatal("Email, recovery key and new password are required")
+			}
+
+			client := createE2EEClient()
+
+			// Step 1: Prove ownership of the email address
+			if code == "" {
+				if err := client.RequestRecoveryCode(email, module); err != nil {
+					log.Fatalf("Failed to request recovery code: %v", err)
+				}
+				fmt.Printf("A verification code was sent to %s\n", email)
+				fmt.Print("Enter the verification code: ")
+				reader := bufio.NewReader(os.Stdin)
+				input, err := reader.ReadString('\n')
+				if err != nil {
+					log.Fatalf("Failed to read verification code: %v", err)
+				}
+				code = strings.TrimSpace(input)
+			}
+
+			// Step 2: Unlock the keys with the recovery key and set the new password
+			if err := client.RecoverAccount(email, code, recoveryKey, newPassword); err != nil {
+				log.Fatalf("Failed to recover account: %v", err)
+			}
+
+			fmt.Println("Account recovered successfully!")
+			fmt.Println("All sessions have been signed out, please login with your new password.")
+		},
+	}
+
+	// Define command flags
+	cmd.Flags().StringVarP(&email, "email", "e", "", "Email address for the user (required)")
+	cmd.Flags().StringVarP(&recoveryKey, "recovery-key", "r", "", "Recovery key shown at registration (required)")
+	cmd.Flags().StringVarP(&newPassword, "new-password", "n", "", "New password (required)")
+	cmd.Flags().StringVarP(&code, "code", "c", "", "Verification code already received by email")
+	cmd.Flags().IntVarP(&module, "module", "m", 1, "Module the user registered for")
+
+	// Mark required flags
+	cmd.MarkFlagRequired("email")
+	cmd.MarkFlagRequired("recovery-key")
+	cmd.MarkFlagRequired("new-password")
+
+	return cmd
+}
@@ -35,6 +35,8 @@ func RemoteCmd() *cobra.Command {
 	cmd.AddCommand(CompleteLoginCmd())
 	cmd.AddCommand(MeCmd())
 	cmd.AddCommand(UploadFileCmd())
+	cmd.AddCommand(ChangePasswordCmd())
+	cmd.AddCommand(RecoverAccountCmd())
 	// cmd.AddCommand(LogoutUserCmd())
 
 	return cmd
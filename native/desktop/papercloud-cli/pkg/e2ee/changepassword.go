@@ -0,0 +1,152 @@
+package e2ee
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// ChangePasswordChallengeResponse contains the currently wrapped keys and a
+// fresh challenge which proves the client still holds the private key
+type ChangePasswordChallengeResponse struct {
+	Salt                string `json:"salt"`
+	EncryptedMasterKey  string `json:"encryptedMasterKey"`
+	EncryptedPrivateKey string `json:"encryptedPrivateKey"`
+	EncryptedChallenge  string `json:"encryptedChallenge"`
+	ChallengeID         string `json:"challengeId"`
+}
+
+// ChangePasswordRequest is the payload for changing the password
+type ChangePasswordRequest struct {
+	ChallengeID         string `json:"challengeId"`
+	DecryptedData       string `json:"decryptedData"`
+	Salt                string `json:"salt"`
+	EncryptedMasterKey  string `json:"encryptedMasterKey"`
+	EncryptedPrivateKey string `json:"encryptedPrivateKey"`
+}
+
+// RewrapKeys derives a new key encryption key from the new password and a
+// fresh salt, then re-encrypts the master key with it and the private key with
+// the master key.
+// WHY: The master key never changes, so files encrypted with it stay readable;
+// only the password-derived wrapping around it is replaced.
+func RewrapKeys(newPassword string, masterKey, privateKey []byte) (salt, encryptedMasterKey, encryptedPrivateKey string, err error) {
+	newSalt, err := generateSalt()
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to generate salt: %v", err)
+	}
+
+	keyEncryptionKey, err := deriveKeyFromPassword(newPassword, newSalt)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to derive key encryption key: %v", err)
+	}
+
+	wrappedMasterKey, err := encryptData(masterKey, keyEncryptionKey)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to encrypt master key: %v", err)
+	}
+
+	wrappedPrivateKey, err := encryptData(privateKey, masterKey)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to encrypt private key: %v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(newSalt),
+		base64.StdEncoding.EncodeToString(wrappedMasterKey),
+		base64.StdEncoding.EncodeToString(wrappedPrivateKey),
+		nil
+}
+
+// unwrapKeys decrypts the master key with the password and the private key
+// with the master key
+func unwrapKeys(password, saltB64, encryptedMasterKeyB64, encryptedPrivateKeyB64 string) (masterKey, privateKey []byte, err error) {
+	salt, err := base64.StdEncoding.DecodeString(saltB64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode salt: %v", err)
+	}
+	encryptedMasterKey, err := base64.StdEncoding.DecodeString(encryptedMasterKeyB64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode encrypted master key: %v", err)
+	}
+	encryptedPrivateKey, err := base64.StdEncoding.DecodeString(encryptedPrivateKeyB64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode encrypted private key: %v", err)
+	}
+
+	keyEncryptionKey, err := deriveKeyFromPassword(password, salt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to derive key encryption key: %v", err)
+	}
+
+	masterKey, err = decryptData(encryptedMasterKey, keyEncryptionKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decrypt master key, likely incorrect password: %v", err)
+	}
+
+	privateKey, err = decryptData(encryptedPrivateKey, masterKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decrypt private key: %v", err)
+	}
+
+	return masterKey, privateKey, nil
+}
+
+// ChangePassword performs the full password change:
+// 1. Requests a challenge and the currently wrapped keys from the server
+// 2. Unwraps the master and private keys with the current password
+// 3. Decrypts the challenge with the private key
+// 4. Re-wraps the keys with the new password and submits them
+func (c *Client) ChangePassword(currentPassword, newPassword string) error {
+	if currentPassword == newPassword {
+		return fmt.Errorf("new password must differ from the current password")
+	}
+
+	// Step 1: Get the challenge
+	body, err := c.AuthenticatedRequest("POST", "/iam/api/v1/change-password/challenge", nil)
+	if err != nil {
+		return fmt.Errorf("failed to request password change challenge: %w", err)
+	}
+	var challenge ChangePasswordChallengeResponse
+	if err := json.Unmarshal(body, &challenge); err != nil {
+		return fmt.Errorf("failed to parse password change challenge: %w", err)
+	}
+
+	// Step 2: Unwrap the keys with the current password
+	masterKey, privateKey, err := unwrapKeys(currentPassword, challenge.Salt, challenge.EncryptedMasterKey, challenge.EncryptedPrivateKey)
+	if err != nil {
+		return fmt.Errorf("failed to unlock keys with current password: %w", err)
+	}
+
+	// Step 3: Prove possession of the private key
+	decryptedChallenge, err := decryptChallengeWithPrivateKey(challenge.EncryptedChallenge, privateKey)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt password change challenge: %w", err)
+	}
+
+	// Step 4: Re-wrap with the new password and submit
+	salt, encryptedMasterKey, encryptedPrivateKey, err := RewrapKeys(newPassword, masterKey, privateKey)
+	if err != nil {
+		return fmt.Errorf("failed to re-wrap keys: %w", err)
+	}
+
+	payload := &ChangePasswordRequest{
+		ChallengeID:         challenge.ChallengeID,
+		DecryptedData:       base64.StdEncoding.EncodeToString(decryptedChallenge),
+		Salt:                salt,
+		EncryptedMasterKey:  encryptedMasterKey,
+		EncryptedPrivateKey: encryptedPrivateKey,
+	}
+	if _, err := c.AuthenticatedRequest("POST", "/iam/api/v1/change-password", payload); err != nil {
+		return fmt.Errorf("failed to change password: %w", err)
+	}
+
+	c.Keys = &KeySet{
+		MasterKey:  masterKey,
+		PrivateKey: privateKey,
+	}
+	c.Salt = salt
+	c.StoredEncryptedMasterKey = encryptedMasterKey
+	c.StoredEncryptedPrivateKey = encryptedPrivateKey
+
+	return nil
+}
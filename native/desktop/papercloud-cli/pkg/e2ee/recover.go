@@ -0,0 +1,173 @@
+package e2ee
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ForgotPasswordRequest is the payload to request an account recovery code
+type ForgotPasswordRequest struct {
+	Email  string `json:"email"`
+	Module int    `json:"module"`
+}
+
+// VerifyRecoveryRequest is the payload to verify the emailed recovery code
+type VerifyRecoveryRequest struct {
+	Email string `json:"email"`
+	Code  string `json:"code"`
+}
+
+// VerifyRecoveryResponse contains the master key wrapped with the recovery key
+// and a challenge to prove the keys were recovered
+type VerifyRecoveryResponse struct {
+	MasterKeyEncryptedWithRecoveryKey string `json:"masterKeyEncryptedWithRecoveryKey"`
+	EncryptedPrivateKey               string `json:"encryptedPrivateKey"`
+	EncryptedChallenge                string `json:"encryptedChallenge"`
+	RecoveryID                        string `json:"recoveryId"`
+}
+
+// ResetPasswordRequest is the payload to complete the account recovery
+type ResetPasswordRequest struct {
+	RecoveryID          string `json:"recoveryId"`
+	DecryptedData       string `json:"decryptedData"`
+	Salt                string `json:"salt"`
+	EncryptedMasterKey  string `json:"encryptedMasterKey"`
+	EncryptedPrivateKey string `json:"encryptedPrivateKey"`
+}
+
+// RequestRecoveryCode asks the server to email a recovery code to the user,
+// proving ownership of the email address
+func (c *Client) RequestRecoveryCode(email string, module int) error {
+	payload := &ForgotPasswordRequest{
+		Email:  email,
+		Module: module,
+	}
+	if _, err := c.postJSON("/iam/api/v1/forgot-password", payload, http.StatusCreated); err != nil {
+		return fmt.Errorf("RequestRecoveryCode: failed for email %s: %w", censorEmail(email), err)
+	}
+	return nil
+}
+
+// RecoverAccount performs the account recovery:
+// 1. Verifies the emailed code and receives the master key wrapped with the recovery key
+// 2. Unwraps the master key with the recovery key and the private key with the master key
+// 3. Decrypts the challenge with the private key
+// 4. Re-wraps the keys with the new password and submits them
+func (c *Client) RecoverAccount(email, code, recoveryKeyB64, newPassword string) error {
+	censoredEmail := censorEmail(email)
+
+	recoveryKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(recoveryKeyB64))
+	if err != nil {
+		return fmt.Errorf("RecoverAccount: failed to decode recovery key: %w", err)
+	}
+
+	// Step 1: Verify the code
+	body, err := c.postJSON("/iam/api/v1/verify-recovery", &VerifyRecoveryRequest{Email: email, Code: code}, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("RecoverAccount: failed to verify recovery code for email %s: %w", censoredEmail, err)
+	}
+	var recovery VerifyRecoveryResponse
+	if err := json.Unmarshal(body, &recovery); err != nil {
+		return fmt.Errorf("RecoverAccount: failed to parse VerifyRecoveryResponse JSON: %w", err)
+	}
+
+	// Step 2: Unwrap the keys with the recovery key
+	masterKeyEncryptedWithRecoveryKey, err := base64.StdEncoding.DecodeString(recovery.MasterKeyEncryptedWithRecoveryKey)
+	if err != nil {
+		return fmt.Errorf("RecoverAccount: failed to decode master key encrypted with recovery key: %w", err)
+	}
+	masterKey, err := decryptData(masterKeyEncryptedWithRecoveryKey, recoveryKey)
+	if err != nil {
+		return fmt.Errorf("RecoverAccount: failed to decrypt master key, likely incorrect recovery key for email %s: %w", censoredEmail, err)
+	}
+	encryptedPrivateKey, err := base64.StdEncoding.DecodeString(recovery.EncryptedPrivateKey)
+	if err != nil {
+		return fmt.Errorf("RecoverAccount: failed to decode encrypted private key: %w", err)
+	}
+	privateKey, err := decryptData(encryptedPrivateKey, masterKey)
+	if err != nil {
+		return fmt.Errorf("RecoverAccount: failed to decrypt private key using master key: %w", err)
+	}
+
+	// Step 3: Prove possession of the private key
+	decryptedChallenge, err := decryptChallengeWithPrivateKey(recovery.EncryptedChallenge, privateKey)
+	if err != nil {
+		return fmt.Errorf("RecoverAccount: failed to decrypt recovery challenge: %w", err)
+	}
+
+	// Step 4: Re-wrap with the new password and submit
+	salt, newEncryptedMasterKey, newEncryptedPrivateKey, err := RewrapKeys(newPassword, masterKey, privateKey)
+	if err != nil {
+		return fmt.Errorf("RecoverAccount: failed to re-wrap keys: %w", err)
+	}
+
+	payload := &ResetPasswordRequest{
+		RecoveryID:          recovery.RecoveryID,
+		DecryptedData:       base64.StdEncoding.EncodeToString(decryptedChallenge),
+		Salt:                salt,
+		EncryptedMasterKey:  newEncryptedMasterKey,
+		EncryptedPrivateKey: newEncryptedPrivateKey,
+	}
+	if _, err := c.postJSON("/iam/api/v1/reset-password", payload, http.StatusCreated); err != nil {
+		return fmt.Errorf("RecoverAccount: failed to reset password for email %s: %w", censoredEmail, err)
+	}
+
+	c.Keys = &KeySet{
+		MasterKey:   masterKey,
+		PrivateKey:  privateKey,
+		RecoveryKey: recoveryKey,
+	}
+	c.Salt = salt
+	c.StoredEncryptedMasterKey = newEncryptedMasterKey
+	c.StoredEncryptedPrivateKey = newEncryptedPrivateKey
+
+	return nil
+}
+
+// postJSON sends an unauthenticated JSON POST request and returns the body if
+// the server responded with the expected status
+func (c *Client) postJSON(endpoint string, payload interface{}, expectedStatus int) ([]byte, error) {
+	client := c.Config.HTTPClient
+	if client == nil {
+		client = defaultHTTPClient()
+	}
+
+	serverURL := c.Config.ServerURL
+	if serverURL == "" {
+		serverURL = DefaultServerURL
+	}
+	fullURL := fmt.Sprintf("%s%s", serverURL, endpoint)
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", fullURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create POST request for %s: %w", fullURL, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request to %s: %w", fullURL, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body from %s (status %d): %w", fullURL, resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return nil, fmt.Errorf("request to %s failed with status %d: %s", fullURL, resp.StatusCode, string(body))
+	}
+
+	return body, nil
+}